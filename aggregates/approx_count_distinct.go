@@ -0,0 +1,85 @@
+package aggregates
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// ApproximateCountDistinctOverloads don't support retractions, as a HyperLogLog sketch can't forget values.
+var ApproximateCountDistinctOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType:  octosql.Any,
+		OutputType:    octosql.Int,
+		Prototype:     NewHyperLogLogPrototype(),
+		NoRetractions: true,
+	},
+}
+
+// hyperLogLogPrecision results in 2^14 registers, which gives a standard error of about 0.8%.
+const hyperLogLogPrecision = 14
+
+// HyperLogLog estimates the number of distinct values using constant memory.
+type HyperLogLog struct {
+	registers []uint8
+}
+
+func NewHyperLogLogPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &HyperLogLog{
+			registers: make([]uint8, 1<<hyperLogLogPrecision),
+		}
+	}
+}
+
+func (c *HyperLogLog) Add(retraction bool, value octosql.Value) (bool, error) {
+	if retraction {
+		return false, fmt.Errorf("approximate distinct count doesn't support retractions, received a retraction of %s", value)
+	}
+	hash := hashValue(value)
+	index := hash >> (64 - hyperLogLogPrecision)
+	// The position of the first set bit in the remaining bits. The sentinel bit bounds it for all-zero hashes.
+	rank := uint8(bits.LeadingZeros64(hash<<hyperLogLogPrecision|1<<(hyperLogLogPrecision-1)) + 1)
+	if rank > c.registers[index] {
+		c.registers[index] = rank
+	}
+	return false, nil
+}
+
+func (c *HyperLogLog) Trigger() octosql.Value {
+	m := float64(len(c.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range c.registers {
+		sum += math.Pow(2, -float64(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return octosql.NewInt(int(math.Round(estimate)))
+}
+
+// hashValue returns a well-mixed 64-bit hash of the value.
+func hashValue(value octosql.Value) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(value.String()))
+	hash := hasher.Sum64()
+
+	// The MurmurHash3 finalizer, as FNV doesn't distribute the high bits well enough.
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
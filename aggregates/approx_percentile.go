@@ -0,0 +1,157 @@
+package aggregates
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// ApproximatePercentileOverloads take the value and the fraction as arguments, like percentile_cont.
+// They don't support retractions, as a t-digest can't forget values.
+var ApproximatePercentileOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn:        PercentileContinuousOverloads[0].TypeFn,
+		Prototype:     NewTDigestPrototype(),
+		NoRetractions: true,
+	},
+}
+
+const (
+	// tDigestCompression bounds the number of centroids, higher values trade memory for accuracy.
+	tDigestCompression = 100
+	tDigestBufferSize  = 5 * tDigestCompression
+)
+
+type tDigestCentroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest approximates percentiles using a merging t-digest.
+// Centroids near the tails are kept small, so extreme percentiles stay accurate.
+type TDigest struct {
+	centroids []tDigestCentroid
+	// Values are buffered and merged into the centroids in batches.
+	buffer   []tDigestCentroid
+	count    float64
+	min, max float64
+
+	fraction float64
+	duration bool
+}
+
+func NewTDigestPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &TDigest{
+			min: math.Inf(1),
+			max: math.Inf(-1),
+		}
+	}
+}
+
+func (c *TDigest) Add(retraction bool, value octosql.Value) (bool, error) {
+	if retraction {
+		return false, fmt.Errorf("approximate percentile doesn't support retractions, received a retraction of %s", value)
+	}
+	if value.Tuple[0].TypeID == octosql.TypeIDNull || value.Tuple[1].TypeID == octosql.TypeIDNull {
+		return false, nil
+	}
+	c.fraction = numericValue(value.Tuple[1])
+	c.duration = value.Tuple[0].TypeID == octosql.TypeIDDuration
+
+	x := numericValue(value.Tuple[0])
+	c.buffer = append(c.buffer, tDigestCentroid{mean: x, weight: 1})
+	c.count++
+	c.min = math.Min(c.min, x)
+	c.max = math.Max(c.max, x)
+	if len(c.buffer) >= tDigestBufferSize {
+		c.compress()
+	}
+	return false, nil
+}
+
+// compress merges the buffered values into the centroids.
+// Neighbouring centroids are merged as long as the result stays within the size bound of the k1 scale function.
+func (c *TDigest) compress() {
+	if len(c.buffer) == 0 {
+		return
+	}
+	all := append(c.centroids, c.buffer...)
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].mean < all[j].mean
+	})
+
+	merged := make([]tDigestCentroid, 0, len(c.centroids)+1)
+	current := all[0]
+	weightSoFar := 0.0
+	weightLimit := c.count * tDigestQuantileLimit(0)
+	for _, centroid := range all[1:] {
+		if weightSoFar+current.weight+centroid.weight <= weightLimit {
+			current.mean += (centroid.mean - current.mean) * centroid.weight / (current.weight + centroid.weight)
+			current.weight += centroid.weight
+			continue
+		}
+		merged = append(merged, current)
+		weightSoFar += current.weight
+		weightLimit = c.count * tDigestQuantileLimit(weightSoFar/c.count)
+		current = centroid
+	}
+	merged = append(merged, current)
+
+	c.centroids = merged
+	c.buffer = c.buffer[:0]
+}
+
+// tDigestQuantileLimit returns the maximum quantile a centroid starting at the given quantile may reach.
+func tDigestQuantileLimit(q float64) float64 {
+	k := tDigestCompression/(2*math.Pi)*math.Asin(2*q-1) + 1
+	if k >= tDigestCompression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/tDigestCompression) + 1) / 2
+}
+
+func (c *TDigest) Trigger() octosql.Value {
+	c.compress()
+	if c.count == 0 {
+		return octosql.NewNull()
+	}
+	out := c.quantile(math.Min(math.Max(c.fraction, 0), 1))
+	if c.duration {
+		return octosql.NewDuration(time.Duration(math.Round(out)))
+	}
+	return octosql.NewFloat(out)
+}
+
+// quantile interpolates between the centers of neighbouring centroids, and between the outer centroids and the extremes.
+func (c *TDigest) quantile(q float64) float64 {
+	if len(c.centroids) == 1 {
+		return c.centroids[0].mean
+	}
+	index := q * c.count
+
+	first := c.centroids[0]
+	if index < first.weight/2 {
+		return c.min + (first.mean-c.min)*index/(first.weight/2)
+	}
+	last := c.centroids[len(c.centroids)-1]
+	if index >= c.count-last.weight/2 {
+		return last.mean + (c.max-last.mean)*(index-(c.count-last.weight/2))/(last.weight/2)
+	}
+
+	center := first.weight / 2
+	for i := 0; i < len(c.centroids)-1; i++ {
+		left, right := c.centroids[i], c.centroids[i+1]
+		nextCenter := center + left.weight/2 + right.weight/2
+		if index < nextCenter {
+			return left.mean + (right.mean-left.mean)*(index-center)/(nextCenter-center)
+		}
+		center = nextCenter
+	}
+	return last.mean
+}
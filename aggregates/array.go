@@ -47,7 +47,7 @@ func (key *arrayKey) Less(than btree.Item) bool {
 	return key.value.Compare(thanTyped.value) == -1
 }
 
-func (c *Array) Add(retraction bool, value octosql.Value) bool {
+func (c *Array) Add(retraction bool, value octosql.Value) (bool, error) {
 	item := c.items.Get(&arrayKey{value: value})
 	var itemTyped *arrayKey
 
@@ -69,7 +69,7 @@ func (c *Array) Add(retraction bool, value octosql.Value) bool {
 	if itemTyped.count == 0 {
 		c.items.Delete(itemTyped)
 	}
-	return c.items.Len() == 0
+	return c.items.Len() == 0, nil
 }
 
 func (c *Array) Trigger() octosql.Value {
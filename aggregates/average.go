@@ -24,6 +24,27 @@ var AverageOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewAverageDurationPrototype(),
 	},
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			decimalType, ok := nullableDecimal(t)
+			if !ok {
+				return octosql.Type{}, false
+			}
+			if decimalType.Decimal.Precision == 0 {
+				return octosql.Decimal, true
+			}
+			return octosql.NewDecimalType(octosql.MaxDecimalPrecision, averageDecimalScale(decimalType.Decimal.Scale)), true
+		},
+		Prototype: NewAverageDecimalPrototype(),
+	},
+}
+
+// averageDecimalScale is the scale of the average of decimals with the given scale.
+func averageDecimalScale(scale int) int {
+	if scale < 6 {
+		return 6
+	}
+	return scale
 }
 
 type AverageInt struct {
@@ -40,8 +61,10 @@ func NewAverageIntPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *AverageInt) Add(retraction bool, value octosql.Value) bool {
-	c.sum.Add(retraction, value)
+func (c *AverageInt) Add(retraction bool, value octosql.Value) (bool, error) {
+	if _, err := c.sum.Add(retraction, value); err != nil {
+		return false, err
+	}
 	return c.count.Add(retraction, value)
 }
 
@@ -63,8 +86,10 @@ func NewAverageFloatPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *AverageFloat) Add(retraction bool, value octosql.Value) bool {
-	c.sum.Add(retraction, value)
+func (c *AverageFloat) Add(retraction bool, value octosql.Value) (bool, error) {
+	if _, err := c.sum.Add(retraction, value); err != nil {
+		return false, err
+	}
 	return c.count.Add(retraction, value)
 }
 
@@ -86,11 +111,39 @@ func NewAverageDurationPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *AverageDuration) Add(retraction bool, value octosql.Value) bool {
-	c.sum.Add(retraction, value)
+func (c *AverageDuration) Add(retraction bool, value octosql.Value) (bool, error) {
+	if _, err := c.sum.Add(retraction, value); err != nil {
+		return false, err
+	}
 	return c.count.Add(retraction, value)
 }
 
 func (c *AverageDuration) Trigger() octosql.Value {
 	return octosql.NewDuration(c.sum.Trigger().Duration / time.Duration(c.count.Trigger().Int))
 }
+
+type AverageDecimal struct {
+	sum   SumDecimal
+	count Count
+}
+
+func NewAverageDecimalPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &AverageDecimal{
+			sum:   SumDecimal{sum: octosql.DecimalFromInt(0)},
+			count: Count{},
+		}
+	}
+}
+
+func (c *AverageDecimal) Add(retraction bool, value octosql.Value) (bool, error) {
+	if _, err := c.sum.Add(retraction, value); err != nil {
+		return false, err
+	}
+	return c.count.Add(retraction, value)
+}
+
+func (c *AverageDecimal) Trigger() octosql.Value {
+	sum := c.sum.Trigger().Decimal
+	return octosql.NewDecimal(sum.Div(octosql.DecimalFromInt(c.count.Trigger().Int), averageDecimalScale(sum.Scale)))
+}
@@ -0,0 +1,57 @@
+package aggregates
+
+import (
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var BoolAndOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Boolean,
+		OutputType:   octosql.Boolean,
+		Prototype:    NewBoolPrototype(true),
+	},
+}
+
+var BoolOrOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Boolean,
+		OutputType:   octosql.Boolean,
+		Prototype:    NewBoolPrototype(false),
+	},
+}
+
+// Bool counts true and false values, so that it can handle retractions.
+type Bool struct {
+	trueCount, falseCount int
+	and                   bool
+}
+
+func NewBoolPrototype(and bool) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Bool{
+			and: and,
+		}
+	}
+}
+
+func (c *Bool) Add(retraction bool, value octosql.Value) (bool, error) {
+	diff := 1
+	if retraction {
+		diff = -1
+	}
+	if value.Boolean {
+		c.trueCount += diff
+	} else {
+		c.falseCount += diff
+	}
+	return c.trueCount+c.falseCount == 0, nil
+}
+
+func (c *Bool) Trigger() octosql.Value {
+	if c.and {
+		return octosql.NewBoolean(c.falseCount == 0)
+	}
+	return octosql.NewBoolean(c.trueCount > 0)
+}
@@ -26,13 +26,13 @@ func NewCountPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *Count) Add(retraction bool, value octosql.Value) bool {
+func (c *Count) Add(retraction bool, value octosql.Value) (bool, error) {
 	if !retraction {
 		c.count++
 	} else {
 		c.count--
 	}
-	return c.count == 0
+	return c.count == 0, nil
 }
 
 func (c *Count) Trigger() octosql.Value {
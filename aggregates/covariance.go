@@ -0,0 +1,113 @@
+package aggregates
+
+import (
+	"math"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// The covariance aggregates take two arguments, i.e. corr(x, y), which are received as a tuple.
+// Pairs containing NULL are skipped.
+func covarianceOverloads(kind covarianceKind) []physical.AggregateDescriptor {
+	return []physical.AggregateDescriptor{
+		{
+			TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+				if t.TypeID != octosql.TypeIDTuple || len(t.Tuple.Elements) != 2 {
+					return octosql.Type{}, false
+				}
+				for _, element := range t.Tuple.Elements {
+					if element.Is(octosql.TypeSum(octosql.TypeSum(octosql.Int, octosql.Float), octosql.Null)) != octosql.TypeRelationIs {
+						return octosql.Type{}, false
+					}
+				}
+				return octosql.TypeSum(octosql.Float, octosql.Null), true
+			},
+			Prototype: NewCovariancePrototype(kind),
+		},
+	}
+}
+
+var CorrelationOverloads = covarianceOverloads(covarianceKindCorrelation)
+var CovariancePopulationOverloads = covarianceOverloads(covarianceKindPopulation)
+var CovarianceSampleOverloads = covarianceOverloads(covarianceKindSample)
+
+type covarianceKind int
+
+const (
+	covarianceKindCorrelation covarianceKind = iota
+	covarianceKindPopulation
+	covarianceKindSample
+)
+
+// Covariance uses the bivariate version of Welford's online algorithm, which can be reversed for retractions.
+type Covariance struct {
+	count        int
+	meanX, meanY float64
+	// comoment is the sum of products of differences from the means.
+	comoment float64
+	// m2X and m2Y are the sums of squared differences from the means.
+	m2X, m2Y float64
+
+	kind covarianceKind
+}
+
+func NewCovariancePrototype(kind covarianceKind) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Covariance{
+			kind: kind,
+		}
+	}
+}
+
+func (c *Covariance) Add(retraction bool, value octosql.Value) (bool, error) {
+	if value.Tuple[0].TypeID == octosql.TypeIDNull || value.Tuple[1].TypeID == octosql.TypeIDNull {
+		return c.count == 0, nil
+	}
+	x, y := numericValue(value.Tuple[0]), numericValue(value.Tuple[1])
+
+	if !retraction {
+		c.count++
+		deltaX := x - c.meanX
+		deltaY := y - c.meanY
+		c.meanX += deltaX / float64(c.count)
+		c.meanY += deltaY / float64(c.count)
+		c.comoment += deltaX * (y - c.meanY)
+		c.m2X += deltaX * (x - c.meanX)
+		c.m2Y += deltaY * (y - c.meanY)
+	} else {
+		c.count--
+		if c.count == 0 {
+			c.meanX, c.meanY, c.comoment, c.m2X, c.m2Y = 0, 0, 0, 0, 0
+			return true, nil
+		}
+		oldMeanX := (c.meanX*float64(c.count+1) - x) / float64(c.count)
+		oldMeanY := (c.meanY*float64(c.count+1) - y) / float64(c.count)
+		c.comoment -= (x - oldMeanX) * (y - c.meanY)
+		c.m2X -= (x - oldMeanX) * (x - c.meanX)
+		c.m2Y -= (y - oldMeanY) * (y - c.meanY)
+		c.meanX, c.meanY = oldMeanX, oldMeanY
+	}
+	return c.count == 0, nil
+}
+
+func (c *Covariance) Trigger() octosql.Value {
+	switch c.kind {
+	case covarianceKindCorrelation:
+		if c.count < 2 || c.m2X <= 0 || c.m2Y <= 0 {
+			return octosql.NewNull()
+		}
+		return octosql.NewFloat(c.comoment / math.Sqrt(c.m2X*c.m2Y))
+	case covarianceKindPopulation:
+		if c.count == 0 {
+			return octosql.NewNull()
+		}
+		return octosql.NewFloat(c.comoment / float64(c.count))
+	default:
+		if c.count < 2 {
+			return octosql.NewNull()
+		}
+		return octosql.NewFloat(c.comoment / float64(c.count-1))
+	}
+}
@@ -15,10 +15,11 @@ func DistinctAggregateOverloads(overloads []physical.AggregateDescriptor) []phys
 	out := make([]physical.AggregateDescriptor, len(overloads))
 	for i := range overloads {
 		out[i] = physical.AggregateDescriptor{
-			ArgumentType: overloads[i].ArgumentType,
-			OutputType:   overloads[i].OutputType,
-			TypeFn:       overloads[i].TypeFn,
-			Prototype:    NewDistinctPrototype(overloads[i].Prototype),
+			ArgumentType:  overloads[i].ArgumentType,
+			OutputType:    overloads[i].OutputType,
+			TypeFn:        overloads[i].TypeFn,
+			Prototype:     NewDistinctPrototype(overloads[i].Prototype),
+			NoRetractions: overloads[i].NoRetractions,
 		}
 	}
 	return out
@@ -52,7 +53,7 @@ func (key *distinctKey) Less(than btree.Item) bool {
 	return key.value.Compare(thanTyped.value) == -1
 }
 
-func (c *Distinct) Add(retraction bool, value octosql.Value) bool {
+func (c *Distinct) Add(retraction bool, value octosql.Value) (bool, error) {
 	item := c.items.Get(&distinctKey{value: value})
 	var itemTyped *distinctKey
 
@@ -72,12 +73,16 @@ func (c *Distinct) Add(retraction bool, value octosql.Value) bool {
 		itemTyped.count--
 	}
 	if itemTyped.count == 1 && !retraction {
-		c.wrapped.Add(false, value)
+		if _, err := c.wrapped.Add(false, value); err != nil {
+			return false, err
+		}
 	} else if itemTyped.count == 0 {
 		c.items.Delete(itemTyped)
-		c.wrapped.Add(true, value)
+		if _, err := c.wrapped.Add(true, value); err != nil {
+			return false, err
+		}
 	}
-	return c.items.Len() == 0
+	return c.items.Len() == 0, nil
 }
 
 func (c *Distinct) Trigger() octosql.Value {
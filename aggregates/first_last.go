@@ -0,0 +1,103 @@
+package aggregates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var FirstOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			return t, true
+		},
+		Prototype:         NewEventTimeOrderedPrototype(false),
+		RequiresEventTime: true,
+	},
+}
+
+var LastOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			return t, true
+		},
+		Prototype:         NewEventTimeOrderedPrototype(true),
+		RequiresEventTime: true,
+	},
+}
+
+// EventTimeOrdered returns the value with the earliest or latest event time.
+// Values with equal event times are ordered by value.
+type EventTimeOrdered struct {
+	items *btree.BTree
+	last  bool
+}
+
+func NewEventTimeOrderedPrototype(last bool) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &EventTimeOrdered{
+			items: btree.New(execution.BTreeDefaultDegree),
+			last:  last,
+		}
+	}
+}
+
+type eventTimeOrderedKey struct {
+	eventTime time.Time
+	value     octosql.Value
+	count     int
+}
+
+func (key *eventTimeOrderedKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(*eventTimeOrderedKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	if !key.eventTime.Equal(thanTyped.eventTime) {
+		return key.eventTime.Before(thanTyped.eventTime)
+	}
+	return key.value.Compare(thanTyped.value) == -1
+}
+
+func (c *EventTimeOrdered) Add(retraction bool, value octosql.Value) (bool, error) {
+	return c.AddWithEventTime(retraction, value, time.Time{})
+}
+
+func (c *EventTimeOrdered) AddWithEventTime(retraction bool, value octosql.Value, eventTime time.Time) (bool, error) {
+	item := c.items.Get(&eventTimeOrderedKey{eventTime: eventTime, value: value})
+	var itemTyped *eventTimeOrderedKey
+
+	if item == nil {
+		itemTyped = &eventTimeOrderedKey{eventTime: eventTime, value: value, count: 0}
+		c.items.ReplaceOrInsert(itemTyped)
+	} else {
+		var ok bool
+		itemTyped, ok = item.(*eventTimeOrderedKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+	}
+	if !retraction {
+		itemTyped.count++
+	} else {
+		itemTyped.count--
+	}
+	if itemTyped.count == 0 {
+		c.items.Delete(itemTyped)
+	}
+	return c.items.Len() == 0, nil
+}
+
+func (c *EventTimeOrdered) Trigger() octosql.Value {
+	if c.last {
+		return c.items.Max().(*eventTimeOrderedKey).value
+	}
+	return c.items.Min().(*eventTimeOrderedKey).value
+}
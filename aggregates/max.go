@@ -32,6 +32,15 @@ var MaxOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Time,
 		Prototype:    NewMaxPrototype(),
 	},
+	{
+		ArgumentType: octosql.Date,
+		OutputType:   octosql.Date,
+		Prototype:    NewMaxPrototype(),
+	},
+	{
+		TypeFn:    nullableDecimal,
+		Prototype: NewMaxPrototype(),
+	},
 }
 
 type Max struct {
@@ -60,7 +69,7 @@ func (key *maxKey) Less(than btree.Item) bool {
 	return key.value.Compare(thanTyped.value) == -1
 }
 
-func (c *Max) Add(retraction bool, value octosql.Value) bool {
+func (c *Max) Add(retraction bool, value octosql.Value) (bool, error) {
 	item := c.items.Get(&maxKey{value: value})
 	var itemTyped *maxKey
 
@@ -82,7 +91,7 @@ func (c *Max) Add(retraction bool, value octosql.Value) bool {
 	if itemTyped.count == 0 {
 		c.items.Delete(itemTyped)
 	}
-	return c.items.Len() == 0
+	return c.items.Len() == 0, nil
 }
 
 func (c *Max) Trigger() octosql.Value {
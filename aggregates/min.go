@@ -27,6 +27,20 @@ var MinOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewMinPrototype(),
 	},
+	{
+		ArgumentType: octosql.Time,
+		OutputType:   octosql.Time,
+		Prototype:    NewMinPrototype(),
+	},
+	{
+		ArgumentType: octosql.Date,
+		OutputType:   octosql.Date,
+		Prototype:    NewMinPrototype(),
+	},
+	{
+		TypeFn:    nullableDecimal,
+		Prototype: NewMinPrototype(),
+	},
 }
 
 type Min struct {
@@ -55,7 +69,7 @@ func (key *minKey) Less(than btree.Item) bool {
 	return key.value.Compare(thanTyped.value) == -1
 }
 
-func (c *Min) Add(retraction bool, value octosql.Value) bool {
+func (c *Min) Add(retraction bool, value octosql.Value) (bool, error) {
 	item := c.items.Get(&minKey{value: value})
 	var itemTyped *minKey
 
@@ -77,7 +91,7 @@ func (c *Min) Add(retraction bool, value octosql.Value) bool {
 	if itemTyped.count == 0 {
 		c.items.Delete(itemTyped)
 	}
-	return c.items.Len() == 0
+	return c.items.Len() == 0, nil
 }
 
 func (c *Min) Trigger() octosql.Value {
@@ -0,0 +1,110 @@
+package aggregates
+
+import (
+	"fmt"
+
+	"github.com/google/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// AnyValueOverloads return the smallest value, so that the chosen value is stable and survives unrelated retractions.
+var AnyValueOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			return t, true
+		},
+		Prototype: NewMinPrototype(),
+	},
+}
+
+var ModeOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			return t, true
+		},
+		Prototype: NewModePrototype(),
+	},
+}
+
+// Mode returns the most frequent value, the smallest one in case of ties.
+// Values are indexed both by value and by count, so that retractions can be handled.
+type Mode struct {
+	items   *btree.BTree
+	byCount *btree.BTree
+}
+
+func NewModePrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Mode{
+			items:   btree.New(execution.BTreeDefaultDegree),
+			byCount: btree.New(execution.BTreeDefaultDegree),
+		}
+	}
+}
+
+type modeKey struct {
+	value octosql.Value
+	count int
+}
+
+func (key *modeKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(*modeKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	return key.value.Compare(thanTyped.value) == -1
+}
+
+// modeCountKey orders by descending count first.
+type modeCountKey struct {
+	*modeKey
+}
+
+func (key modeCountKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(modeCountKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	if key.count != thanTyped.count {
+		return key.count > thanTyped.count
+	}
+	return key.value.Compare(thanTyped.value) == -1
+}
+
+func (c *Mode) Add(retraction bool, value octosql.Value) (bool, error) {
+	item := c.items.Get(&modeKey{value: value})
+	var itemTyped *modeKey
+
+	if item == nil {
+		itemTyped = &modeKey{value: value, count: 0}
+		c.items.ReplaceOrInsert(itemTyped)
+	} else {
+		var ok bool
+		itemTyped, ok = item.(*modeKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+		c.byCount.Delete(modeCountKey{itemTyped})
+	}
+	if !retraction {
+		itemTyped.count++
+	} else {
+		itemTyped.count--
+	}
+	if itemTyped.count == 0 {
+		c.items.Delete(itemTyped)
+	} else {
+		c.byCount.ReplaceOrInsert(modeCountKey{itemTyped})
+	}
+	return c.items.Len() == 0, nil
+}
+
+func (c *Mode) Trigger() octosql.Value {
+	return c.byCount.Min().(modeCountKey).value
+}
@@ -0,0 +1,191 @@
+package aggregates
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var MedianOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Int,
+		OutputType:   octosql.Float,
+		Prototype:    NewPercentilePrototype(true, false, 0.5),
+	},
+	{
+		ArgumentType: octosql.Float,
+		OutputType:   octosql.Float,
+		Prototype:    NewPercentilePrototype(true, false, 0.5),
+	},
+	{
+		ArgumentType: octosql.Duration,
+		OutputType:   octosql.Duration,
+		Prototype:    NewPercentilePrototype(true, false, 0.5),
+	},
+}
+
+// The percentile aggregates take the value and the fraction as arguments, i.e. percentile_cont(latency, 0.95).
+// Those are received as a tuple.
+var PercentileContinuousOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			value, ok := percentileArguments(t)
+			if !ok {
+				return octosql.Type{}, false
+			}
+			switch {
+			case value.Is(octosql.TypeSum(octosql.TypeSum(octosql.Int, octosql.Float), octosql.Null)) == octosql.TypeRelationIs:
+				return octosql.TypeSum(octosql.Float, octosql.Null), true
+			case value.Is(octosql.TypeSum(octosql.Duration, octosql.Null)) == octosql.TypeRelationIs:
+				return octosql.TypeSum(octosql.Duration, octosql.Null), true
+			}
+			return octosql.Type{}, false
+		},
+		Prototype: NewPercentilePrototype(true, true, 0),
+	},
+}
+
+var PercentileDiscreteOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			value, ok := percentileArguments(t)
+			if !ok {
+				return octosql.Type{}, false
+			}
+			return octosql.TypeSum(value, octosql.Null), true
+		},
+		Prototype: NewPercentilePrototype(false, true, 0),
+	},
+}
+
+// percentileArguments checks if the type is a tuple of a value and a numeric fraction, and returns the value type.
+func percentileArguments(t octosql.Type) (octosql.Type, bool) {
+	if t.TypeID != octosql.TypeIDTuple || len(t.Tuple.Elements) != 2 {
+		return octosql.Type{}, false
+	}
+	if t.Tuple.Elements[1].Is(octosql.TypeSum(octosql.TypeSum(octosql.Int, octosql.Float), octosql.Null)) != octosql.TypeRelationIs {
+		return octosql.Type{}, false
+	}
+	return t.Tuple.Elements[0], true
+}
+
+// Percentile keeps all values, so that the exact percentile can be computed.
+// Continuous percentiles interpolate between the two nearest values, while discrete percentiles return
+// the first value whose position in the sorted values is greater than or equal to the fraction.
+type Percentile struct {
+	items *btree.BTree
+	count int
+
+	continuous bool
+	// If fractionArgument is set, then the values are tuples of the value and the fraction.
+	fractionArgument bool
+	fraction         float64
+}
+
+func NewPercentilePrototype(continuous, fractionArgument bool, fraction float64) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Percentile{
+			items:            btree.New(execution.BTreeDefaultDegree),
+			continuous:       continuous,
+			fractionArgument: fractionArgument,
+			fraction:         fraction,
+		}
+	}
+}
+
+type percentileKey struct {
+	value octosql.Value
+	count int
+}
+
+func (key *percentileKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(*percentileKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	return key.value.Compare(thanTyped.value) == -1
+}
+
+func (c *Percentile) Add(retraction bool, value octosql.Value) (bool, error) {
+	if c.fractionArgument {
+		if value.Tuple[0].TypeID == octosql.TypeIDNull || value.Tuple[1].TypeID == octosql.TypeIDNull {
+			return c.count == 0, nil
+		}
+		c.fraction = numericValue(value.Tuple[1])
+		value = value.Tuple[0]
+	}
+
+	item := c.items.Get(&percentileKey{value: value})
+	var itemTyped *percentileKey
+
+	if item == nil {
+		itemTyped = &percentileKey{value: value, count: 0}
+		c.items.ReplaceOrInsert(itemTyped)
+	} else {
+		var ok bool
+		itemTyped, ok = item.(*percentileKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+	}
+	if !retraction {
+		itemTyped.count++
+		c.count++
+	} else {
+		itemTyped.count--
+		c.count--
+	}
+	if itemTyped.count == 0 {
+		c.items.Delete(itemTyped)
+	}
+	return c.count == 0, nil
+}
+
+func (c *Percentile) Trigger() octosql.Value {
+	if c.count == 0 {
+		return octosql.NewNull()
+	}
+	fraction := math.Min(math.Max(c.fraction, 0), 1)
+
+	if !c.continuous {
+		index := int(math.Ceil(fraction*float64(c.count))) - 1
+		if index < 0 {
+			index = 0
+		}
+		return c.valueAt(index)
+	}
+
+	position := fraction * float64(c.count-1)
+	lower := c.valueAt(int(math.Floor(position)))
+	upper := c.valueAt(int(math.Ceil(position)))
+	weight := position - math.Floor(position)
+	interpolated := numericValue(lower) + (numericValue(upper)-numericValue(lower))*weight
+	if lower.TypeID == octosql.TypeIDDuration {
+		return octosql.NewDuration(time.Duration(math.Round(interpolated)))
+	}
+	return octosql.NewFloat(interpolated)
+}
+
+// valueAt returns the value at the given index of the sorted values.
+func (c *Percentile) valueAt(index int) octosql.Value {
+	var out octosql.Value
+	seen := 0
+	c.items.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*percentileKey)
+		seen += itemTyped.count
+		if seen > index {
+			out = itemTyped.value
+			return false
+		}
+		return true
+	})
+	return out
+}
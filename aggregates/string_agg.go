@@ -0,0 +1,128 @@
+package aggregates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/btree"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// string_agg receives the value and the separator as a tuple.
+// With an ORDER BY, i.e. string_agg(name, ', ' ORDER BY age DESC), the tuple also contains the key and the direction multipliers.
+var StringAggOverloads = []physical.AggregateDescriptor{
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			if t.TypeID != octosql.TypeIDTuple || (len(t.Tuple.Elements) != 2 && len(t.Tuple.Elements) != 4) {
+				return octosql.Type{}, false
+			}
+			if t.Tuple.Elements[0].Is(octosql.TypeSum(octosql.String, octosql.Null)) != octosql.TypeRelationIs {
+				return octosql.Type{}, false
+			}
+			if t.Tuple.Elements[1].Is(octosql.String) != octosql.TypeRelationIs {
+				return octosql.Type{}, false
+			}
+			return octosql.TypeSum(octosql.String, octosql.Null), true
+		},
+		Prototype: NewStringAggPrototype(),
+	},
+}
+
+// StringAgg concatenates the values using the separator.
+// Values are ordered by the ORDER BY key if present, and by the values themselves otherwise.
+type StringAgg struct {
+	items     *btree.BTree
+	separator string
+}
+
+func NewStringAggPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &StringAgg{
+			items: btree.New(execution.BTreeDefaultDegree),
+		}
+	}
+}
+
+type stringAggKey struct {
+	key                  []octosql.Value
+	directionMultipliers []int
+	value                octosql.Value
+	count                int
+}
+
+func (key *stringAggKey) Less(than btree.Item) bool {
+	thanTyped, ok := than.(*stringAggKey)
+	if !ok {
+		panic(fmt.Sprintf("invalid key comparison: %T", than))
+	}
+
+	for i := range key.key {
+		if cmp := key.key[i].Compare(thanTyped.key[i]); cmp != 0 {
+			return cmp*key.directionMultipliers[i] == -1
+		}
+	}
+	return key.value.Compare(thanTyped.value) == -1
+}
+
+func (c *StringAgg) Add(retraction bool, value octosql.Value) (bool, error) {
+	if value.Tuple[0].TypeID == octosql.TypeIDNull {
+		return c.items.Len() == 0, nil
+	}
+	c.separator = value.Tuple[1].Str
+
+	key := &stringAggKey{value: value.Tuple[0]}
+	if len(value.Tuple) == 4 {
+		key.key = value.Tuple[2].Tuple
+		key.directionMultipliers = make([]int, len(value.Tuple[3].Tuple))
+		for i := range value.Tuple[3].Tuple {
+			key.directionMultipliers[i] = value.Tuple[3].Tuple[i].Int
+		}
+	}
+
+	item := c.items.Get(key)
+	var itemTyped *stringAggKey
+
+	if item == nil {
+		itemTyped = key
+		c.items.ReplaceOrInsert(itemTyped)
+	} else {
+		var ok bool
+		itemTyped, ok = item.(*stringAggKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+	}
+	if !retraction {
+		itemTyped.count++
+	} else {
+		itemTyped.count--
+	}
+	if itemTyped.count == 0 {
+		c.items.Delete(itemTyped)
+	}
+	return c.items.Len() == 0, nil
+}
+
+func (c *StringAgg) Trigger() octosql.Value {
+	if c.items.Len() == 0 {
+		return octosql.NewNull()
+	}
+	var sb strings.Builder
+	first := true
+	c.items.Ascend(func(item btree.Item) bool {
+		itemTyped := item.(*stringAggKey)
+		for i := 0; i < itemTyped.count; i++ {
+			if !first {
+				sb.WriteString(c.separator)
+			}
+			first = false
+			sb.WriteString(itemTyped.value.Str)
+		}
+		return true
+	})
+	return octosql.NewString(sb.String())
+}
@@ -24,6 +24,35 @@ var SumOverloads = []physical.AggregateDescriptor{
 		OutputType:   octosql.Duration,
 		Prototype:    NewSumDurationPrototype(),
 	},
+	{
+		TypeFn: func(t octosql.Type) (octosql.Type, bool) {
+			decimalType, ok := nullableDecimal(t)
+			if !ok {
+				return octosql.Type{}, false
+			}
+			if decimalType.Decimal.Precision == 0 {
+				return octosql.Decimal, true
+			}
+			return octosql.NewDecimalType(octosql.MaxDecimalPrecision, decimalType.Decimal.Scale), true
+		},
+		Prototype: NewSumDecimalPrototype(),
+	},
+}
+
+// nullableDecimal returns the decimal type of a decimal or nullable decimal.
+func nullableDecimal(t octosql.Type) (octosql.Type, bool) {
+	decimalType, ok := t.DecimalAlternative()
+	if !ok {
+		return octosql.Type{}, false
+	}
+	if t.TypeID == octosql.TypeIDUnion {
+		for _, alternative := range t.Union.Alternatives {
+			if alternative.TypeID != octosql.TypeIDDecimal && alternative.TypeID != octosql.TypeIDNull {
+				return octosql.Type{}, false
+			}
+		}
+	}
+	return decimalType, true
 }
 
 type SumInt struct {
@@ -38,13 +67,13 @@ func NewSumIntPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *SumInt) Add(retraction bool, value octosql.Value) bool {
+func (c *SumInt) Add(retraction bool, value octosql.Value) (bool, error) {
 	if !retraction {
 		c.sum += value.Int
 	} else {
 		c.sum -= value.Int
 	}
-	return c.sum == 0
+	return c.sum == 0, nil
 }
 
 func (c *SumInt) Trigger() octosql.Value {
@@ -63,13 +92,13 @@ func NewSumFloatPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *SumFloat) Add(retraction bool, value octosql.Value) bool {
+func (c *SumFloat) Add(retraction bool, value octosql.Value) (bool, error) {
 	if !retraction {
 		c.sum += value.Float
 	} else {
 		c.sum -= value.Float
 	}
-	return c.sum == 0
+	return c.sum == 0, nil
 }
 
 func (c *SumFloat) Trigger() octosql.Value {
@@ -88,15 +117,40 @@ func NewSumDurationPrototype() func() nodes.Aggregate {
 	}
 }
 
-func (c *SumDuration) Add(retraction bool, value octosql.Value) bool {
+func (c *SumDuration) Add(retraction bool, value octosql.Value) (bool, error) {
 	if !retraction {
 		c.sum += value.Duration
 	} else {
 		c.sum -= value.Duration
 	}
-	return c.sum == 0
+	return c.sum == 0, nil
 }
 
 func (c *SumDuration) Trigger() octosql.Value {
 	return octosql.NewDuration(c.sum)
 }
+
+type SumDecimal struct {
+	sum octosql.DecimalNumber
+}
+
+func NewSumDecimalPrototype() func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &SumDecimal{
+			sum: octosql.DecimalFromInt(0),
+		}
+	}
+}
+
+func (c *SumDecimal) Add(retraction bool, value octosql.Value) (bool, error) {
+	if !retraction {
+		c.sum = c.sum.Add(value.Decimal)
+	} else {
+		c.sum = c.sum.Sub(value.Decimal)
+	}
+	return c.sum.Sign() == 0, nil
+}
+
+func (c *SumDecimal) Trigger() octosql.Value {
+	return octosql.NewDecimal(c.sum)
+}
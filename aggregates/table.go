@@ -29,6 +29,10 @@ var Aggregates = map[string]physical.AggregateDetails{
 		Description: "Sums distinct items in the group.",
 		Descriptors: DistinctAggregateOverloads(SumOverloads),
 	},
+	"approx_count_distinct": {
+		Description: "Estimates the number of distinct items in the group using HyperLogLog, with a standard error of about 1%. Doesn't support inputs with retractions.",
+		Descriptors: ApproximateCountDistinctOverloads,
+	},
 	"avg": {
 		Description: "Averages all items in the group.",
 		Descriptors: AverageOverloads,
@@ -45,4 +49,76 @@ var Aggregates = map[string]physical.AggregateDetails{
 		Description: "Returns minimum item in the group.",
 		Descriptors: MinOverloads,
 	},
+	"any_value": {
+		Description: "Returns any item in the group. Currently the smallest one, so that it's stable under retractions.",
+		Descriptors: AnyValueOverloads,
+	},
+	"bool_and": {
+		Description: "Returns true if all items in the group are true.",
+		Descriptors: BoolAndOverloads,
+	},
+	"bool_or": {
+		Description: "Returns true if any item in the group is true.",
+		Descriptors: BoolOrOverloads,
+	},
+	"first": {
+		Description: "Returns the item with the earliest event time in the group. Requires an input with an event time field.",
+		Descriptors: FirstOverloads,
+	},
+	"last": {
+		Description: "Returns the item with the latest event time in the group. Requires an input with an event time field.",
+		Descriptors: LastOverloads,
+	},
+	"mode": {
+		Description: "Returns the most frequent item in the group, the smallest one in case of ties.",
+		Descriptors: ModeOverloads,
+	},
+	"string_agg": {
+		Description: "Concatenates the items in the group using the separator given as the second argument, i.e. string_agg(name, ', '). The items can be ordered using ORDER BY, i.e. string_agg(name, ', ' ORDER BY age DESC), otherwise they are sorted by value.",
+		Descriptors: StringAggOverloads,
+	},
+	"var_pop": {
+		Description: "Returns the population variance of the items in the group.",
+		Descriptors: VariancePopulationOverloads,
+	},
+	"var_samp": {
+		Description: "Returns the sample variance of the items in the group. NULL if there are less than two items.",
+		Descriptors: VarianceSampleOverloads,
+	},
+	"stddev_pop": {
+		Description: "Returns the population standard deviation of the items in the group.",
+		Descriptors: StandardDeviationPopulationOverloads,
+	},
+	"stddev_samp": {
+		Description: "Returns the sample standard deviation of the items in the group. NULL if there are less than two items.",
+		Descriptors: StandardDeviationSampleOverloads,
+	},
+	"median": {
+		Description: "Returns the median of the items in the group, interpolating between the two middle items if their count is even.",
+		Descriptors: MedianOverloads,
+	},
+	"percentile_cont": {
+		Description: "Returns the percentile given by the fraction in the second argument, i.e. percentile_cont(latency, 0.95), interpolating between the nearest items.",
+		Descriptors: PercentileContinuousOverloads,
+	},
+	"approx_percentile": {
+		Description: "Estimates the percentile given by the fraction in the second argument, i.e. approx_percentile(latency, 0.95), using a t-digest. Doesn't support inputs with retractions.",
+		Descriptors: ApproximatePercentileOverloads,
+	},
+	"percentile_disc": {
+		Description: "Returns the first item in sorted order whose position is at or above the percentile given by the fraction in the second argument, i.e. percentile_disc(latency, 0.95).",
+		Descriptors: PercentileDiscreteOverloads,
+	},
+	"corr": {
+		Description: "Returns the correlation coefficient of the pairs of arguments in the group, i.e. corr(x, y). Pairs containing NULL are skipped.",
+		Descriptors: CorrelationOverloads,
+	},
+	"covar_pop": {
+		Description: "Returns the population covariance of the pairs of arguments in the group, i.e. covar_pop(x, y). Pairs containing NULL are skipped.",
+		Descriptors: CovariancePopulationOverloads,
+	},
+	"covar_samp": {
+		Description: "Returns the sample covariance of the pairs of arguments in the group, i.e. covar_samp(x, y). Pairs containing NULL are skipped.",
+		Descriptors: CovarianceSampleOverloads,
+	},
 }
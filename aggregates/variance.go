@@ -0,0 +1,145 @@
+package aggregates
+
+import (
+	"math"
+	"time"
+
+	"github.com/cube2222/octosql/execution/nodes"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var VariancePopulationOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Int,
+		OutputType:   octosql.Float,
+		Prototype:    NewVariancePrototype(false, false),
+	},
+	{
+		ArgumentType: octosql.Float,
+		OutputType:   octosql.Float,
+		Prototype:    NewVariancePrototype(false, false),
+	},
+}
+
+var VarianceSampleOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Int,
+		OutputType:   octosql.TypeSum(octosql.Float, octosql.Null),
+		Prototype:    NewVariancePrototype(true, false),
+	},
+	{
+		ArgumentType: octosql.Float,
+		OutputType:   octosql.TypeSum(octosql.Float, octosql.Null),
+		Prototype:    NewVariancePrototype(true, false),
+	},
+}
+
+var StandardDeviationPopulationOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Int,
+		OutputType:   octosql.Float,
+		Prototype:    NewVariancePrototype(false, true),
+	},
+	{
+		ArgumentType: octosql.Float,
+		OutputType:   octosql.Float,
+		Prototype:    NewVariancePrototype(false, true),
+	},
+	{
+		ArgumentType: octosql.Duration,
+		OutputType:   octosql.Duration,
+		Prototype:    NewVariancePrototype(false, true),
+	},
+}
+
+var StandardDeviationSampleOverloads = []physical.AggregateDescriptor{
+	{
+		ArgumentType: octosql.Int,
+		OutputType:   octosql.TypeSum(octosql.Float, octosql.Null),
+		Prototype:    NewVariancePrototype(true, true),
+	},
+	{
+		ArgumentType: octosql.Float,
+		OutputType:   octosql.TypeSum(octosql.Float, octosql.Null),
+		Prototype:    NewVariancePrototype(true, true),
+	},
+	{
+		ArgumentType: octosql.Duration,
+		OutputType:   octosql.TypeSum(octosql.Duration, octosql.Null),
+		Prototype:    NewVariancePrototype(true, true),
+	},
+}
+
+// Variance uses Welford's online algorithm, which is numerically stable and can be reversed for retractions.
+type Variance struct {
+	count int
+	mean  float64
+	// m2 is the sum of squared differences from the mean.
+	m2 float64
+
+	sample   bool
+	stddev   bool
+	duration bool
+}
+
+func NewVariancePrototype(sample, stddev bool) func() nodes.Aggregate {
+	return func() nodes.Aggregate {
+		return &Variance{
+			sample: sample,
+			stddev: stddev,
+		}
+	}
+}
+
+func (c *Variance) Add(retraction bool, value octosql.Value) (bool, error) {
+	c.duration = value.TypeID == octosql.TypeIDDuration
+	x := numericValue(value)
+	if !retraction {
+		c.count++
+		delta := x - c.mean
+		c.mean += delta / float64(c.count)
+		c.m2 += delta * (x - c.mean)
+	} else {
+		c.count--
+		if c.count == 0 {
+			c.mean, c.m2 = 0, 0
+			return true, nil
+		}
+		oldMean := (c.mean*float64(c.count+1) - x) / float64(c.count)
+		c.m2 -= (x - oldMean) * (x - c.mean)
+		c.mean = oldMean
+	}
+	return c.count == 0, nil
+}
+
+func (c *Variance) Trigger() octosql.Value {
+	n := float64(c.count)
+	if c.sample {
+		if c.count < 2 {
+			return octosql.NewNull()
+		}
+		n--
+	}
+	// Retractions may leave a slightly negative rounding error.
+	variance := math.Max(c.m2/n, 0)
+	if !c.stddev {
+		return octosql.NewFloat(variance)
+	}
+	if c.duration {
+		return octosql.NewDuration(time.Duration(math.Sqrt(variance)))
+	}
+	return octosql.NewFloat(math.Sqrt(variance))
+}
+
+// numericValue returns the value as a float. Durations are converted to nanoseconds.
+func numericValue(value octosql.Value) float64 {
+	switch value.TypeID {
+	case octosql.TypeIDInt:
+		return float64(value.Int)
+	case octosql.TypeIDDuration:
+		return float64(value.Duration)
+	default:
+		return value.Float
+	}
+}
@@ -0,0 +1,475 @@
+package cmd
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cube2222/octosql/aggregates"
+	"github.com/cube2222/octosql/datasources/csv"
+	"github.com/cube2222/octosql/datasources/inference"
+	"github.com/cube2222/octosql/datasources/json"
+	"github.com/cube2222/octosql/datasources/parquet"
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/functions"
+	"github.com/cube2222/octosql/logical"
+	"github.com/cube2222/octosql/optimizer"
+	"github.com/cube2222/octosql/parser"
+	"github.com/cube2222/octosql/parser/sqlparser"
+	"github.com/cube2222/octosql/physical"
+	"github.com/cube2222/octosql/table_valued_functions"
+)
+
+// runQuery runs the query over the files in testdata and returns the resulting rows, sorted, with values separated by commas.
+// Output ordering and limits aren't applied.
+func runQuery(t *testing.T, query string, options inference.Options) ([]string, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	env := physical.Environment{
+		Aggregates: aggregates.Aggregates,
+		Functions:  functions.FunctionMap(),
+		Datasources: &physical.DatasourceRepository{
+			Databases: map[string]func() (physical.Database, error){},
+			FileHandlers: map[string]func(name string) (physical.DatasourceImplementation, physical.Schema, error){
+				"json":    json.NewCreator(options),
+				"csv":     csv.NewCreator(csv.DefaultDialect, options),
+				"parquet": parquet.Creator,
+			},
+		},
+		PhysicalConfig: map[string]interface{}{
+			inference.PhysicalConfigKey: options,
+		},
+	}
+	statement, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	logicalPlan, _, err := parser.ParseNode(statement.(sqlparser.SelectStatement), true)
+	require.NoError(t, err)
+
+	physicalPlan, _, err := typecheckNode(ctx, logicalPlan, env, logical.Environment{
+		CommonTableExpressions: map[string]logical.CommonTableExpression{},
+		TableValuedFunctions: map[string]logical.TableValuedFunctionDescription{
+			"range":  table_valued_functions.Range,
+			"tumble": table_valued_functions.Tumble,
+		},
+		UniqueNameGenerator: map[string]int{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	physicalPlan = optimizer.Optimize(physicalPlan)
+	executionPlan, err := physicalPlan.Materialize(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	if err := executionPlan.Run(
+		execution.ExecutionContext{Context: ctx},
+		func(ctx execution.ProduceContext, record execution.Record) error {
+			values := make([]string, len(record.Values))
+			for i := range record.Values {
+				values[i] = record.Values[i].String()
+			}
+			if record.Retraction {
+				counts[strings.Join(values, ", ")]--
+			} else {
+				counts[strings.Join(values, ", ")]++
+			}
+			return nil
+		},
+		func(ctx execution.ProduceContext, msg execution.MetadataMessage) error {
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	out := []string{}
+	for row, count := range counts {
+		for i := 0; i < count; i++ {
+			out = append(out, row)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func TestLookupJoin(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "inner",
+			query:    "SELECT u.id, o.amount FROM testdata/users.json u LOOKUP JOIN testdata/orders.json o ON o.user_id = u.id",
+			expected: []string{"1, 10", "1, 20", "3, 5"},
+		},
+		{
+			name:     "left",
+			query:    "SELECT u.id, o.amount FROM testdata/users.json u LOOKUP LEFT JOIN testdata/orders.json o ON o.user_id = u.id AND o.amount > 7.0",
+			expected: []string{"1, 10", "1, 20", "2, <null>", "3, <null>"},
+		},
+		{
+			name:     "left non-equality",
+			query:    "SELECT u.id, o.amount FROM testdata/users.json u LOOKUP LEFT JOIN testdata/orders.json o ON o.user_id > u.id",
+			expected: []string{"1, 5", "2, 5", "3, <null>"},
+		},
+		{
+			name:     "right",
+			query:    "SELECT u.id, o.amount FROM testdata/orders.json o LOOKUP RIGHT JOIN testdata/users.json u ON o.user_id = u.id",
+			expected: []string{"1, 10", "1, 20", "2, <null>", "3, 5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestSchemaMismatchModes(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		mode     inference.Mode
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "csv null",
+			query:    "SELECT id, score FROM testdata/mismatch.csv WHERE id > 10",
+			mode:     inference.ModeNull,
+			expected: []string{"11, <null>", "12, <null>"},
+		},
+		{
+			name:     "csv null arithmetic",
+			query:    "SELECT m.id, m.score + 1 FROM testdata/mismatch.csv m",
+			mode:     inference.ModeNull,
+			expected: []string{"1, 2", "11, <null>", "12, <null>", "2, 3", "3, 4"},
+		},
+		{
+			name:  "csv fail",
+			query: "SELECT id, score FROM testdata/mismatch.csv WHERE id > 10",
+			mode:  inference.ModeFail,
+			err:   true,
+		},
+		{
+			name:     "csv widen",
+			query:    "SELECT id, score FROM testdata/mismatch.csv WHERE id > 10",
+			mode:     inference.ModeWiden,
+			expected: []string{"11, 'x'", "12, 4.5"},
+		},
+		{
+			name:     "csv widen keeps matching columns",
+			query:    "SELECT id + 1, name FROM testdata/mismatch.csv WHERE id > 10",
+			mode:     inference.ModeWiden,
+			expected: []string{"12, 'd'", "13, 'e'"},
+		},
+		{
+			name:     "json widen",
+			query:    "SELECT id, a, b FROM testdata/mismatch.json WHERE id > 1.0",
+			mode:     inference.ModeWiden,
+			expected: []string{"2, 'y', <null>", "3, 5, true", "4, <null>, <null>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := inference.DefaultOptions
+			options.SampleSize = 2
+			options.Mode = tt.mode
+			rows, err := runQuery(t, tt.query, options)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "compare with time",
+			query:    "SELECT id, d < ts, ts >= d FROM testdata/dates.csv",
+			expected: []string{"1, true, true", "2, false, false", "3, false, true"},
+		},
+		{
+			name:     "mixed dates and times are times",
+			query:    "SELECT id, ts FROM testdata/dates.csv WHERE id = 3",
+			expected: []string{"3, 2021-05-01T00:00:00Z"},
+		},
+		{
+			name:     "time functions",
+			query:    "SELECT date_add('month', 1, d), extract('month', d), date_trunc('month', d), date_diff('day', d, ts) FROM testdata/dates.csv WHERE id = 1",
+			expected: []string{"2021-02-28, 1, 2021-01-01, 1"},
+		},
+		{
+			name:     "min and max",
+			query:    "SELECT min(d), max(d) FROM testdata/dates.csv",
+			expected: []string{"2021-01-31, 2021-05-01"},
+		},
+		{
+			name:  "add smaller units",
+			query: "SELECT date_add('hour', 1, d) FROM testdata/dates.csv",
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "add to dates",
+			query:    "SELECT d + INTERVAL 1 DAY, d + INTERVAL 1 MONTH, d - INTERVAL 1 WEEK, INTERVAL 1 DAY + d FROM testdata/dates.csv WHERE id = 1",
+			expected: []string{"2021-02-01, 2021-02-28, 2021-01-24, 2021-02-01"},
+		},
+		{
+			name:     "compare with durations",
+			query:    "SELECT INTERVAL 24 HOUR = INTERVAL 1 DAY, INTERVAL 25 HOUR > INTERVAL 1 DAY, INTERVAL 40 DAY > INTERVAL 1 MONTH FROM testdata/dates.csv WHERE id = 1",
+			expected: []string{"true, true, true"},
+		},
+		{
+			name:     "window length and delay in days",
+			query:    "SELECT window_end, COUNT(*) FROM tumble(source=>TABLE(testdata/dates.csv), time_field=>DESCRIPTOR(ts), window_length=>INTERVAL 1 DAY) c GROUP BY window_end TRIGGER AFTER DELAY INTERVAL 1 DAY",
+			expected: []string{"2021-02-02T00:00:00Z, 1", "2021-03-02T00:00:00Z, 1", "2021-05-02T00:00:00Z, 1"},
+		},
+		{
+			name:  "delay in months",
+			query: "SELECT window_end, COUNT(*) FROM tumble(source=>TABLE(testdata/dates.csv), time_field=>DESCRIPTOR(ts), window_length=>INTERVAL 1 DAY) c GROUP BY window_end TRIGGER AFTER DELAY INTERVAL 1 MONTH",
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestLists(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "lambdas",
+			query:    "SELECT id, transform(tags, t -> upper(t)), filter(tags, t -> t != 'internal') FROM testdata/tags.json",
+			expected: []string{"1, ['A', 'INTERNAL', 'B'], ['a', 'b']", "2, ['INTERNAL'], []", "3, <null>, <null>"},
+		},
+		{
+			name:     "concat nulls",
+			query:    "SELECT id, array_concat(tags, tags), array_concat(tags, NULL), array_concat(NULL, NULL) FROM testdata/tags.json WHERE id > 1.0",
+			expected: []string{"2, ['internal', 'internal'], ['internal'], <null>", "3, <null>, <null>, <null>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestFieldAccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "dotted names",
+			query:    "SELECT p.id, p.payload.user.id, payload.user.name, (payload).n FROM testdata/payloads.json p",
+			expected: []string{"1, 7, 'x', 2", "2, 8, 'y', 3"},
+		},
+		{
+			name:     "structure star",
+			query:    "SELECT payload.user.* FROM testdata/payloads.json p",
+			expected: []string{"7, 'x'", "8, 'y'"},
+		},
+		{
+			name:     "table star",
+			query:    "SELECT p.* FROM testdata/payloads.json p WHERE p.payload.n > 2.0",
+			expected: []string{"2, { 3, { 8, 'y' } }"},
+		},
+		{
+			name:     "nested structure star",
+			query:    "SELECT p.payload.user.address.* FROM testdata/nested_payloads.json p",
+			expected: []string{"'Berlin', { 52.5, 13.4 }", "'Warsaw', { 52.2, 21 }"},
+		},
+		{
+			name:     "deeply nested structure star",
+			query:    "SELECT payload.user.address.geo.*, p.id FROM testdata/nested_payloads.json p",
+			expected: []string{"52.2, 21, 1", "52.5, 13.4, 2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestDecimals(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "float operands",
+			query:    "SELECT decimal('0.10') > 0.05, decimal('0.10') + 0.05, 0.05 * decimal('2.5'), decimal('0.15') < 0.15 FROM testdata/users.json u WHERE u.id = 1.0",
+			expected: []string{"true, 0.15, 0.125, false"},
+		},
+		{
+			name:     "overflowing conversions",
+			query:    "SELECT decimal(1e300), decimal('1e400'), decimal(12.5) FROM testdata/users.json u WHERE u.id = 1.0",
+			expected: []string{"<null>, <null>, 12.5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "unordered",
+			query:    "SELECT COUNT(*) FROM (SELECT * FROM testdata/orders.json LIMIT 2 OFFSET 1) o",
+			expected: []string{"2"},
+		},
+		{
+			name:     "ordered",
+			query:    "SELECT o.amount FROM (SELECT * FROM testdata/orders.json ORDER BY amount DESC LIMIT 2) o",
+			expected: []string{"10", "20"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestAggregatesWithoutRetractions(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "semi join",
+			query:    "SELECT approx_count_distinct(u.id) FROM testdata/users.json u WHERE u.id IN (SELECT o.user_id FROM testdata/orders.json o)",
+			expected: []string{"2"},
+		},
+		{
+			name:  "anti join",
+			query: "SELECT approx_count_distinct(u.id) FROM testdata/users.json u WHERE NOT EXISTS (SELECT * FROM testdata/orders.json o WHERE o.user_id = u.id)",
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "union all",
+			query:    "SELECT u.id FROM testdata/users.json u UNION ALL SELECT o.user_id FROM testdata/orders.json o",
+			expected: []string{"1", "1", "1", "2", "3", "3"},
+		},
+		{
+			name:     "union",
+			query:    "SELECT u.id FROM testdata/users.json u UNION SELECT o.user_id FROM testdata/orders.json o",
+			expected: []string{"1", "2", "3"},
+		},
+		{
+			name:     "chained",
+			query:    "(SELECT u.id FROM testdata/users.json u) UNION ALL (SELECT o.user_id FROM testdata/orders.json o) UNION ALL (SELECT u.id FROM testdata/users.json u WHERE u.id = 2.0)",
+			expected: []string{"1", "1", "1", "2", "2", "3", "3"},
+		},
+		{
+			name:     "union all with retractions",
+			query:    "SELECT o.user_id, COUNT(*) FROM testdata/orders.json o GROUP BY o.user_id TRIGGER COUNTING 1 UNION ALL SELECT u.id, 0 FROM testdata/users.json u",
+			expected: []string{"1, 0", "1, 2", "2, 0", "3, 0", "3, 1"},
+		},
+		{
+			name:     "union with retractions",
+			query:    "SELECT o.user_id, COUNT(*) FROM testdata/orders.json o GROUP BY o.user_id TRIGGER COUNTING 1 UNION SELECT u.id, 1 FROM testdata/users.json u",
+			expected: []string{"1, 1", "1, 2", "2, 1", "3, 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := runQuery(t, tt.query, inference.DefaultOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
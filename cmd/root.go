@@ -19,7 +19,9 @@ import (
 	"github.com/cube2222/octosql/config"
 	"github.com/cube2222/octosql/datasources/csv"
 	"github.com/cube2222/octosql/datasources/docs"
+	"github.com/cube2222/octosql/datasources/inference"
 	"github.com/cube2222/octosql/datasources/json"
+	"github.com/cube2222/octosql/datasources/parquet"
 	"github.com/cube2222/octosql/datasources/plugins"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/execution/nodes"
@@ -57,6 +59,7 @@ var rootCmd = &cobra.Command{
 	Long:  ``,
 	Example: `octosql "SELECT * FROM myfile.json"
 octosql "SELECT * FROM mydir/myfile.csv"
+octosql "SELECT * FROM mydir/myfile.parquet"
 octosql "SELECT * FROM plugins.plugins"`,
 	SilenceErrors: true,
 	Version:       VERSION,
@@ -164,17 +167,33 @@ octosql "SELECT * FROM plugins.plugins"`,
 				return db, nil
 			}
 		}
+		schemaMismatchMode, err := inference.ParseMode(schemaMismatch)
+		if err != nil {
+			return err
+		}
+		inferenceOptions := inference.Options{
+			SampleSize: schemaSampleSize,
+			Mode:       schemaMismatchMode,
+			Decimals:   schemaDecimals,
+			UnixTimes:  schemaUnixTimes,
+		}
+
 		env := physical.Environment{
 			Aggregates: aggregates.Aggregates,
 			Functions:  functions.FunctionMap(),
 			Datasources: &physical.DatasourceRepository{
 				Databases: databases,
 				FileHandlers: map[string]func(name string) (physical.DatasourceImplementation, physical.Schema, error){
-					"json": json.Creator,
-					"csv":  csv.Creator,
+					"json":    json.NewCreator(inferenceOptions),
+					"csv":     csv.NewCreator(csv.DefaultDialect, inferenceOptions),
+					"tsv":     csv.NewCreator(csv.Dialect{Delimiter: '\t', HasHeader: true}, inferenceOptions),
+					"psv":     csv.NewCreator(csv.Dialect{Delimiter: '|', HasHeader: true}, inferenceOptions),
+					"parquet": parquet.Creator,
 				},
 			},
-			PhysicalConfig:  nil,
+			PhysicalConfig: map[string]interface{}{
+				inference.PhysicalConfigKey: inferenceOptions,
+			},
 			VariableContext: nil,
 		}
 		statement, err := sqlparser.Parse(args[0])
@@ -190,6 +209,7 @@ octosql "SELECT * FROM plugins.plugins"`,
 			"tumble":             table_valued_functions.Tumble,
 			"range":              table_valued_functions.Range,
 			"poll":               table_valued_functions.Poll,
+			"csv":                table_valued_functions.CSV,
 		}
 		uniqueNameGenerator := map[string]int{}
 		physicalPlan, mapping, err := typecheckNode(
@@ -328,16 +348,22 @@ octosql "SELECT * FROM plugins.plugins"`,
 				false,
 			)
 		case "stream_native":
-			if len(orderByExpressions) > 0 {
+			if outputOptions.Limit > 0 {
+				executionPlan = nodes.NewLimit(
+					executionPlan,
+					orderByExpressions,
+					logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
+					outputOptions.Limit,
+					0,
+					physicalPlan.MayContainRetractions(),
+				)
+			} else if len(orderByExpressions) > 0 {
 				executionPlan = nodes.NewBatchOrderBy(
 					executionPlan,
 					orderByExpressions,
 					logical.DirectionsToMultipliers(outputOptions.OrderByDirections),
 				)
 			}
-			if outputOptions.Limit > 0 {
-				return fmt.Errorf("LIMIT clause not supported with stream output")
-			}
 
 			sink = stream.NewOutputPrinter(
 				executionPlan,
@@ -367,12 +393,20 @@ var describe bool
 var explain int
 var optimize bool
 var output string
+var schemaSampleSize int
+var schemaMismatch string
+var schemaDecimals bool
+var schemaUnixTimes bool
 
 func init() {
 	rootCmd.Flags().BoolVar(&describe, "describe", false, "Describe query output schema.")
 	rootCmd.Flags().IntVar(&explain, "explain", 0, "Describe query output schema.")
 	rootCmd.Flags().BoolVar(&optimize, "optimize", true, "Whether OctoSQL should optimize the query.")
 	rootCmd.Flags().StringVar(&output, "output", "live_table", "Output format to use. Available options are live_table, batch_table, csv and stream_native.")
+	rootCmd.Flags().IntVar(&schemaSampleSize, "schema-sample-size", inference.DefaultOptions.SampleSize, "Number of records used to infer the schema of files, -1 to use the whole file.")
+	rootCmd.Flags().StringVar(&schemaMismatch, "schema-mismatch", inference.DefaultOptions.Mode.String(), "What to do with file values which don't match the inferred schema. Available options are null, fail and widen.")
+	rootCmd.Flags().BoolVar(&schemaDecimals, "schema-decimals", inference.DefaultOptions.Decimals, "Infer numbers with a fractional part, and numeric strings in JSON files, as exact decimals instead of floats.")
+	rootCmd.Flags().BoolVar(&schemaUnixTimes, "schema-unix-times", inference.DefaultOptions.UnixTimes, "Infer integers which look like unix timestamps in seconds or milliseconds, between the years 2001 and 2286, as times.")
 }
 
 func typecheckNode(ctx context.Context, node logical.Node, env physical.Environment, logicalEnv logical.Environment) (_ physical.Node, _ map[string]string, outErr error) {
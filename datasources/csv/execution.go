@@ -2,21 +2,32 @@ package csv
 
 import (
 	"bufio"
-	"encoding/csv"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
 	"time"
 
+	"github.com/cube2222/octosql/datasources/inference"
 	. "github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
 type DatasourceExecuting struct {
-	path   string
-	fields []physical.SchemaField
+	path    string
+	dialect Dialect
+	mode    inference.Mode
+	fields  []physical.SchemaField
+	// timeField is the index of the event time field, -1 if there is none.
+	timeField   int
+	timeFormats []string
+	// columnIndices are the indices of the fields' columns in the file.
+	columnIndices []int
+
+	predicates []Expression
+	// predicateFields are the indices of the fields used by the predicates.
+	predicateFields []int
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
@@ -26,28 +37,20 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 	}
 	defer f.Close()
 
-	usedColumns := map[string]bool{}
-	for i := range d.fields {
-		usedColumns[d.fields[i].Name] = true
-	}
-
-	decoder := csv.NewReader(bufio.NewReaderSize(f, 4096*1024))
-	decoder.Comma = ','
-	decoder.ReuseRecord = true
-	columnNames, err := decoder.Read()
-	if err != nil {
-		return fmt.Errorf("couldn't decode csv header row: %w", err)
+	decoder := d.dialect.newReader(bufio.NewReaderSize(f, 4096*1024))
+	if d.dialect.HasHeader {
+		if _, err := decoder.Read(); err != nil {
+			return fmt.Errorf("couldn't decode csv header row: %w", err)
+		}
 	}
 
-	indicesToRead := make([]int, 0)
-	for i := range columnNames {
-		if usedColumns[columnNames[i]] {
-			indicesToRead = append(indicesToRead, i)
-		}
+	isPredicateField := make([]bool, len(d.fields))
+	for _, fieldIndex := range d.predicateFields {
+		isPredicateField[fieldIndex] = true
 	}
 
-	// TODO: Fix CSV with limited schema pushed down.
-	for {
+rowLoop:
+	for line := 1; ; line++ {
 		row, err := decoder.Read()
 		if err == io.EOF {
 			break
@@ -55,48 +58,123 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 			return fmt.Errorf("couldn't decode message: %w", err)
 		}
 
-		values := make([]octosql.Value, len(indicesToRead))
-		for i, columnIndex := range indicesToRead {
-			str := row[columnIndex]
-			if octosql.Int.Is(d.fields[i].Type) == octosql.TypeRelationIs {
-				integer, err := strconv.ParseInt(str, 10, 64)
-				if err == nil {
-					values[i] = octosql.NewInt(int(integer))
-					continue
-				}
-			}
+		values := make([]octosql.Value, len(d.columnIndices))
 
-			if octosql.Float.Is(d.fields[i].Type) == octosql.TypeRelationIs {
-				float, err := strconv.ParseFloat(str, 64)
-				if err == nil {
-					values[i] = octosql.NewFloat(float)
-					continue
+		// We first only parse the fields needed by the predicates, so that filtered out rows are cheap.
+		if len(d.predicates) > 0 {
+			for _, fieldIndex := range d.predicateFields {
+				if values[fieldIndex], err = d.parseValue(row[d.columnIndices[fieldIndex]], fieldIndex, line); err != nil {
+					return err
 				}
 			}
-
-			if octosql.Boolean.Is(d.fields[i].Type) == octosql.TypeRelationIs {
-				b, err := strconv.ParseBool(str)
-				if err == nil {
-					values[i] = octosql.NewBoolean(b)
-					continue
+			predicateCtx := ctx.WithRecord(NewRecord(values, false, time.Time{}))
+			for _, predicate := range d.predicates {
+				ok, err := predicate.Evaluate(predicateCtx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate pushed down predicate: %w", err)
+				}
+				if ok.TypeID != octosql.TypeIDBoolean || !ok.Boolean {
+					continue rowLoop
 				}
 			}
+		}
 
-			if octosql.Time.Is(d.fields[i].Type) == octosql.TypeRelationIs {
-				t, err := time.Parse(time.RFC3339Nano, str)
-				if err == nil {
-					values[i] = octosql.NewTime(t)
-					continue
-				}
+		for i, columnIndex := range d.columnIndices {
+			if isPredicateField[i] {
+				continue
 			}
+			if values[i], err = d.parseValue(row[columnIndex], i, line); err != nil {
+				return err
+			}
+		}
 
-			values[i] = octosql.NewString(str)
+		var eventTime time.Time
+		if d.timeField != -1 {
+			eventTime = values[d.timeField].Time
 		}
 
-		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, eventTime)); err != nil {
 			return fmt.Errorf("couldn't produce record: %w", err)
 		}
 	}
 
 	return nil
 }
+
+func (d *DatasourceExecuting) parseValue(str string, fieldIndex int, line int) (octosql.Value, error) {
+	value, ok := parseValue(str, d.fields[fieldIndex].Type, d.timeFormats[fieldIndex])
+	if !ok && d.mode == inference.ModeFail {
+		return octosql.ZeroValue, fmt.Errorf("value of column %s in row %d doesn't match the inferred type %s: '%s'", d.fields[fieldIndex].Name, line, d.fields[fieldIndex].Type, str)
+	}
+	return value, nil
+}
+
+// parseValue parses the value as the given type, falling back to a string if that fails.
+// If the type doesn't contain strings either, the value doesn't match it and null is returned.
+func parseValue(str string, t octosql.Type, timeFormat string) (octosql.Value, bool) {
+	if str == "" && octosql.Null.Is(t) == octosql.TypeRelationIs {
+		return octosql.NewNull(), true
+	}
+
+	if octosql.Int.Is(t) == octosql.TypeRelationIs {
+		integer, err := strconv.ParseInt(str, 10, 64)
+		if err == nil {
+			return octosql.NewInt(int(integer)), true
+		}
+	}
+
+	if decimalType, ok := t.DecimalAlternative(); ok {
+		if decimal, err := octosql.ParseDecimal(str); err == nil {
+			if decimal, ok := decimal.ToType(decimalType); ok {
+				return octosql.NewDecimal(decimal), true
+			}
+		}
+	}
+
+	if octosql.Float.Is(t) == octosql.TypeRelationIs {
+		float, err := strconv.ParseFloat(str, 64)
+		if err == nil {
+			return octosql.NewFloat(float), true
+		}
+	}
+
+	if octosql.Boolean.Is(t) == octosql.TypeRelationIs {
+		b, err := strconv.ParseBool(str)
+		if err == nil {
+			return octosql.NewBoolean(b), true
+		}
+	}
+
+	if octosql.Date.Is(t) == octosql.TypeRelationIs {
+		parsed, err := inference.ParseDate(timeFormat, str)
+		if err == nil {
+			return octosql.NewDate(parsed), true
+		}
+	}
+
+	if octosql.Time.Is(t) == octosql.TypeRelationIs {
+		parsed, err := inference.ParseTime(timeFormat, str)
+		if err == nil {
+			return octosql.NewTime(parsed), true
+		}
+	}
+
+	if octosql.Duration.Is(t) == octosql.TypeRelationIs {
+		parsed, err := time.ParseDuration(str)
+		if err == nil {
+			return octosql.NewDuration(parsed), true
+		}
+	}
+
+	if octosql.Interval.Is(t) == octosql.TypeRelationIs {
+		parsed, err := octosql.ParseInterval(str)
+		if err == nil {
+			return octosql.NewInterval(parsed), true
+		}
+	}
+
+	if octosql.String.Is(t) == octosql.TypeRelationIs {
+		return octosql.NewString(str), true
+	}
+	return octosql.NewNull(), false
+}
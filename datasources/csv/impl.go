@@ -7,99 +7,141 @@ import (
 	"io"
 	"os"
 	"strconv"
-	"time"
 
+	"github.com/cube2222/octosql/datasources/inference"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
+// Dialect describes the format of a CSV file.
+type Dialect struct {
+	Delimiter rune
+	// HasHeader specifies whether the first row contains the column names.
+	// Otherwise, the columns are named col_1, col_2, etc.
+	HasHeader  bool
+	Comment    rune
+	LazyQuotes bool
+}
+
+var DefaultDialect = Dialect{
+	Delimiter: ',',
+	HasHeader: true,
+}
+
+func (dialect Dialect) newReader(r io.Reader) *csv.Reader {
+	decoder := csv.NewReader(r)
+	decoder.Comma = dialect.Delimiter
+	decoder.Comment = dialect.Comment
+	decoder.LazyQuotes = dialect.LazyQuotes
+	decoder.ReuseRecord = true
+	return decoder
+}
+
 func Creator(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+	return NewCreator(DefaultDialect, inference.DefaultOptions)(name)
+}
+
+func NewCreator(dialect Dialect, options inference.Options) func(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+	return func(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+		return create(name, dialect, options)
+	}
+}
+
+func create(name string, dialect Dialect, options inference.Options) (physical.DatasourceImplementation, physical.Schema, error) {
 	f, err := os.Open(name)
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open file: %w", err)
 	}
 	defer f.Close()
 
-	decoder := csv.NewReader(f)
-	decoder.Comma = ','
-	decoder.ReuseRecord = true
+	decoder := dialect.newReader(f)
 	row, err := decoder.Read()
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't decode csv header row: %w", err)
 	}
 	fieldNames := make([]string, len(row))
-	copy(fieldNames, row)
+	if dialect.HasHeader {
+		copy(fieldNames, row)
+	}
+	fieldNames = getFieldNames(fieldNames)
+
+	declared, err := inference.ReadSchemaFile(name)
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+	if declared != nil {
+		if !dialect.HasHeader {
+			// Without a header, the declared fields name the columns in order.
+			if len(declared.Fields) > len(fieldNames) {
+				return nil, physical.Schema{}, fmt.Errorf("schema file declares %d fields, but the file has only %d columns", len(declared.Fields), len(fieldNames))
+			}
+			for i := range declared.Fields {
+				fieldNames[i] = declared.Fields[i].Name
+			}
+		}
+		for _, field := range declared.Fields {
+			found := false
+			for _, name := range fieldNames {
+				if name == field.Name {
+					found = true
+				}
+			}
+			if !found {
+				return nil, physical.Schema{}, fmt.Errorf("declared field %s not found in csv header", field.Name)
+			}
+		}
+
+		return &impl{
+				path:       name,
+				dialect:    dialect,
+				mode:       options.Mode,
+				fieldNames: fieldNames,
+				declared:   declared,
+			},
+			declared.Schema(),
+			nil
+	}
 
 	fields := make([]octosql.Type, len(fieldNames))
 	filled := make([]bool, len(fieldNames))
-	for i := 0; i < 10; i++ {
+	rowCount := 0
+	if !dialect.HasHeader {
+		inferRowTypes(fields, filled, row, options)
+		rowCount++
+	}
+	readWholeFile := false
+	for ; options.ShouldRead(rowCount); rowCount++ {
 		row, err = decoder.Read()
 		if err == io.EOF {
+			readWholeFile = true
 			break
 		} else if err != nil {
 			return nil, physical.Schema{}, fmt.Errorf("couldn't decode message: %w", err)
 		}
-
+		if options.ShouldSample(rowCount) {
+			inferRowTypes(fields, filled, row, options)
+			continue
+		}
 		for i := range row {
-			str := row[i]
-			_, err := strconv.ParseInt(str, 10, 64)
-			if err == nil {
-				if !filled[i] {
-					fields[i] = octosql.Int
-					filled[i] = true
-				} else if !fields[i].Equals(octosql.Float) {
-					fields[i] = octosql.TypeSum(fields[i], octosql.Int)
-				}
-				continue
-			}
-
-			_, err = strconv.ParseFloat(str, 64)
-			if err == nil {
-				if !filled[i] {
-					fields[i] = octosql.Float
-					filled[i] = true
-				} else if fields[i].Equals(octosql.Int) {
-					fields[i] = octosql.Float
-				} else {
-					fields[i] = octosql.TypeSum(fields[i], octosql.Float)
-				}
-				continue
-			}
-
-			_, err = strconv.ParseBool(str)
-			if err == nil {
-				if !filled[i] {
-					fields[i] = octosql.Boolean
-					filled[i] = true
-				} else {
-					fields[i] = octosql.TypeSum(fields[i], octosql.Boolean)
-				}
-				continue
-			}
-
-			_, err = time.Parse(time.RFC3339Nano, str)
-			if err == nil {
-				if !filled[i] {
-					fields[i] = octosql.Time
-					filled[i] = true
-				} else {
-					fields[i] = octosql.TypeSum(fields[i], octosql.Time)
-				}
-				continue
-			}
-
-			if !filled[i] {
-				fields[i] = octosql.String
-				filled[i] = true
-			} else {
-				fields[i] = octosql.TypeSum(fields[i], octosql.String)
+			// Values which only match the type as a string fallback, like 4.5 in an Int | String column, widen it too.
+			if value, ok := parseValue(row[i], fields[i], ""); !ok || value.TypeID == octosql.TypeIDString && !fields[i].Equals(octosql.String) {
+				inferValueType(fields, filled, i, row[i], options)
 			}
 		}
 	}
+	if !readWholeFile {
+		if _, err := decoder.Read(); err == io.EOF {
+			readWholeFile = true
+		}
+	}
 
 	schemaFields := make([]physical.SchemaField, len(fields))
 	for i := range fields {
+		if options.Mode == inference.ModeNull && !readWholeFile {
+			// Values in the rest of the file which don't match the inferred type are read as nulls.
+			fields[i] = octosql.TypeSum(fields[i], octosql.Null)
+		}
 		schemaFields[i] = physical.SchemaField{
 			Name: fieldNames[i],
 			Type: fields[i],
@@ -107,23 +149,233 @@ func Creator(name string) (physical.DatasourceImplementation, physical.Schema, e
 	}
 
 	return &impl{
-			path: name,
+			path:       name,
+			dialect:    dialect,
+			mode:       options.Mode,
+			fieldNames: fieldNames,
 		},
 		physical.NewSchema(schemaFields, -1),
 		nil
 }
 
+func inferRowTypes(fields []octosql.Type, filled []bool, row []string, options inference.Options) {
+	for i := range row {
+		inferValueType(fields, filled, i, row[i], options)
+	}
+}
+
+func inferValueType(fields []octosql.Type, filled []bool, i int, str string, options inference.Options) {
+	integer, err := strconv.ParseInt(str, 10, 64)
+	if err == nil && options.UnixTimes && inference.IsUnixTime(float64(integer)) {
+		if !filled[i] {
+			fields[i] = octosql.Time
+			filled[i] = true
+		} else {
+			fields[i] = inference.TypeSum(fields[i], octosql.Time)
+		}
+		return
+	}
+	if err == nil {
+		if !filled[i] {
+			fields[i] = octosql.Int
+			filled[i] = true
+		} else if !fields[i].Equals(octosql.Float) && fields[i].TypeID != octosql.TypeIDDecimal {
+			fields[i] = octosql.TypeSum(fields[i], octosql.Int)
+		}
+		return
+	}
+
+	if options.Decimals {
+		if decimal, err := octosql.ParseDecimal(str); err == nil {
+			t := inference.DecimalType(decimal)
+			if !filled[i] || fields[i].Equals(octosql.Int) {
+				fields[i] = t
+				filled[i] = true
+			} else {
+				fields[i] = octosql.TypeSum(fields[i], t)
+			}
+			return
+		}
+	}
+
+	_, err = strconv.ParseFloat(str, 64)
+	if err == nil {
+		if !filled[i] {
+			fields[i] = octosql.Float
+			filled[i] = true
+		} else if fields[i].Equals(octosql.Int) {
+			fields[i] = octosql.Float
+		} else {
+			fields[i] = octosql.TypeSum(fields[i], octosql.Float)
+		}
+		return
+	}
+
+	_, err = strconv.ParseBool(str)
+	if err == nil {
+		if !filled[i] {
+			fields[i] = octosql.Boolean
+			filled[i] = true
+		} else {
+			fields[i] = octosql.TypeSum(fields[i], octosql.Boolean)
+		}
+		return
+	}
+
+	if t, ok := inference.InferTimeType(str); ok {
+		if !filled[i] {
+			fields[i] = t
+			filled[i] = true
+		} else {
+			fields[i] = inference.TypeSum(fields[i], t)
+		}
+		return
+	}
+
+	if !filled[i] {
+		fields[i] = octosql.String
+		filled[i] = true
+	} else {
+		fields[i] = octosql.TypeSum(fields[i], octosql.String)
+	}
+}
+
 type impl struct {
-	path string
+	path       string
+	dialect    Dialect
+	mode       inference.Mode
+	fieldNames []string
+	declared   *inference.DeclaredSchema
+}
+
+// getFieldNames generates names for columns with an empty name and deduplicates the rest.
+func getFieldNames(names []string) []string {
+	out := make([]string, len(names))
+	existingFields := make(map[string]int)
+	for i, name := range names {
+		if name == "" {
+			name = fmt.Sprintf("col_%d", i+1)
+		}
+		existingCount := existingFields[name]
+		if existingCount > 0 {
+			name = fmt.Sprintf("%s_%d", name, existingCount)
+		}
+		existingFields[name] = existingCount + 1
+		out[i] = name
+	}
+	return out
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
+	columnIndices := make([]int, len(schema.Fields))
+	for j := range schema.Fields {
+		columnIndices[j] = -1
+		for columnIndex, name := range i.fieldNames {
+			if name == schema.Fields[j].Name {
+				columnIndices[j] = columnIndex
+			}
+		}
+		if columnIndices[j] == -1 {
+			return nil, fmt.Errorf("no such column: %s", schema.Fields[j].Name)
+		}
+	}
+
+	predicates := make([]execution.Expression, len(pushedDownPredicates))
+	var predicateFields []int
+	for j := range pushedDownPredicates {
+		predicate, err := pushedDownPredicates[j].Materialize(ctx, env.WithRecordSchema(schema))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize pushed down predicate: %w", err)
+		}
+		predicates[j] = predicate
+
+		for _, arg := range pushedDownPredicates[j].FunctionCall.Arguments {
+			if arg.ExpressionType != physical.ExpressionTypeVariable || !arg.Variable.IsLevel0 {
+				continue
+			}
+			for fieldIndex := range schema.Fields {
+				if schema.Fields[fieldIndex].Name == arg.Variable.Name {
+					predicateFields = append(predicateFields, fieldIndex)
+				}
+			}
+		}
+	}
+
+	timeFormats := make([]string, len(schema.Fields))
+	if i.declared != nil {
+		timeFormats = i.declared.TimeFormats(schema.Fields)
+	}
+
 	return &DatasourceExecuting{
-		path:   i.path,
-		fields: schema.Fields,
+		path:            i.path,
+		dialect:         i.dialect,
+		mode:            i.mode,
+		fields:          schema.Fields,
+		timeField:       schema.TimeField,
+		timeFormats:     timeFormats,
+		columnIndices:   columnIndices,
+		predicates:      predicates,
+		predicateFields: predicateFields,
 	}, nil
 }
 
+var comparisonFunctions = map[string]bool{
+	"=":  true,
+	"!=": true,
+	"<":  true,
+	"<=": true,
+	">":  true,
+	">=": true,
+}
+
 func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
-	return newPredicates, []physical.Expression{}, false
+	rejected = []physical.Expression{}
+	pushedDown = append([]physical.Expression{}, pushedDownPredicates...)
+	for _, predicate := range newPredicates {
+		if i.isSimpleComparison(predicate) {
+			pushedDown = append(pushedDown, predicate)
+			changed = true
+		} else {
+			rejected = append(rejected, predicate)
+		}
+	}
+	return rejected, pushedDown, changed
+}
+
+// isSimpleComparison checks whether the predicate compares a single column with a constant or a variable from an outer scope.
+func (i *impl) isSimpleComparison(predicate physical.Expression) bool {
+	if predicate.ExpressionType != physical.ExpressionTypeFunctionCall || !comparisonFunctions[predicate.FunctionCall.Name] {
+		return false
+	}
+	args := predicate.FunctionCall.Arguments
+	if len(args) != 2 {
+		return false
+	}
+
+	columns := 0
+	for _, arg := range args {
+		switch arg.ExpressionType {
+		case physical.ExpressionTypeConstant:
+		case physical.ExpressionTypeVariable:
+			if !arg.Variable.IsLevel0 {
+				continue
+			}
+			if !i.hasField(arg.Variable.Name) {
+				return false
+			}
+			columns++
+		default:
+			return false
+		}
+	}
+	return columns == 1
+}
+
+func (i *impl) hasField(name string) bool {
+	for _, fieldName := range i.fieldNames {
+		if fieldName == name {
+			return true
+		}
+	}
+	return false
 }
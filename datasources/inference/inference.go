@@ -0,0 +1,87 @@
+package inference
+
+import (
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// Options configure the schema inference of file datasources.
+type Options struct {
+	// SampleSize is the number of records used to infer the schema, a negative value means the whole file.
+	SampleSize int
+	Mode       Mode
+	// Decimals makes numbers with a fractional part, and numeric strings in JSON, inferred as exact decimals instead of floats.
+	Decimals bool
+	// UnixTimes makes integers which look like unix timestamps in seconds or milliseconds inferred as times, see IsUnixTime.
+	UnixTimes bool
+}
+
+var DefaultOptions = Options{
+	SampleSize: 10,
+	Mode:       ModeNull,
+}
+
+// PhysicalConfigKey is the key under which Options may be stored in the physical environment config.
+const PhysicalConfigKey = "file_schema_inference"
+
+// FromPhysicalConfig returns the options stored in the physical environment config, or the default options.
+func FromPhysicalConfig(config map[string]interface{}) Options {
+	if options, ok := config[PhysicalConfigKey].(Options); ok {
+		return options
+	}
+	return DefaultOptions
+}
+
+// ShouldSample checks whether the record with the given index should be used to infer the schema.
+func (options Options) ShouldSample(index int) bool {
+	return options.SampleSize < 0 || index < options.SampleSize
+}
+
+// ShouldRead checks whether the record with the given index should be read during schema inference.
+// In widen mode, records past the sample are read to widen the types with values which don't match them.
+func (options Options) ShouldRead(index int) bool {
+	return options.ShouldSample(index) || options.Mode == ModeWiden
+}
+
+// DecimalType returns the type inferred for the decimal, which keeps its scale and allows any integer part.
+func DecimalType(d octosql.DecimalNumber) octosql.Type {
+	return octosql.NewDecimalType(octosql.MaxDecimalPrecision, d.Scale)
+}
+
+// Mode specifies what happens with values that don't match the inferred schema.
+type Mode int
+
+const (
+	// ModeNull reads values which don't match the schema as null.
+	ModeNull Mode = iota
+	// ModeFail fails on values which don't match the schema.
+	ModeFail
+	// ModeWiden reads the whole file during schema inference, widening the types sampled from the first records
+	// with the types of later values which don't match them.
+	ModeWiden
+)
+
+func ParseMode(str string) (Mode, error) {
+	switch str {
+	case "null":
+		return ModeNull, nil
+	case "fail":
+		return ModeFail, nil
+	case "widen":
+		return ModeWiden, nil
+	}
+	return 0, fmt.Errorf("invalid schema mismatch mode '%s', available modes are null, fail and widen", str)
+}
+
+func (mode Mode) String() string {
+	switch mode {
+	case ModeNull:
+		return "null"
+	case ModeFail:
+		return "fail"
+	case ModeWiden:
+		return "widen"
+	}
+	return "unknown"
+}
@@ -0,0 +1,166 @@
+package inference
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// DeclaredSchema is a schema declared explicitly in a sidecar file, which overrides schema inference.
+type DeclaredSchema struct {
+	Fields    []DeclaredField
+	TimeField int
+}
+
+type DeclaredField struct {
+	Name string
+	Type octosql.Type
+	// TimeFormat is used to parse time values, see ParseTime.
+	TimeFormat string
+}
+
+type schemaFile struct {
+	Fields []struct {
+		Name       string `yaml:"name"`
+		Type       string `yaml:"type"`
+		TimeFormat string `yaml:"time_format"`
+	} `yaml:"fields"`
+	TimeField string `yaml:"time_field"`
+}
+
+// SchemaFilePath returns the path of the schema sidecar file for the given file, i.e. data.schema.yml for data.csv.
+func SchemaFilePath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".schema.yml"
+}
+
+// ReadSchemaFile reads the schema sidecar file for the given file. It returns nil if there is none.
+func ReadSchemaFile(path string) (*DeclaredSchema, error) {
+	schemaPath := SchemaFilePath(path)
+	data, err := ioutil.ReadFile(schemaPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("couldn't read schema file: %w", err)
+	}
+
+	var file schemaFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("couldn't decode schema file %s: %w", schemaPath, err)
+	}
+	if len(file.Fields) == 0 {
+		return nil, fmt.Errorf("schema file %s declares no fields", schemaPath)
+	}
+
+	out := &DeclaredSchema{
+		Fields:    make([]DeclaredField, len(file.Fields)),
+		TimeField: -1,
+	}
+	for i, field := range file.Fields {
+		if field.Name == "" {
+			return nil, fmt.Errorf("field %d in schema file %s has no name", i, schemaPath)
+		}
+		t, err := octosql.ParseType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of field %s in schema file %s: %w", field.Name, schemaPath, err)
+		}
+		out.Fields[i] = DeclaredField{
+			Name:       field.Name,
+			Type:       t,
+			TimeFormat: field.TimeFormat,
+		}
+		if field.Name == file.TimeField {
+			if !t.Equals(octosql.Time) {
+				return nil, fmt.Errorf("time field %s in schema file %s must be of type Time, is %s", field.Name, schemaPath, t)
+			}
+			out.TimeField = i
+		}
+	}
+	if file.TimeField != "" && out.TimeField == -1 {
+		return nil, fmt.Errorf("time field %s not found in schema file %s", file.TimeField, schemaPath)
+	}
+
+	return out, nil
+}
+
+func (s *DeclaredSchema) Schema() physical.Schema {
+	fields := make([]physical.SchemaField, len(s.Fields))
+	for i := range s.Fields {
+		fields[i] = physical.SchemaField{
+			Name: s.Fields[i].Name,
+			Type: s.Fields[i].Type,
+		}
+	}
+	return physical.NewSchema(fields, s.TimeField)
+}
+
+// TimeFormats returns the time formats of the given fields.
+func (s *DeclaredSchema) TimeFormats(fields []physical.SchemaField) []string {
+	out := make([]string, len(fields))
+	for i := range fields {
+		for _, declared := range s.Fields {
+			if declared.Name == fields[i].Name {
+				out[i] = declared.TimeFormat
+			}
+		}
+	}
+	return out
+}
+
+// ParseTime parses the string using the time format,
+// which is either a Go time layout or one of unix, unix_milli, unix_micro and unix_nano.
+// An empty format means RFC3339 or one of the other common date time layouts, or a unix timestamp, see TimeFromNumber.
+func ParseTime(format string, str string) (time.Time, error) {
+	switch format {
+	case "":
+		t, err := parseDateTime(str)
+		if err != nil {
+			if n, numErr := strconv.ParseFloat(str, 64); numErr == nil {
+				t, _ = TimeFromNumber(format, n)
+				return t, nil
+			}
+		}
+		return t, err
+	case "unix", "unix_milli", "unix_micro", "unix_nano":
+		n, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		t, _ := TimeFromNumber(format, n)
+		return t, nil
+	}
+	return time.Parse(format, str)
+}
+
+// TimeFromNumber converts a number to a time using one of the unix time formats.
+// An empty format means seconds or, for numbers larger than 1e11, milliseconds.
+func TimeFromNumber(format string, n float64) (time.Time, bool) {
+	if format == "" {
+		format = "unix"
+		if n >= 1e11 || n <= -1e11 {
+			format = "unix_milli"
+		}
+	}
+	var nanos float64
+	switch format {
+	case "unix":
+		nanos = n * 1e9
+	case "unix_milli":
+		nanos = n * 1e6
+	case "unix_micro":
+		nanos = n * 1e3
+	case "unix_nano":
+		nanos = n
+	default:
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(nanos)).UTC(), true
+}
@@ -0,0 +1,86 @@
+package inference
+
+import (
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// dateTimeLayouts are the layouts of times recognized without an explicit time format.
+// Fractional seconds are always accepted, and times without a time zone are in UTC.
+// ISO dates are read as midnight UTC, so that columns mixing dates and times can be read as times.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	octosql.DateLayout,
+}
+
+func parseDateTime(str string) (time.Time, error) {
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, str); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// InferTimeType returns Date for ISO dates, like 2021-03-04, and Time for strings in one of the common date time layouts.
+func InferTimeType(str string) (octosql.Type, bool) {
+	if _, err := time.Parse(octosql.DateLayout, str); err == nil {
+		return octosql.Date, true
+	}
+	if _, err := parseDateTime(str); err == nil {
+		return octosql.Time, true
+	}
+	return octosql.Type{}, false
+}
+
+// TypeSum returns the sum of the inferred types, widening dates to times if both are present.
+func TypeSum(t1, t2 octosql.Type) octosql.Type {
+	sum := octosql.TypeSum(t1, t2)
+	if sum.TypeID != octosql.TypeIDUnion {
+		return sum
+	}
+	hasDate, hasTime := false, false
+	for _, alternative := range sum.Union.Alternatives {
+		switch alternative.TypeID {
+		case octosql.TypeIDDate:
+			hasDate = true
+		case octosql.TypeIDTime:
+			hasTime = true
+		}
+	}
+	if !hasDate || !hasTime {
+		return sum
+	}
+
+	out := octosql.Time
+	for _, alternative := range sum.Union.Alternatives {
+		if alternative.TypeID != octosql.TypeIDDate && alternative.TypeID != octosql.TypeIDTime {
+			out = octosql.TypeSum(out, alternative)
+		}
+	}
+	return out
+}
+
+// IsUnixTime checks whether the number looks like a unix timestamp in seconds or milliseconds.
+// Only timestamps between the years 2001 and 2286 are recognized, so that other numbers aren't mistaken for times.
+func IsUnixTime(n float64) bool {
+	if n != float64(int64(n)) {
+		return false
+	}
+	return n >= 1e9 && n < 1e10 || n >= 1e12 && n < 1e13
+}
+
+// ParseDate parses the string as a date using the time format, see ParseTime.
+// An empty format means an ISO date, like 2021-03-04.
+func ParseDate(format string, str string) (time.Time, error) {
+	if format == "" {
+		return time.Parse(octosql.DateLayout, str)
+	}
+	return ParseTime(format, str)
+}
@@ -8,14 +8,20 @@ import (
 
 	"github.com/segmentio/encoding/json"
 
+	"github.com/cube2222/octosql/datasources/inference"
 	. "github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/octosql/jsonvalue"
 	"github.com/cube2222/octosql/physical"
 )
 
 type DatasourceExecuting struct {
 	path   string
+	mode   inference.Mode
 	fields []physical.SchemaField
+	// timeField is the index of the event time field, -1 if there is none.
+	timeField   int
+	timeFormats []string
 }
 
 func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
@@ -28,7 +34,7 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 	decoder := json.NewDecoder(f)
 	decoder.ZeroCopy()
 
-	for {
+	for line := 1; ; line++ {
 		var msg map[string]interface{}
 		if err := decoder.Decode(&msg); err == io.EOF {
 			return nil
@@ -38,90 +44,20 @@ func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaS
 
 		values := make([]octosql.Value, len(d.fields))
 		for i := range values {
-			values[i], _ = getOctoSQLValue(d.fields[i].Type, msg[d.fields[i].Name])
+			var ok bool
+			values[i], ok = jsonvalue.GetOctoSQLValue(d.fields[i].Type, msg[d.fields[i].Name], d.timeFormats[i])
+			if !ok && d.mode == inference.ModeFail {
+				return fmt.Errorf("value of field %s in message %d doesn't match the inferred type %s: %v", d.fields[i].Name, line, d.fields[i].Type, msg[d.fields[i].Name])
+			}
 		}
 
-		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, time.Time{})); err != nil {
-			return fmt.Errorf("couldn't produce record: %w", err)
+		var eventTime time.Time
+		if d.timeField != -1 {
+			eventTime = values[d.timeField].Time
 		}
-	}
-}
 
-func getOctoSQLValue(t octosql.Type, value interface{}) (out octosql.Value, ok bool) {
-	switch t.TypeID {
-	case octosql.TypeIDNull:
-		if value == nil {
-			return octosql.NewNull(), true
-		}
-	case octosql.TypeIDInt:
-		if value, ok := value.(int); ok {
-			return octosql.NewInt(value), true
-		}
-	case octosql.TypeIDFloat:
-		if value, ok := value.(float64); ok {
-			return octosql.NewFloat(value), true
-		}
-	case octosql.TypeIDBoolean:
-		if value, ok := value.(bool); ok {
-			return octosql.NewBoolean(value), true
-		}
-	case octosql.TypeIDString:
-		if value, ok := value.(string); ok {
-			return octosql.NewString(value), true
-		}
-	case octosql.TypeIDTime:
-		if value, ok := value.(string); ok {
-			if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
-				return octosql.NewTime(parsed), true
-			}
-		}
-	case octosql.TypeIDDuration:
-		if value, ok := value.(string); ok {
-			if parsed, err := time.ParseDuration(value); err == nil {
-				return octosql.NewDuration(parsed), true
-			}
-		}
-	case octosql.TypeIDList:
-		if value, ok := value.([]interface{}); ok {
-			elements := make([]octosql.Value, len(value))
-			outOk := true
-			for i := range elements {
-				curElement, curOk := getOctoSQLValue(*t.List.Element, value[i])
-				elements[i] = curElement
-				outOk = outOk && curOk
-			}
-			return octosql.NewList(elements), outOk
-		}
-	case octosql.TypeIDStruct:
-		if value, ok := value.(map[string]interface{}); ok {
-			values := make([]octosql.Value, len(t.Struct.Fields))
-			outOk := true
-			for i, field := range t.Struct.Fields {
-				curValue, curOk := getOctoSQLValue(field.Type, value[field.Name])
-				values[i] = curValue
-				outOk = outOk && curOk
-			}
-			return octosql.NewStruct(values), outOk
-		}
-	case octosql.TypeIDTuple:
-		if value, ok := value.([]interface{}); ok {
-			elements := make([]octosql.Value, len(value))
-			outOk := true
-			for i := range elements {
-				curElement, curOk := getOctoSQLValue(t.Tuple.Elements[i], value[i])
-				elements[i] = curElement
-				outOk = outOk && curOk
-			}
-			return octosql.NewList(elements), outOk
-		}
-	case octosql.TypeIDUnion:
-		for _, alternative := range t.Union.Alternatives {
-			v, ok := getOctoSQLValue(alternative, value)
-			if ok {
-				return v, true
-			}
+		if err := produce(ProduceFromExecutionContext(ctx), NewRecord(values, false, eventTime)); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
 		}
 	}
-
-	return octosql.ZeroValue, false
 }
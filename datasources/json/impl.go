@@ -10,24 +10,53 @@ import (
 
 	"github.com/segmentio/encoding/json"
 
+	"github.com/cube2222/octosql/datasources/inference"
 	"github.com/cube2222/octosql/execution"
 	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/octosql/jsonvalue"
 	"github.com/cube2222/octosql/physical"
 )
 
 func Creator(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+	return NewCreator(inference.DefaultOptions)(name)
+}
+
+func NewCreator(options inference.Options) func(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+	return func(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+		return create(name, options)
+	}
+}
+
+func create(name string, options inference.Options) (physical.DatasourceImplementation, physical.Schema, error) {
 	f, err := os.Open(name)
 	if err != nil {
 		return nil, physical.Schema{}, fmt.Errorf("couldn't open file: %w", err)
 	}
 	defer f.Close()
 
+	declared, err := inference.ReadSchemaFile(name)
+	if err != nil {
+		return nil, physical.Schema{}, err
+	}
+	if declared != nil {
+		return &impl{
+				path:     name,
+				mode:     options.Mode,
+				declared: declared,
+			},
+			declared.Schema(),
+			nil
+	}
+
 	decoder := json.NewDecoder(f)
 	decoder.ZeroCopy()
 
 	fields := make(map[string]octosql.Type)
+	// fieldCounts is the number of messages in which the field is present.
+	fieldCounts := make(map[string]int)
 
-	for i := 0; i < 10; i++ {
+	messageCount := 0
+	for ; options.ShouldRead(messageCount); messageCount++ {
 		var msg map[string]interface{}
 		if err := decoder.Decode(&msg); err == io.EOF {
 			break
@@ -35,17 +64,25 @@ func Creator(name string) (physical.DatasourceImplementation, physical.Schema, e
 			return nil, physical.Schema{}, fmt.Errorf("couldn't decode message: %w", err)
 		}
 
+		sampled := options.ShouldSample(messageCount)
 		for k := range msg {
-			if t, ok := fields[k]; ok {
-				fields[k] = octosql.TypeSum(t, getOctoSQLType(msg[k]))
-			} else {
-				fields[k] = getOctoSQLType(msg[k])
+			if t, ok := fields[k]; !ok {
+				fields[k] = getOctoSQLType(msg[k], options)
+			} else if sampled {
+				fields[k] = inference.TypeSum(t, getOctoSQLType(msg[k], options))
+			} else if _, ok := jsonvalue.GetOctoSQLValue(t, msg[k], ""); !ok {
+				fields[k] = inference.TypeSum(t, getOctoSQLType(msg[k], options))
 			}
+			fieldCounts[k]++
 		}
 	}
 
 	var schemaFields []physical.SchemaField
 	for k, t := range fields {
+		if fieldCounts[k] < messageCount {
+			// The field is missing in some messages.
+			t = inference.TypeSum(t, octosql.Null)
+		}
 		schemaFields = append(schemaFields, physical.SchemaField{
 			Name: k,
 			Type: t,
@@ -57,25 +94,38 @@ func Creator(name string) (physical.DatasourceImplementation, physical.Schema, e
 
 	return &impl{
 			path: name,
+			mode: options.Mode,
 		},
 		physical.NewSchema(schemaFields, -1),
 		nil
 }
 
-func getOctoSQLType(value interface{}) octosql.Type {
+func getOctoSQLType(value interface{}, options inference.Options) octosql.Type {
 	switch value := value.(type) {
 	case int:
 		return octosql.Int
 	case bool:
 		return octosql.Boolean
 	case float64:
+		if options.UnixTimes && inference.IsUnixTime(value) {
+			return octosql.Time
+		}
+		if options.Decimals {
+			if decimal, err := octosql.DecimalFromFloat(value); err == nil {
+				return inference.DecimalType(decimal)
+			}
+		}
 		return octosql.Float
 	case string:
-		if _, err := time.Parse(time.RFC3339Nano, value); err == nil {
-			return octosql.Time
-		} else {
-			return octosql.String
+		if options.Decimals {
+			if decimal, err := octosql.ParseDecimal(value); err == nil {
+				return inference.DecimalType(decimal)
+			}
+		}
+		if t, ok := inference.InferTimeType(value); ok {
+			return t
 		}
+		return octosql.String
 	case time.Time:
 		return octosql.Time
 	case map[string]interface{}:
@@ -88,7 +138,7 @@ func getOctoSQLType(value interface{}) octosql.Type {
 		for i := range fieldNames {
 			fields[i] = octosql.StructField{
 				Name: fieldNames[i],
-				Type: getOctoSQLType(value[fieldNames[i]]),
+				Type: getOctoSQLType(value[fieldNames[i]], options),
 			}
 		}
 		return octosql.Type{
@@ -99,10 +149,10 @@ func getOctoSQLType(value interface{}) octosql.Type {
 		var elementType *octosql.Type
 		for i := range value {
 			if elementType != nil {
-				t := octosql.TypeSum(*elementType, getOctoSQLType(value[i]))
+				t := inference.TypeSum(*elementType, getOctoSQLType(value[i], options))
 				elementType = &t
 			} else {
-				t := getOctoSQLType(value[i])
+				t := getOctoSQLType(value[i], options)
 				elementType = &t
 			}
 		}
@@ -122,13 +172,23 @@ func getOctoSQLType(value interface{}) octosql.Type {
 }
 
 type impl struct {
-	path string
+	path     string
+	mode     inference.Mode
+	declared *inference.DeclaredSchema
 }
 
 func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
+	timeFormats := make([]string, len(schema.Fields))
+	if i.declared != nil {
+		timeFormats = i.declared.TimeFormats(schema.Fields)
+	}
+
 	return &DatasourceExecuting{
-		path:   i.path,
-		fields: schema.Fields,
+		path:        i.path,
+		mode:        i.mode,
+		fields:      schema.Fields,
+		timeField:   schema.TimeField,
+		timeFormats: timeFormats,
 	}, nil
 }
 
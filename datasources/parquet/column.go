@@ -0,0 +1,665 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// column contains all decoded levels and values of a column chunk.
+type column struct {
+	repetitionLevels []int32
+	definitionLevels []int32
+	// values contains only the defined values.
+	values []octosql.Value
+
+	maxDefinitionLevel int32
+	levelIndex         int
+	valueIndex         int
+}
+
+func (c *column) peekDefinitionLevel() int32 {
+	if c.levelIndex >= len(c.definitionLevels) {
+		return -1
+	}
+	return c.definitionLevels[c.levelIndex]
+}
+
+func (c *column) peekRepetitionLevel() int32 {
+	if c.levelIndex >= len(c.repetitionLevels) {
+		return -1
+	}
+	return c.repetitionLevels[c.levelIndex]
+}
+
+func (c *column) next() octosql.Value {
+	c.levelIndex++
+	if c.overrun() {
+		return octosql.NewNull()
+	}
+	definitionLevel := c.definitionLevels[c.levelIndex-1]
+	if definitionLevel < c.maxDefinitionLevel {
+		return octosql.NewNull()
+	}
+	value := c.values[c.valueIndex]
+	c.valueIndex++
+	return value
+}
+
+// overrun checks whether more values have been read than the column contains, which happens for corrupted files.
+func (c *column) overrun() bool {
+	return c.levelIndex > len(c.definitionLevels)
+}
+
+// maxPageValues limits the memory used by the levels and values of a single page,
+// as with run-length encoding even a small corrupted page may claim to contain any number of values.
+const maxPageValues = 1 << 20
+
+func readColumn(f *os.File, chunk columnChunk, leaf *schemaNode) (*column, error) {
+	start := chunk.DataPageOffset
+	if chunk.HasDictionaryPage && chunk.DictionaryPageOffset > 0 && chunk.DictionaryPageOffset < start {
+		start = chunk.DictionaryPageOffset
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't stat file: %w", err)
+	}
+	if start < 0 || chunk.TotalCompressedSize < 0 || chunk.TotalCompressedSize > stat.Size()-start {
+		return nil, fmt.Errorf("column chunk exceeds file")
+	}
+	data := make([]byte, chunk.TotalCompressedSize)
+	if _, err := f.ReadAt(data, start); err != nil {
+		return nil, fmt.Errorf("couldn't read column chunk: %w", err)
+	}
+
+	out := &column{
+		maxDefinitionLevel: int32(leaf.definitionLevel),
+	}
+	maxRepetitionLevel := int32(leaf.repetitionLevel)
+	var dictionary []octosql.Value
+
+	r := &thriftReader{data: data}
+	for valuesRead := int64(0); valuesRead < chunk.NumValues; {
+		header, err := readPageHeader(r)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read page header: %w", err)
+		}
+		if header.CompressedPageSize < 0 || int64(len(data)-r.pos) < header.CompressedPageSize {
+			return nil, fmt.Errorf("page exceeds column chunk")
+		}
+		if header.NumValues < 0 || header.NumValues > maxPageValues {
+			return nil, fmt.Errorf("invalid page value count: %d", header.NumValues)
+		}
+		page := data[r.pos : r.pos+int(header.CompressedPageSize)]
+		r.pos += int(header.CompressedPageSize)
+
+		switch header.Type {
+		case pageTypeDictionaryPage:
+			page, err = decompress(chunk.Codec, page, header.UncompressedPageSize)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't decompress dictionary page: %w", err)
+			}
+			dictionary, _, err = decodePlain(page, int(header.NumValues), leaf)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't decode dictionary page: %w", err)
+			}
+
+		case pageTypeDataPage:
+			page, err = decompress(chunk.Codec, page, header.UncompressedPageSize)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't decompress data page: %w", err)
+			}
+			numValues := int(header.NumValues)
+
+			repetitionLevels := make([]int32, numValues)
+			if maxRepetitionLevel > 0 {
+				if header.RepetitionLevelEncoding != encodingRLE {
+					return nil, fmt.Errorf("unsupported repetition level encoding: %d", header.RepetitionLevelEncoding)
+				}
+				var n int
+				repetitionLevels, n, err = decodeLengthPrefixedRLE(page, bitWidth(maxRepetitionLevel), numValues)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't decode repetition levels: %w", err)
+				}
+				page = page[n:]
+			}
+			definitionLevels := make([]int32, numValues)
+			if out.maxDefinitionLevel > 0 {
+				if header.DefinitionLevelEncoding != encodingRLE {
+					return nil, fmt.Errorf("unsupported definition level encoding: %d", header.DefinitionLevelEncoding)
+				}
+				var n int
+				definitionLevels, n, err = decodeLengthPrefixedRLE(page, bitWidth(out.maxDefinitionLevel), numValues)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't decode definition levels: %w", err)
+				}
+				page = page[n:]
+			}
+
+			if err := out.appendPage(repetitionLevels, definitionLevels, page, header.Encoding, dictionary, leaf); err != nil {
+				return nil, err
+			}
+			valuesRead += int64(numValues)
+
+		case pageTypeDataPageV2:
+			numValues := int(header.NumValues)
+			levelsLength := header.RepetitionLevelsByteLength + header.DefinitionLevelsByteLength
+			if header.RepetitionLevelsByteLength < 0 || header.DefinitionLevelsByteLength < 0 || int64(len(page)) < levelsLength {
+				return nil, fmt.Errorf("invalid level lengths in data page")
+			}
+
+			repetitionLevels := make([]int32, numValues)
+			if maxRepetitionLevel > 0 {
+				repetitionLevels, err = decodeRLE(page[:header.RepetitionLevelsByteLength], bitWidth(maxRepetitionLevel), numValues)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't decode repetition levels: %w", err)
+				}
+			}
+			definitionLevels := make([]int32, numValues)
+			if out.maxDefinitionLevel > 0 {
+				definitionLevels, err = decodeRLE(page[header.RepetitionLevelsByteLength:levelsLength], bitWidth(out.maxDefinitionLevel), numValues)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't decode definition levels: %w", err)
+				}
+			}
+
+			values := page[levelsLength:]
+			if header.IsCompressed {
+				values, err = decompress(chunk.Codec, values, header.UncompressedPageSize-levelsLength)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't decompress data page: %w", err)
+				}
+			}
+
+			if err := out.appendPage(repetitionLevels, definitionLevels, values, header.Encoding, dictionary, leaf); err != nil {
+				return nil, err
+			}
+			valuesRead += int64(numValues)
+
+		default:
+			// Index pages and unknown pages can be skipped.
+		}
+	}
+
+	return out, nil
+}
+
+func (c *column) appendPage(repetitionLevels, definitionLevels []int32, data []byte, encoding int64, dictionary []octosql.Value, leaf *schemaNode) error {
+	definedCount := 0
+	for _, level := range definitionLevels {
+		if level > c.maxDefinitionLevel {
+			return fmt.Errorf("definition level %d exceeds maximum %d", level, c.maxDefinitionLevel)
+		}
+		if level == c.maxDefinitionLevel {
+			definedCount++
+		}
+	}
+
+	var values []octosql.Value
+	var err error
+	switch encoding {
+	case encodingPlain:
+		values, _, err = decodePlain(data, definedCount, leaf)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		values, err = decodeDictionaryIndices(data, definedCount, dictionary)
+	case encodingRLE:
+		if leaf.element.Type != typeBoolean {
+			return fmt.Errorf("rle encoding is only supported for booleans")
+		}
+		var decoded []int32
+		decoded, _, err = decodeLengthPrefixedRLE(data, 1, definedCount)
+		values = make([]octosql.Value, len(decoded))
+		for i := range decoded {
+			values[i] = octosql.NewBoolean(decoded[i] == 1)
+		}
+	case encodingDeltaBinaryPacked:
+		values, err = decodeDeltaBinaryPackedValues(data, definedCount, leaf)
+	case encodingDeltaLengthByteArray:
+		var decoded [][]byte
+		decoded, err = decodeDeltaLengthByteArray(data, definedCount)
+		values = convertByteArrays(decoded, leaf)
+	case encodingDeltaByteArray:
+		var decoded [][]byte
+		decoded, err = decodeDeltaByteArray(data, definedCount)
+		values = convertByteArrays(decoded, leaf)
+	case encodingByteStreamSplit:
+		values, err = decodeByteStreamSplit(data, definedCount, leaf)
+	default:
+		return fmt.Errorf("unsupported encoding: %d", encoding)
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't decode values: %w", err)
+	}
+	if len(values) != definedCount {
+		return fmt.Errorf("expected %d values in page, got %d", definedCount, len(values))
+	}
+
+	c.repetitionLevels = append(c.repetitionLevels, repetitionLevels...)
+	c.definitionLevels = append(c.definitionLevels, definitionLevels...)
+	c.values = append(c.values, values...)
+	return nil
+}
+
+// zstdDecoder is shared by all columns, as it's safe for concurrent use of DecodeAll.
+var zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+
+func decompress(codec int64, data []byte, uncompressedSize int64) ([]byte, error) {
+	if uncompressedSize < 0 {
+		return nil, fmt.Errorf("invalid uncompressed page size: %d", uncompressedSize)
+	}
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(nil, data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(r)
+	case codecZstd:
+		// The declared size is only a hint, so we don't trust it with large allocations.
+		if uncompressedSize > int64(len(data))*64 {
+			uncompressedSize = int64(len(data)) * 64
+		}
+		return zstdDecoder.DecodeAll(data, make([]byte, 0, uncompressedSize))
+	}
+	return nil, fmt.Errorf("unsupported compression codec: %d", codec)
+}
+
+func bitWidth(maxLevel int32) int {
+	return bits.Len32(uint32(maxLevel))
+}
+
+// decodePlain decodes count plain encoded values, returning the number of bytes read.
+func decodePlain(data []byte, count int, leaf *schemaNode) ([]octosql.Value, int, error) {
+	// Each value takes at least a bit.
+	if count < 0 || count > len(data)*8 {
+		return nil, 0, fmt.Errorf("not enough data for %d values", count)
+	}
+	out := make([]octosql.Value, count)
+	pos := 0
+	switch leaf.element.Type {
+	case typeBoolean:
+		if len(data) < (count+7)/8 {
+			return nil, 0, fmt.Errorf("not enough data for %d booleans", count)
+		}
+		for i := range out {
+			out[i] = octosql.NewBoolean(data[i/8]&(1<<(i%8)) != 0)
+		}
+		pos = (count + 7) / 8
+	case typeInt32:
+		if len(data) < count*4 {
+			return nil, 0, fmt.Errorf("not enough data for %d int32 values", count)
+		}
+		for i := range out {
+			out[i] = leaf.convertInt32(int32(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+		pos = count * 4
+	case typeInt64:
+		if len(data) < count*8 {
+			return nil, 0, fmt.Errorf("not enough data for %d int64 values", count)
+		}
+		for i := range out {
+			out[i] = leaf.convertInt64(int64(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+		pos = count * 8
+	case typeInt96:
+		if len(data) < count*12 {
+			return nil, 0, fmt.Errorf("not enough data for %d int96 values", count)
+		}
+		for i := range out {
+			out[i] = leaf.convertInt96(data[i*12 : i*12+12])
+		}
+		pos = count * 12
+	case typeFloat:
+		if len(data) < count*4 {
+			return nil, 0, fmt.Errorf("not enough data for %d float values", count)
+		}
+		for i := range out {
+			out[i] = octosql.NewFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))))
+		}
+		pos = count * 4
+	case typeDouble:
+		if len(data) < count*8 {
+			return nil, 0, fmt.Errorf("not enough data for %d double values", count)
+		}
+		for i := range out {
+			out[i] = octosql.NewFloat(math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+		pos = count * 8
+	case typeByteArray:
+		for i := range out {
+			if len(data)-pos < 4 {
+				return nil, 0, fmt.Errorf("not enough data for byte array length")
+			}
+			length := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if len(data)-pos < length {
+				return nil, 0, fmt.Errorf("not enough data for byte array")
+			}
+			out[i] = leaf.convertBytes(data[pos : pos+length])
+			pos += length
+		}
+	case typeFixedLenByteArray:
+		length := int(leaf.element.TypeLength)
+		if len(data) < count*length {
+			return nil, 0, fmt.Errorf("not enough data for %d fixed length byte arrays", count)
+		}
+		for i := range out {
+			out[i] = leaf.convertBytes(data[i*length : (i+1)*length])
+		}
+		pos = count * length
+	default:
+		return nil, 0, fmt.Errorf("unknown physical type: %d", leaf.element.Type)
+	}
+	return out, pos, nil
+}
+
+func decodeDictionaryIndices(data []byte, count int, dictionary []octosql.Value) ([]octosql.Value, error) {
+	if count == 0 {
+		return nil, nil
+	}
+	if len(data) < 1 {
+		return nil, fmt.Errorf("missing dictionary index bit width")
+	}
+	if data[0] > 32 {
+		return nil, fmt.Errorf("invalid dictionary index bit width: %d", data[0])
+	}
+	indices, err := decodeRLE(data[1:], int(data[0]), count)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]octosql.Value, count)
+	for i, index := range indices {
+		if int(index) >= len(dictionary) || index < 0 {
+			return nil, fmt.Errorf("dictionary index %d out of range", index)
+		}
+		out[i] = dictionary[index]
+	}
+	return out, nil
+}
+
+func decodeLengthPrefixedRLE(data []byte, bitWidth int, count int) ([]int32, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("missing rle length")
+	}
+	length := int(binary.LittleEndian.Uint32(data))
+	if len(data)-4 < length {
+		return nil, 0, fmt.Errorf("rle data exceeds page")
+	}
+	out, err := decodeRLE(data[4:4+length], bitWidth, count)
+	return out, 4 + length, err
+}
+
+// decodeRLE decodes the RLE and bit-packing hybrid encoding.
+func decodeRLE(data []byte, bitWidth int, count int) ([]int32, error) {
+	out := make([]int32, 0, count)
+	byteWidth := (bitWidth + 7) / 8
+	pos := 0
+	for len(out) < count {
+		header, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid rle run header")
+		}
+		pos += n
+
+		if header&1 == 0 {
+			runLength := int(header >> 1)
+			if len(data)-pos < byteWidth {
+				return nil, fmt.Errorf("not enough data for rle run value")
+			}
+			var value uint32
+			for i := 0; i < byteWidth; i++ {
+				value |= uint32(data[pos+i]) << (8 * i)
+			}
+			pos += byteWidth
+			for i := 0; i < runLength && len(out) < count; i++ {
+				out = append(out, int32(value))
+			}
+		} else {
+			// Only the groups which contain values we need are read, as the rest may be padding.
+			groups := header >> 1
+			if needed := uint64(count-len(out)+7) / 8; groups > needed {
+				groups = needed
+			}
+			valueCount := int(groups) * 8
+			byteCount := int(groups) * bitWidth
+			if len(data)-pos < byteCount {
+				// The last run may be truncated.
+				byteCount = len(data) - pos
+				valueCount = 0
+				if bitWidth > 0 {
+					valueCount = byteCount * 8 / bitWidth
+				}
+			}
+			values := unpackBits(data[pos:pos+byteCount], bitWidth, valueCount)
+			pos += byteCount
+			for i := 0; i < len(values) && len(out) < count; i++ {
+				out = append(out, int32(values[i]))
+			}
+			if valueCount == 0 {
+				return nil, fmt.Errorf("not enough data for bit-packed run")
+			}
+		}
+	}
+	return out, nil
+}
+
+// unpackBits unpacks little-endian bit-packed values.
+func unpackBits(data []byte, bitWidth int, count int) []uint64 {
+	out := make([]uint64, count)
+	if bitWidth == 0 {
+		return out
+	}
+	bitPos := 0
+	for i := range out {
+		var value uint64
+		for b := 0; b < bitWidth; b++ {
+			if data[(bitPos+b)/8]&(1<<((bitPos+b)%8)) != 0 {
+				value |= 1 << b
+			}
+		}
+		out[i] = value
+		bitPos += bitWidth
+	}
+	return out
+}
+
+// decodeDeltaBinaryPacked decodes count values, returning the number of bytes read.
+func decodeDeltaBinaryPacked(data []byte, count int) ([]int64, int, error) {
+	pos := 0
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return 0, fmt.Errorf("invalid delta binary packed varint")
+		}
+		pos += n
+		return v, nil
+	}
+	readZigZag := func() (int64, error) {
+		v, err := readUvarint()
+		return int64(v>>1) ^ -int64(v&1), err
+	}
+
+	blockSize, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	miniblockCount, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	totalCount, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	value, err := readZigZag()
+	if err != nil {
+		return nil, 0, err
+	}
+	if blockSize == 0 || blockSize > math.MaxInt32 || miniblockCount == 0 || blockSize%miniblockCount != 0 {
+		return nil, 0, fmt.Errorf("invalid delta binary packed block size")
+	}
+	miniblockSize := int(blockSize / miniblockCount)
+	if miniblockSize%8 != 0 {
+		return nil, 0, fmt.Errorf("invalid delta binary packed miniblock size: %d", miniblockSize)
+	}
+	if totalCount > uint64(count) {
+		return nil, 0, fmt.Errorf("expected %d delta binary packed values, got %d", count, totalCount)
+	}
+
+	out := make([]int64, 0, totalCount)
+	if totalCount > 0 {
+		out = append(out, value)
+	}
+	for uint64(len(out)) < totalCount {
+		minDelta, err := readZigZag()
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(data)-pos < int(miniblockCount) {
+			return nil, 0, fmt.Errorf("not enough data for miniblock bit widths")
+		}
+		bitWidths := data[pos : pos+int(miniblockCount)]
+		pos += int(miniblockCount)
+
+		for _, width := range bitWidths {
+			if uint64(len(out)) >= totalCount {
+				break
+			}
+			if width > 64 {
+				return nil, 0, fmt.Errorf("invalid miniblock bit width: %d", width)
+			}
+			byteCount := miniblockSize * int(width) / 8
+			if len(data)-pos < byteCount {
+				return nil, 0, fmt.Errorf("not enough data for miniblock")
+			}
+			// The last miniblock may be padded, so we only unpack the values we need.
+			valueCount := miniblockSize
+			if remaining := int(totalCount) - len(out); valueCount > remaining {
+				valueCount = remaining
+			}
+			deltas := unpackBits(data[pos:pos+byteCount], int(width), valueCount)
+			pos += byteCount
+			for _, delta := range deltas {
+				if uint64(len(out)) >= totalCount {
+					break
+				}
+				value = int64(uint64(value) + uint64(minDelta) + delta)
+				out = append(out, value)
+			}
+		}
+	}
+	if len(out) < count {
+		return nil, 0, fmt.Errorf("expected %d delta binary packed values, got %d", count, len(out))
+	}
+	return out[:count], pos, nil
+}
+
+func decodeDeltaBinaryPackedValues(data []byte, count int, leaf *schemaNode) ([]octosql.Value, error) {
+	decoded, _, err := decodeDeltaBinaryPacked(data, count)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]octosql.Value, len(decoded))
+	switch leaf.element.Type {
+	case typeInt32:
+		for i := range decoded {
+			out[i] = leaf.convertInt32(int32(decoded[i]))
+		}
+	case typeInt64:
+		for i := range decoded {
+			out[i] = leaf.convertInt64(decoded[i])
+		}
+	default:
+		return nil, fmt.Errorf("delta binary packed encoding is only supported for integers")
+	}
+	return out, nil
+}
+
+func decodeDeltaLengthByteArray(data []byte, count int) ([][]byte, error) {
+	lengths, pos, err := decodeDeltaBinaryPacked(data, count)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode lengths: %w", err)
+	}
+	out := make([][]byte, count)
+	for i, length := range lengths {
+		if int64(len(data)-pos) < length || length < 0 {
+			return nil, fmt.Errorf("not enough data for byte array")
+		}
+		out[i] = data[pos : pos+int(length)]
+		pos += int(length)
+	}
+	return out, nil
+}
+
+func decodeDeltaByteArray(data []byte, count int) ([][]byte, error) {
+	prefixLengths, pos, err := decodeDeltaBinaryPacked(data, count)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode prefix lengths: %w", err)
+	}
+	suffixes, err := decodeDeltaLengthByteArray(data[pos:], count)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode suffixes: %w", err)
+	}
+	out := make([][]byte, count)
+	var previous []byte
+	for i := range out {
+		if prefixLengths[i] > int64(len(previous)) || prefixLengths[i] < 0 {
+			return nil, fmt.Errorf("invalid prefix length")
+		}
+		value := make([]byte, 0, int(prefixLengths[i])+len(suffixes[i]))
+		value = append(value, previous[:prefixLengths[i]]...)
+		value = append(value, suffixes[i]...)
+		out[i] = value
+		previous = value
+	}
+	return out, nil
+}
+
+func convertByteArrays(decoded [][]byte, leaf *schemaNode) []octosql.Value {
+	out := make([]octosql.Value, len(decoded))
+	for i := range decoded {
+		out[i] = leaf.convertBytes(decoded[i])
+	}
+	return out
+}
+
+func decodeByteStreamSplit(data []byte, count int, leaf *schemaNode) ([]octosql.Value, error) {
+	var width int
+	switch leaf.element.Type {
+	case typeInt32, typeFloat:
+		width = 4
+	case typeInt64, typeDouble:
+		width = 8
+	case typeFixedLenByteArray:
+		width = int(leaf.element.TypeLength)
+	default:
+		return nil, fmt.Errorf("byte stream split encoding isn't supported for physical type %d", leaf.element.Type)
+	}
+	if len(data) < count*width {
+		return nil, fmt.Errorf("not enough data for %d values", count)
+	}
+
+	// Gather the bytes of each value from the streams and decode it as plain.
+	plain := make([]byte, count*width)
+	for i := 0; i < count; i++ {
+		for b := 0; b < width; b++ {
+			plain[i*width+b] = data[b*count+i]
+		}
+	}
+	values, _, err := decodePlain(plain, count, leaf)
+	return values, err
+}
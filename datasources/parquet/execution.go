@@ -0,0 +1,150 @@
+package parquet
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type DatasourceExecuting struct {
+	path     string
+	metadata *fileMetadata
+	// fields are the top-level schema nodes of the columns to read.
+	fields []*schemaNode
+	leaves []*schemaNode
+
+	predicates           []Expression
+	statisticsPredicates []statisticsPredicate
+}
+
+func (d *DatasourceExecuting) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	f, err := os.Open(d.path)
+	if err != nil {
+		return fmt.Errorf("couldn't open file: %w", err)
+	}
+	defer f.Close()
+
+rowGroupLoop:
+	for _, group := range d.metadata.RowGroups {
+		for i := range d.statisticsPredicates {
+			if !d.statisticsPredicates[i].mayMatch(group) {
+				continue rowGroupLoop
+			}
+		}
+
+		columns := make(map[int]*column)
+		for _, field := range d.fields {
+			for _, leafIndex := range field.leaves {
+				if _, ok := columns[leafIndex]; ok {
+					continue
+				}
+				c, err := readColumn(f, group.Columns[leafIndex], d.leaves[leafIndex])
+				if err != nil {
+					return fmt.Errorf("couldn't read column %s: %w", strings.Join(group.Columns[leafIndex].PathInSchema, "."), err)
+				}
+				columns[leafIndex] = c
+			}
+		}
+		r := &recordAssembler{columns: columns}
+
+	rowLoop:
+		for row := int64(0); row < group.NumRows; row++ {
+			values := make([]octosql.Value, len(d.fields))
+			for i, field := range d.fields {
+				values[i] = r.readNode(field)
+			}
+			for leafIndex, c := range columns {
+				if c.overrun() {
+					return fmt.Errorf("column %s has fewer values than the row group has rows", strings.Join(group.Columns[leafIndex].PathInSchema, "."))
+				}
+			}
+			record := NewRecord(values, false, time.Time{})
+
+			recordCtx := ctx.WithRecord(record)
+			for _, predicate := range d.predicates {
+				ok, err := predicate.Evaluate(recordCtx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate pushed down predicate: %w", err)
+				}
+				if ok.TypeID != octosql.TypeIDBoolean || !ok.Boolean {
+					continue rowLoop
+				}
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce record: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordAssembler reconstructs nested values from the levels of their leaf columns.
+type recordAssembler struct {
+	columns map[int]*column
+}
+
+func (r *recordAssembler) readNode(node *schemaNode) octosql.Value {
+	switch node.element.RepetitionType {
+	case repetitionOptional:
+		if r.columns[node.firstLeaf].peekDefinitionLevel() < int32(node.definitionLevel) {
+			r.skip(node)
+			return octosql.NewNull()
+		}
+		return r.readValue(node)
+	case repetitionRepeated:
+		return octosql.NewList(r.readRepeated(node, r.readValue))
+	default:
+		return r.readValue(node)
+	}
+}
+
+// readValue reads a present instance of the node.
+func (r *recordAssembler) readValue(node *schemaNode) octosql.Value {
+	if len(node.children) == 0 {
+		return r.columns[node.firstLeaf].next()
+	}
+	if repeated, ok := node.repeatedChild(); ok {
+		if node.isThreeLevelList(repeated) {
+			return octosql.NewList(r.readRepeated(repeated, func(node *schemaNode) octosql.Value {
+				return r.readNode(node.children[0])
+			}))
+		}
+		return octosql.NewList(r.readRepeated(repeated, r.readValue))
+	}
+
+	values := make([]octosql.Value, len(node.children))
+	for i, child := range node.children {
+		values[i] = r.readNode(child)
+	}
+	return octosql.NewStruct(values)
+}
+
+func (r *recordAssembler) readRepeated(node *schemaNode, readElement func(node *schemaNode) octosql.Value) []octosql.Value {
+	first := r.columns[node.firstLeaf]
+	if first.peekDefinitionLevel() < int32(node.definitionLevel) {
+		// The list is empty.
+		r.skip(node)
+		return []octosql.Value{}
+	}
+
+	var out []octosql.Value
+	for {
+		out = append(out, readElement(node))
+		if first.peekRepetitionLevel() != int32(node.repetitionLevel) {
+			return out
+		}
+	}
+}
+
+// skip consumes the single level entry of each leaf which denotes that the node isn't present.
+func (r *recordAssembler) skip(node *schemaNode) {
+	for _, leaf := range node.leaves {
+		r.columns[leaf].levelIndex++
+	}
+}
@@ -0,0 +1,96 @@
+package parquet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/physical"
+)
+
+func addTestdataSeeds(f *testing.F) [][]byte {
+	paths, err := filepath.Glob("testdata/*.parquet")
+	if err != nil {
+		f.Fatal(err)
+	}
+	var files [][]byte
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		files = append(files, data)
+	}
+	return files
+}
+
+// FuzzReadFile checks that reading arbitrary files returns errors instead of panicking.
+func FuzzReadFile(f *testing.F) {
+	for _, data := range addTestdataSeeds(f) {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		path := filepath.Join(t.TempDir(), "fuzz.parquet")
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		impl, schema, err := Creator(path)
+		if err != nil {
+			return
+		}
+		ctx := context.Background()
+		node, err := impl.Materialize(ctx, physical.Environment{}, schema, nil)
+		if err != nil {
+			return
+		}
+		_ = node.Run(
+			execution.ExecutionContext{Context: ctx},
+			func(ctx execution.ProduceContext, record execution.Record) error {
+				return nil
+			},
+			func(ctx execution.ProduceContext, msg execution.MetadataMessage) error {
+				return nil
+			},
+		)
+	})
+}
+
+// FuzzMetadata checks that decoding arbitrary metadata and schemas returns errors instead of panicking.
+func FuzzMetadata(f *testing.F) {
+	for _, data := range addTestdataSeeds(f) {
+		// The metadata is followed by its length and the magic bytes.
+		if len(data) < 12 {
+			continue
+		}
+		length := int(data[len(data)-8]) | int(data[len(data)-7])<<8 | int(data[len(data)-6])<<16 | int(data[len(data)-5])<<24
+		f.Add(data[len(data)-8-length : len(data)-8])
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &thriftReader{data: data}
+		raw, err := r.readStruct()
+		if err != nil {
+			return
+		}
+		metadata, err := parseFileMetadata(raw)
+		if err != nil {
+			return
+		}
+		_, _, _ = buildSchemaTree(metadata.Schema)
+	})
+}
+
+// FuzzDecodings checks that decoding arbitrary page data returns errors instead of panicking.
+func FuzzDecodings(f *testing.F) {
+	f.Add([]byte{0x02, 0x00, 0x00, 0x00, 0x06, 0x01}, uint8(1), uint16(3))
+	f.Add([]byte{0x80, 0x01, 0x04, 0x03, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00}, uint8(0), uint16(3))
+	f.Add([]byte{0x03, 0xff, 0x0f}, uint8(4), uint16(12))
+	f.Fuzz(func(t *testing.T, data []byte, bitWidth uint8, count uint16) {
+		_, _, _ = decodeLengthPrefixedRLE(data, int(bitWidth%33), int(count))
+		_, _ = decodeRLE(data, int(bitWidth%33), int(count))
+		_, _, _ = decodeDeltaBinaryPacked(data, int(count))
+		_, _ = decodeDeltaLengthByteArray(data, int(count))
+		_, _ = decodeDeltaByteArray(data, int(count))
+	})
+}
@@ -0,0 +1,229 @@
+package parquet
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+func Creator(name string) (physical.DatasourceImplementation, physical.Schema, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, physical.Schema{}, fmt.Errorf("couldn't open file: %w", err)
+	}
+	defer f.Close()
+
+	metadata, err := readFileMetadata(f)
+	if err != nil {
+		return nil, physical.Schema{}, fmt.Errorf("couldn't read parquet metadata: %w", err)
+	}
+	root, leaves, err := buildSchemaTree(metadata.Schema)
+	if err != nil {
+		return nil, physical.Schema{}, fmt.Errorf("couldn't read parquet schema: %w", err)
+	}
+	for _, group := range metadata.RowGroups {
+		if len(group.Columns) != len(leaves) {
+			return nil, physical.Schema{}, fmt.Errorf("row group has %d columns, schema has %d", len(group.Columns), len(leaves))
+		}
+	}
+
+	schemaFields := make([]physical.SchemaField, len(root.children))
+	for i, child := range root.children {
+		schemaFields[i] = physical.SchemaField{
+			Name: child.element.Name,
+			Type: child.fieldType(),
+		}
+	}
+
+	return &impl{
+			path:     name,
+			metadata: metadata,
+			root:     root,
+			leaves:   leaves,
+		},
+		physical.NewSchema(schemaFields, -1),
+		nil
+}
+
+type impl struct {
+	path     string
+	metadata *fileMetadata
+	root     *schemaNode
+	leaves   []*schemaNode
+}
+
+func (i *impl) Materialize(ctx context.Context, env physical.Environment, schema physical.Schema, pushedDownPredicates []physical.Expression) (execution.Node, error) {
+	fields := make([]*schemaNode, len(schema.Fields))
+	for j := range schema.Fields {
+		field, ok := i.field(schema.Fields[j].Name)
+		if !ok {
+			return nil, fmt.Errorf("no such column: %s", schema.Fields[j].Name)
+		}
+		fields[j] = field
+	}
+
+	predicates := make([]execution.Expression, len(pushedDownPredicates))
+	statisticsPredicates := make([]statisticsPredicate, 0, len(pushedDownPredicates))
+	for j := range pushedDownPredicates {
+		predicate, err := pushedDownPredicates[j].Materialize(ctx, env.WithRecordSchema(schema))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize pushed down predicate: %w", err)
+		}
+		predicates[j] = predicate
+
+		if statisticsPredicate, ok := i.getStatisticsPredicate(pushedDownPredicates[j]); ok {
+			statisticsPredicates = append(statisticsPredicates, statisticsPredicate)
+		}
+	}
+
+	return &DatasourceExecuting{
+		path:                 i.path,
+		metadata:             i.metadata,
+		fields:               fields,
+		leaves:               i.leaves,
+		predicates:           predicates,
+		statisticsPredicates: statisticsPredicates,
+	}, nil
+}
+
+func (i *impl) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
+	rejected = []physical.Expression{}
+	pushedDown = append([]physical.Expression{}, pushedDownPredicates...)
+	for _, predicate := range newPredicates {
+		if _, ok := i.getStatisticsPredicate(predicate); ok {
+			pushedDown = append(pushedDown, predicate)
+			changed = true
+		} else {
+			rejected = append(rejected, predicate)
+		}
+	}
+	return rejected, pushedDown, changed
+}
+
+func (i *impl) field(name string) (*schemaNode, bool) {
+	for _, child := range i.root.children {
+		if child.element.Name == name {
+			return child, true
+		}
+	}
+	return nil, false
+}
+
+// statisticsPredicate is a comparison of a column with a constant, which can be checked using the column statistics.
+type statisticsPredicate struct {
+	column   int
+	leaf     *schemaNode
+	operator string
+	value    octosql.Value
+}
+
+var flippedOperators = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+func (i *impl) getStatisticsPredicate(predicate physical.Expression) (statisticsPredicate, bool) {
+	if predicate.ExpressionType != physical.ExpressionTypeFunctionCall {
+		return statisticsPredicate{}, false
+	}
+	operator := predicate.FunctionCall.Name
+	if _, ok := flippedOperators[operator]; !ok {
+		return statisticsPredicate{}, false
+	}
+	args := predicate.FunctionCall.Arguments
+	if len(args) != 2 {
+		return statisticsPredicate{}, false
+	}
+	variable, constant := args[0], args[1]
+	if variable.ExpressionType == physical.ExpressionTypeConstant {
+		variable, constant = constant, variable
+		operator = flippedOperators[operator]
+	}
+	if variable.ExpressionType != physical.ExpressionTypeVariable || !variable.Variable.IsLevel0 ||
+		constant.ExpressionType != physical.ExpressionTypeConstant {
+		return statisticsPredicate{}, false
+	}
+
+	field, ok := i.field(variable.Variable.Name)
+	if !ok || len(field.children) > 0 || field.element.RepetitionType == repetitionRepeated {
+		return statisticsPredicate{}, false
+	}
+	switch field.kind {
+	case leafKindBoolean, leafKindInt, leafKindString,
+		leafKindDate, leafKindTimestampMillis, leafKindTimestampMicros, leafKindTimestampNanos:
+	case leafKindFloat:
+		if constant.Constant.Value.TypeID == octosql.TypeIDFloat && constant.Constant.Value.Float != constant.Constant.Value.Float {
+			// NaN doesn't have a well-defined ordering in the statistics.
+			return statisticsPredicate{}, false
+		}
+	default:
+		return statisticsPredicate{}, false
+	}
+	if constant.Constant.Value.TypeID != field.kind.Type().TypeID {
+		return statisticsPredicate{}, false
+	}
+
+	return statisticsPredicate{
+		column:   field.firstLeaf,
+		leaf:     field,
+		operator: operator,
+		value:    constant.Constant.Value,
+	}, true
+}
+
+// mayMatch checks whether any row in the row group may satisfy the predicate.
+func (p *statisticsPredicate) mayMatch(group rowGroup) bool {
+	stats := group.Columns[p.column].Statistics
+	if stats == nil {
+		return true
+	}
+	if stats.HasNullCount && stats.NullCount == group.NumRows {
+		// A comparison with null is never true.
+		return false
+	}
+	if !stats.HasMin || !stats.HasMax {
+		return true
+	}
+	min, _, err := decodePlain(statisticsValue(p.leaf, stats.Min), 1, p.leaf)
+	if err != nil {
+		return true
+	}
+	max, _, err := decodePlain(statisticsValue(p.leaf, stats.Max), 1, p.leaf)
+	if err != nil {
+		return true
+	}
+
+	switch p.operator {
+	case "=":
+		return min[0].Compare(p.value) <= 0 && max[0].Compare(p.value) >= 0
+	case "<":
+		return min[0].Compare(p.value) < 0
+	case "<=":
+		return min[0].Compare(p.value) <= 0
+	case ">":
+		return max[0].Compare(p.value) > 0
+	case ">=":
+		return max[0].Compare(p.value) >= 0
+	}
+	return true
+}
+
+// statisticsValue prepares a statistics value to be decoded as a plain value.
+func statisticsValue(leaf *schemaNode, data []byte) []byte {
+	if leaf.element.Type != typeByteArray {
+		return data
+	}
+	// Byte array statistics aren't length-prefixed.
+	out := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(out, uint32(len(data)))
+	copy(out[4:], data)
+	return out
+}
@@ -0,0 +1,333 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Physical types.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Repetition types.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// Converted types, the legacy logical type annotations.
+const (
+	convertedUTF8            = 0
+	convertedMap             = 1
+	convertedMapKeyValue     = 2
+	convertedList            = 3
+	convertedEnum            = 4
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimeMillis      = 7
+	convertedTimeMicros      = 8
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedJSON            = 19
+)
+
+// Field ids of the logical type union.
+const (
+	logicalString    = 1
+	logicalMap       = 2
+	logicalList      = 3
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTime      = 7
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalJSON      = 12
+	logicalUUID      = 14
+)
+
+// Time units.
+const (
+	timeUnitMillis = 1
+	timeUnitMicros = 2
+	timeUnitNanos  = 3
+)
+
+// Encodings.
+const (
+	encodingPlain                = 0
+	encodingPlainDictionary      = 2
+	encodingRLE                  = 3
+	encodingBitPacked            = 4
+	encodingDeltaBinaryPacked    = 5
+	encodingDeltaLengthByteArray = 6
+	encodingDeltaByteArray       = 7
+	encodingRLEDictionary        = 8
+	encodingByteStreamSplit      = 9
+)
+
+// Compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+// Page types.
+const (
+	pageTypeDataPage       = 0
+	pageTypeDictionaryPage = 2
+	pageTypeDataPageV2     = 3
+)
+
+type fileMetadata struct {
+	Schema    []schemaElement
+	NumRows   int64
+	RowGroups []rowGroup
+}
+
+type schemaElement struct {
+	Type           int64
+	HasType        bool
+	TypeLength     int64
+	RepetitionType int64
+	Name           string
+	NumChildren    int64
+	ConvertedType  int64
+	HasConverted   bool
+	Scale          int64
+	Precision      int64
+	// LogicalType is the raw logical type union, if present.
+	LogicalType thriftStruct
+}
+
+type rowGroup struct {
+	Columns []columnChunk
+	NumRows int64
+}
+
+type columnChunk struct {
+	Type                 int64
+	PathInSchema         []string
+	Codec                int64
+	NumValues            int64
+	TotalCompressedSize  int64
+	DataPageOffset       int64
+	DictionaryPageOffset int64
+	HasDictionaryPage    bool
+	Statistics           *statistics
+}
+
+type statistics struct {
+	Min, Max       []byte
+	HasMin, HasMax bool
+	NullCount      int64
+	HasNullCount   bool
+}
+
+var magic = []byte("PAR1")
+
+func readFileMetadata(f *os.File) (*fileMetadata, error) {
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't stat file: %w", err)
+	}
+	if stat.Size() < 12 {
+		return nil, fmt.Errorf("file too small to be a parquet file")
+	}
+
+	footer := make([]byte, 8)
+	if _, err := f.ReadAt(footer, stat.Size()-8); err != nil {
+		return nil, fmt.Errorf("couldn't read footer: %w", err)
+	}
+	if !bytes.Equal(footer[4:], magic) {
+		return nil, fmt.Errorf("invalid parquet magic bytes, encrypted files aren't supported")
+	}
+	metadataLength := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if metadataLength > stat.Size()-12 {
+		return nil, fmt.Errorf("invalid metadata length: %d", metadataLength)
+	}
+
+	data := make([]byte, metadataLength)
+	if _, err := f.ReadAt(data, stat.Size()-8-metadataLength); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("couldn't read metadata: %w", err)
+	}
+
+	r := &thriftReader{data: data}
+	raw, err := r.readStruct()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode metadata: %w", err)
+	}
+
+	return parseFileMetadata(raw)
+}
+
+func parseFileMetadata(raw thriftStruct) (*fileMetadata, error) {
+	var out fileMetadata
+	out.NumRows, _ = raw.Int(3)
+
+	for _, item := range raw.List(2) {
+		element, ok := item.(thriftStruct)
+		if !ok {
+			return nil, fmt.Errorf("invalid schema element")
+		}
+		var parsed schemaElement
+		parsed.Type, parsed.HasType = element.Int(1)
+		parsed.TypeLength, _ = element.Int(2)
+		parsed.RepetitionType, _ = element.Int(3)
+		name, _ := element.Binary(4)
+		parsed.Name = string(name)
+		parsed.NumChildren, _ = element.Int(5)
+		parsed.ConvertedType, parsed.HasConverted = element.Int(6)
+		parsed.Scale, _ = element.Int(7)
+		parsed.Precision, _ = element.Int(8)
+		parsed.LogicalType, _ = element.Struct(10)
+		if decimal, ok := parsed.LogicalType.Struct(logicalDecimal); ok {
+			parsed.Scale, _ = decimal.Int(1)
+			parsed.Precision, _ = decimal.Int(2)
+		}
+		out.Schema = append(out.Schema, parsed)
+	}
+
+	for _, item := range raw.List(4) {
+		group, ok := item.(thriftStruct)
+		if !ok {
+			return nil, fmt.Errorf("invalid row group")
+		}
+		var parsed rowGroup
+		parsed.NumRows, _ = group.Int(3)
+		for _, item := range group.List(1) {
+			chunk, ok := item.(thriftStruct)
+			if !ok {
+				return nil, fmt.Errorf("invalid column chunk")
+			}
+			if path, ok := chunk.Binary(1); ok && len(path) > 0 {
+				return nil, fmt.Errorf("column chunks in external files aren't supported")
+			}
+			meta, ok := chunk.Struct(3)
+			if !ok {
+				return nil, fmt.Errorf("column chunk without metadata")
+			}
+			parsed.Columns = append(parsed.Columns, parseColumnMetadata(meta))
+		}
+		out.RowGroups = append(out.RowGroups, parsed)
+	}
+
+	return &out, nil
+}
+
+func parseColumnMetadata(meta thriftStruct) columnChunk {
+	var out columnChunk
+	out.Type, _ = meta.Int(1)
+	for _, item := range meta.List(3) {
+		if name, ok := item.([]byte); ok {
+			out.PathInSchema = append(out.PathInSchema, string(name))
+		}
+	}
+	out.Codec, _ = meta.Int(4)
+	out.NumValues, _ = meta.Int(5)
+	out.TotalCompressedSize, _ = meta.Int(7)
+	out.DataPageOffset, _ = meta.Int(9)
+	out.DictionaryPageOffset, out.HasDictionaryPage = meta.Int(11)
+
+	if stats, ok := meta.Struct(12); ok {
+		var parsed statistics
+		// The min_value and max_value fields have a well-defined sort order, min and max are deprecated.
+		parsed.Min, parsed.HasMin = stats.Binary(6)
+		parsed.Max, parsed.HasMax = stats.Binary(5)
+		if !parsed.HasMin || !parsed.HasMax {
+			parsed.Min, parsed.HasMin = stats.Binary(2)
+			parsed.Max, parsed.HasMax = stats.Binary(1)
+			// The deprecated fields were written using signed comparison, which is wrong for byte arrays.
+			if out.Type == typeByteArray || out.Type == typeFixedLenByteArray {
+				parsed.HasMin, parsed.HasMax = false, false
+			}
+		}
+		parsed.NullCount, parsed.HasNullCount = stats.Int(3)
+		out.Statistics = &parsed
+	}
+
+	return out
+}
+
+type pageHeader struct {
+	Type                 int64
+	UncompressedPageSize int64
+	CompressedPageSize   int64
+
+	// Data page v1 and v2.
+	NumValues int64
+	Encoding  int64
+
+	// Data page v1.
+	DefinitionLevelEncoding int64
+	RepetitionLevelEncoding int64
+
+	// Data page v2.
+	DefinitionLevelsByteLength int64
+	RepetitionLevelsByteLength int64
+	IsCompressed               bool
+}
+
+func readPageHeader(r *thriftReader) (*pageHeader, error) {
+	raw, err := r.readStruct()
+	if err != nil {
+		return nil, err
+	}
+
+	var out pageHeader
+	out.Type, _ = raw.Int(1)
+	out.UncompressedPageSize, _ = raw.Int(2)
+	out.CompressedPageSize, _ = raw.Int(3)
+
+	switch out.Type {
+	case pageTypeDataPage:
+		header, ok := raw.Struct(5)
+		if !ok {
+			return nil, fmt.Errorf("data page without data page header")
+		}
+		out.NumValues, _ = header.Int(1)
+		out.Encoding, _ = header.Int(2)
+		out.DefinitionLevelEncoding, _ = header.Int(3)
+		out.RepetitionLevelEncoding, _ = header.Int(4)
+	case pageTypeDictionaryPage:
+		header, ok := raw.Struct(7)
+		if !ok {
+			return nil, fmt.Errorf("dictionary page without dictionary page header")
+		}
+		out.NumValues, _ = header.Int(1)
+		out.Encoding, _ = header.Int(2)
+	case pageTypeDataPageV2:
+		header, ok := raw.Struct(8)
+		if !ok {
+			return nil, fmt.Errorf("data page v2 without data page header")
+		}
+		out.NumValues, _ = header.Int(1)
+		out.Encoding, _ = header.Int(4)
+		out.DefinitionLevelsByteLength, _ = header.Int(5)
+		out.RepetitionLevelsByteLength, _ = header.Int(6)
+		out.IsCompressed = true
+		if isCompressed, ok := header.Bool(7); ok {
+			out.IsCompressed = isCompressed
+		}
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,284 @@
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// The test files are generated by testdata/generate.go.
+
+func readFile(t *testing.T, path string, pushedDownPredicates ...physical.Expression) []execution.Record {
+	impl, schema, err := Creator(path)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	node, err := impl.Materialize(ctx, physical.Environment{}, schema, pushedDownPredicates)
+	require.NoError(t, err)
+
+	var records []execution.Record
+	require.NoError(t, node.Run(
+		execution.ExecutionContext{Context: ctx},
+		func(ctx execution.ProduceContext, record execution.Record) error {
+			records = append(records, record)
+			return nil
+		},
+		func(ctx execution.ProduceContext, msg execution.MetadataMessage) error {
+			return nil
+		},
+	))
+	return records
+}
+
+func recordValues(records []execution.Record) [][]octosql.Value {
+	out := make([][]octosql.Value, len(records))
+	for i := range records {
+		out[i] = records[i].Values
+	}
+	return out
+}
+
+func TestTypes(t *testing.T) {
+	_, schema, err := Creator("testdata/types.parquet")
+	require.NoError(t, err)
+	expectedTypes := []octosql.Type{
+		octosql.Boolean,
+		octosql.Int,
+		octosql.TypeSum(octosql.Int, octosql.Null),
+		octosql.Float,
+		octosql.TypeSum(octosql.Float, octosql.Null),
+		octosql.TypeSum(octosql.String, octosql.Null),
+		octosql.Date,
+		octosql.Time,
+		octosql.Time,
+		octosql.Time,
+		octosql.Int,
+		octosql.NewDecimalType(10, 2),
+	}
+	for i := range expectedTypes {
+		assert.Equal(t, expectedTypes[i].String(), schema.Fields[i].Type.String(), schema.Fields[i].Name)
+	}
+
+	assert.Equal(t, [][]octosql.Value{
+		{
+			octosql.NewBoolean(true),
+			octosql.NewInt(1),
+			octosql.NewInt(10),
+			octosql.NewFloat(1.5),
+			octosql.NewFloat(2.25),
+			octosql.NewString("one"),
+			octosql.NewDate(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)),
+			octosql.NewTime(time.Date(2021, 3, 4, 15, 30, 0, 123456000, time.UTC)),
+			octosql.NewTime(time.Date(2021, 3, 4, 15, 30, 0, 123000000, time.UTC)),
+			octosql.NewTime(time.Date(2021, 3, 4, 15, 30, 0, 0, time.UTC)),
+			octosql.NewInt(4294967295),
+			decimal(12345, 2),
+		},
+		{
+			octosql.NewBoolean(false),
+			octosql.NewInt(-2),
+			octosql.NewNull(),
+			octosql.NewFloat(-0.5),
+			octosql.NewNull(),
+			octosql.NewString(""),
+			octosql.NewDate(time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)),
+			octosql.NewTime(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)),
+			octosql.NewTime(time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)),
+			octosql.NewTime(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)),
+			octosql.NewInt(7),
+			decimal(-5, 2),
+		},
+		{
+			octosql.NewBoolean(true),
+			octosql.NewInt(2147483647),
+			octosql.NewInt(-9223372036854775808),
+			octosql.NewFloat(3),
+			octosql.NewFloat(1e100),
+			octosql.NewNull(),
+			octosql.NewDate(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)),
+			octosql.NewTime(time.Date(1969, 12, 31, 23, 59, 59, 999999000, time.UTC)),
+			octosql.NewTime(time.Date(1970, 1, 1, 0, 0, 0, 1000000, time.UTC)),
+			octosql.NewTime(time.Date(1971, 1, 1, 0, 0, 0, 0, time.UTC)),
+			octosql.NewInt(0),
+			decimal(100, 2),
+		},
+	}, recordValues(readFile(t, "testdata/types.parquet")))
+}
+
+func decimal(unscaled int64, scale int) octosql.Value {
+	return octosql.NewDecimal(octosql.DecimalNumber{Unscaled: big.NewInt(unscaled), Scale: scale})
+}
+
+func encodingsRow(i int) []octosql.Value {
+	words := []string{"apple", "banana", "cherry", "date", "elderberry"}
+	word, flag, path := octosql.NewNull(), octosql.NewNull(), octosql.NewNull()
+	if i%7 != 0 {
+		word = octosql.NewString(words[i*i%len(words)])
+	}
+	if i%11 != 0 {
+		flag = octosql.NewBoolean((i/50)%2 == 0)
+	}
+	if i%13 != 0 {
+		path = octosql.NewString(fmt.Sprintf("/data/%d/%d", i/100, i))
+	}
+	return []octosql.Value{
+		octosql.NewInt(i),
+		word,
+		octosql.NewInt(i % 3),
+		flag,
+		octosql.NewFloat(float64(i) / 4),
+		octosql.NewInt(i*i - 500*i),
+		octosql.NewString(fmt.Sprintf("name%d", i)),
+		path,
+	}
+}
+
+// TestEncodings reads a column of each encoding, using both data page versions, with each compression codec.
+func TestEncodings(t *testing.T) {
+	expected := make([][]octosql.Value, 1000)
+	for i := range expected {
+		expected[i] = encodingsRow(i)
+	}
+
+	for _, codec := range []string{"uncompressed", "snappy", "gzip", "zstd"} {
+		t.Run(codec, func(t *testing.T) {
+			assert.Equal(t, expected, recordValues(readFile(t, fmt.Sprintf("testdata/encodings_%s.parquet", codec))))
+		})
+	}
+}
+
+func TestNested(t *testing.T) {
+	_, schema, err := Creator("testdata/nested.parquet")
+	require.NoError(t, err)
+	expectedTypes := []string{
+		"Int",
+		"{name: String; age: Int | NULL} | NULL",
+		"[String | NULL] | NULL",
+		"[Int]",
+		"[{x: Int; y: Int}]",
+	}
+	for i := range expectedTypes {
+		assert.Equal(t, expectedTypes[i], schema.Fields[i].Type.String(), schema.Fields[i].Name)
+	}
+
+	list := func(values ...octosql.Value) octosql.Value {
+		if values == nil {
+			values = []octosql.Value{}
+		}
+		return octosql.NewList(values)
+	}
+	str := octosql.NewString
+	point := func(x, y int) octosql.Value {
+		return octosql.NewStruct([]octosql.Value{octosql.NewInt(x), octosql.NewInt(y)})
+	}
+	assert.Equal(t, [][]octosql.Value{
+		{
+			octosql.NewInt(1),
+			octosql.NewStruct([]octosql.Value{str("Alice"), octosql.NewInt(30)}),
+			list(str("a"), str("b")),
+			list(octosql.NewInt(1), octosql.NewInt(2), octosql.NewInt(3)),
+			list(point(1, 2)),
+		},
+		{
+			octosql.NewInt(2),
+			octosql.NewNull(),
+			octosql.NewNull(),
+			list(),
+			list(),
+		},
+		{
+			octosql.NewInt(3),
+			octosql.NewStruct([]octosql.Value{str("Bob"), octosql.NewNull()}),
+			list(),
+			list(octosql.NewInt(4)),
+			list(point(3, 4), point(5, 6)),
+		},
+		{
+			octosql.NewInt(4),
+			octosql.NewStruct([]octosql.Value{str("Carol"), octosql.NewInt(41)}),
+			list(octosql.NewNull(), str("c"), octosql.NewNull()),
+			list(),
+			list(point(7, 8)),
+		},
+	}, recordValues(readFile(t, "testdata/nested.parquet")))
+}
+
+func comparison(operator string, name string, value octosql.Value) physical.Expression {
+	return physical.Expression{
+		Type:           octosql.Boolean,
+		ExpressionType: physical.ExpressionTypeFunctionCall,
+		FunctionCall: &physical.FunctionCall{
+			Name: operator,
+			Arguments: []physical.Expression{
+				{
+					ExpressionType: physical.ExpressionTypeVariable,
+					Variable:       &physical.Variable{Name: name, IsLevel0: true},
+				},
+				{
+					Type:           value.Type(),
+					ExpressionType: physical.ExpressionTypeConstant,
+					Constant:       &physical.Constant{Value: value},
+				},
+			},
+			FunctionDescriptor: physical.FunctionDescriptor{
+				Function: func(values []octosql.Value) (octosql.Value, error) {
+					if values[0].TypeID == octosql.TypeIDNull {
+						return octosql.NewNull(), nil
+					}
+					cmp := values[0].Compare(values[1])
+					switch operator {
+					case "=":
+						return octosql.NewBoolean(cmp == 0), nil
+					case "<":
+						return octosql.NewBoolean(cmp < 0), nil
+					case ">":
+						return octosql.NewBoolean(cmp > 0), nil
+					}
+					panic("unsupported operator")
+				},
+			},
+		},
+	}
+}
+
+func TestRowGroupSkipping(t *testing.T) {
+	implementation, _, err := Creator("testdata/row_groups.parquet")
+	require.NoError(t, err)
+	i := implementation.(*impl)
+	require.Len(t, i.metadata.RowGroups, 3)
+
+	tests := []struct {
+		predicate physical.Expression
+		mayMatch  []bool
+		rows      int
+	}{
+		{comparison(">", "id", octosql.NewInt(250)), []bool{false, false, true}, 49},
+		{comparison("<", "id", octosql.NewInt(100)), []bool{true, false, false}, 100},
+		{comparison("=", "id", octosql.NewInt(150)), []bool{false, true, false}, 1},
+		{comparison("=", "name", octosql.NewString("name042")), []bool{true, false, false}, 1},
+		{comparison(">", "name", octosql.NewString("name3")), []bool{false, false, false}, 0},
+		// The first row group only contains nulls in this column.
+		{comparison("=", "optional", octosql.NewInt(5)), []bool{false, true, true}, 20},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("%s %s %s", tt.predicate.FunctionCall.Arguments[0].Variable.Name, tt.predicate.FunctionCall.Name, tt.predicate.FunctionCall.Arguments[1].Constant.Value.String())
+		t.Run(name, func(t *testing.T) {
+			predicate, ok := i.getStatisticsPredicate(tt.predicate)
+			require.True(t, ok)
+			for group := range i.metadata.RowGroups {
+				assert.Equal(t, tt.mayMatch[group], predicate.mayMatch(i.metadata.RowGroups[group]), "row group %d", group)
+			}
+
+			assert.Len(t, readFile(t, "testdata/row_groups.parquet", tt.predicate), tt.rows)
+		})
+	}
+}
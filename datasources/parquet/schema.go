@@ -0,0 +1,364 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+type schemaNode struct {
+	element  schemaElement
+	children []*schemaNode
+	// definitionLevel and repetitionLevel are the levels at which this node is present.
+	definitionLevel, repetitionLevel int
+	// firstLeaf is the column index of the first leaf under this node, which we use to check for its presence.
+	firstLeaf int
+	// leaves are the column indices of all leaves under this node.
+	leaves []int
+
+	// Only set for leaves.
+	kind leafKind
+}
+
+type leafKind int
+
+const (
+	leafKindBoolean leafKind = iota
+	leafKindInt
+	leafKindUint32
+	leafKindFloat
+	leafKindString
+	leafKindUUID
+	leafKindDate
+	leafKindTimestampMillis
+	leafKindTimestampMicros
+	leafKindTimestampNanos
+	leafKindTimeMillis
+	leafKindTimeMicros
+	leafKindTimeNanos
+	leafKindDecimal
+	leafKindInt96
+)
+
+func buildSchemaTree(elements []schemaElement) (*schemaNode, []*schemaNode, error) {
+	if len(elements) == 0 {
+		return nil, nil, fmt.Errorf("empty schema")
+	}
+
+	var leaves []*schemaNode
+	pos := 0
+	var build func(definitionLevel, repetitionLevel int, isRoot bool) (*schemaNode, error)
+	build = func(definitionLevel, repetitionLevel int, isRoot bool) (*schemaNode, error) {
+		if pos >= len(elements) {
+			return nil, fmt.Errorf("invalid schema, not enough elements")
+		}
+		node := &schemaNode{element: elements[pos]}
+		pos++
+
+		if !isRoot {
+			switch node.element.RepetitionType {
+			case repetitionOptional:
+				definitionLevel++
+			case repetitionRepeated:
+				definitionLevel++
+				repetitionLevel++
+			}
+		}
+		node.definitionLevel = definitionLevel
+		node.repetitionLevel = repetitionLevel
+
+		if !isRoot && node.element.NumChildren == 0 {
+			kind, err := getLeafKind(node.element)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported column %s: %w", node.element.Name, err)
+			}
+			node.kind = kind
+			node.firstLeaf = len(leaves)
+			node.leaves = []int{len(leaves)}
+			leaves = append(leaves, node)
+			return node, nil
+		}
+
+		for i := 0; i < int(node.element.NumChildren); i++ {
+			child, err := build(definitionLevel, repetitionLevel, false)
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+			node.leaves = append(node.leaves, child.leaves...)
+		}
+		if len(node.leaves) == 0 {
+			return nil, fmt.Errorf("group %s has no columns", node.element.Name)
+		}
+		node.firstLeaf = node.leaves[0]
+
+		return node, nil
+	}
+
+	root, err := build(0, 0, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return root, leaves, nil
+}
+
+func getLeafKind(element schemaElement) (leafKind, error) {
+	if !element.HasType {
+		return 0, fmt.Errorf("missing physical type")
+	}
+	logical := element.LogicalType
+
+	isDecimal := element.HasConverted && element.ConvertedType == convertedDecimal
+	if _, ok := logical.Struct(logicalDecimal); ok {
+		isDecimal = true
+	}
+
+	switch element.Type {
+	case typeBoolean:
+		return leafKindBoolean, nil
+
+	case typeInt32, typeInt64:
+		if isDecimal {
+			return leafKindDecimal, nil
+		}
+		if _, ok := logical.Struct(logicalDate); ok || element.HasConverted && element.ConvertedType == convertedDate {
+			return leafKindDate, nil
+		}
+		if timestamp, ok := logical.Struct(logicalTimestamp); ok {
+			unit, _ := timestamp.Struct(2)
+			switch {
+			case unit[timeUnitMillis] != nil:
+				return leafKindTimestampMillis, nil
+			case unit[timeUnitNanos] != nil:
+				return leafKindTimestampNanos, nil
+			default:
+				return leafKindTimestampMicros, nil
+			}
+		}
+		if t, ok := logical.Struct(logicalTime); ok {
+			unit, _ := t.Struct(2)
+			switch {
+			case unit[timeUnitMillis] != nil:
+				return leafKindTimeMillis, nil
+			case unit[timeUnitNanos] != nil:
+				return leafKindTimeNanos, nil
+			default:
+				return leafKindTimeMicros, nil
+			}
+		}
+		if element.HasConverted {
+			switch element.ConvertedType {
+			case convertedTimestampMillis:
+				return leafKindTimestampMillis, nil
+			case convertedTimestampMicros:
+				return leafKindTimestampMicros, nil
+			case convertedTimeMillis:
+				return leafKindTimeMillis, nil
+			case convertedTimeMicros:
+				return leafKindTimeMicros, nil
+			case convertedUint32:
+				if element.Type == typeInt32 {
+					return leafKindUint32, nil
+				}
+			}
+		}
+		if integer, ok := logical.Struct(logicalInteger); ok && element.Type == typeInt32 {
+			if signed, ok := integer.Bool(2); ok && !signed {
+				return leafKindUint32, nil
+			}
+		}
+		return leafKindInt, nil
+
+	case typeInt96:
+		return leafKindInt96, nil
+
+	case typeFloat, typeDouble:
+		return leafKindFloat, nil
+
+	case typeByteArray, typeFixedLenByteArray:
+		if element.Type == typeFixedLenByteArray && element.TypeLength <= 0 {
+			return 0, fmt.Errorf("invalid fixed length byte array length: %d", element.TypeLength)
+		}
+		if isDecimal {
+			return leafKindDecimal, nil
+		}
+		if _, ok := logical.Struct(logicalUUID); ok && element.Type == typeFixedLenByteArray && element.TypeLength == 16 {
+			return leafKindUUID, nil
+		}
+		// There is no binary type, so all byte arrays are read as strings.
+		return leafKindString, nil
+	}
+
+	return 0, fmt.Errorf("unknown physical type: %d", element.Type)
+}
+
+func (kind leafKind) Type() octosql.Type {
+	switch kind {
+	case leafKindBoolean:
+		return octosql.Boolean
+	case leafKindInt, leafKindUint32:
+		return octosql.Int
+	case leafKindFloat:
+		return octosql.Float
+	case leafKindDecimal:
+		return octosql.Type{TypeID: octosql.TypeIDDecimal}
+	case leafKindString, leafKindUUID:
+		return octosql.String
+	case leafKindDate:
+		return octosql.Date
+	case leafKindTimestampMillis, leafKindTimestampMicros, leafKindTimestampNanos, leafKindInt96:
+		return octosql.Time
+	case leafKindTimeMillis, leafKindTimeMicros, leafKindTimeNanos:
+		return octosql.Duration
+	}
+	panic(fmt.Sprintf("unexhaustive leaf kind match: %d", kind))
+}
+
+// repeatedChild returns the repeated child of a LIST or MAP annotated group, if the group is structured correctly.
+// Maps are read as lists of key-value structs.
+func (node *schemaNode) repeatedChild() (*schemaNode, bool) {
+	isList := node.element.HasConverted && (node.element.ConvertedType == convertedList || node.element.ConvertedType == convertedMap || node.element.ConvertedType == convertedMapKeyValue)
+	if _, ok := node.element.LogicalType.Struct(logicalList); ok {
+		isList = true
+	}
+	if _, ok := node.element.LogicalType.Struct(logicalMap); ok {
+		isList = true
+	}
+	if !isList || len(node.children) != 1 || node.children[0].element.RepetitionType != repetitionRepeated {
+		return nil, false
+	}
+	return node.children[0], true
+}
+
+// isThreeLevelList checks whether the repeated child of a list is just a wrapper for the list element,
+// following the backward-compatibility rules of the Parquet format.
+func (node *schemaNode) isThreeLevelList(repeated *schemaNode) bool {
+	if len(repeated.children) != 1 {
+		return false
+	}
+	if repeated.element.Name == "array" || repeated.element.Name == node.element.Name+"_tuple" {
+		return false
+	}
+	return true
+}
+
+// fieldType is the type of the node, including its repetition.
+func (node *schemaNode) fieldType() octosql.Type {
+	switch node.element.RepetitionType {
+	case repetitionOptional:
+		return octosql.TypeSum(node.valueType(), octosql.Null)
+	case repetitionRepeated:
+		element := node.valueType()
+		return octosql.Type{
+			TypeID: octosql.TypeIDList,
+			List: struct {
+				Element *octosql.Type
+			}{
+				Element: &element,
+			},
+		}
+	default:
+		return node.valueType()
+	}
+}
+
+// valueType is the type of a present instance of the node.
+func (node *schemaNode) valueType() octosql.Type {
+	if len(node.children) == 0 {
+		if node.kind == leafKindDecimal {
+			return octosql.NewDecimalType(int(node.element.Precision), int(node.element.Scale))
+		}
+		return node.kind.Type()
+	}
+	if repeated, ok := node.repeatedChild(); ok {
+		var element octosql.Type
+		if node.isThreeLevelList(repeated) {
+			element = repeated.children[0].fieldType()
+		} else {
+			element = repeated.valueType()
+		}
+		return octosql.Type{
+			TypeID: octosql.TypeIDList,
+			List: struct {
+				Element *octosql.Type
+			}{
+				Element: &element,
+			},
+		}
+	}
+
+	fields := make([]octosql.StructField, len(node.children))
+	for i, child := range node.children {
+		fields[i] = octosql.StructField{
+			Name: child.element.Name,
+			Type: child.fieldType(),
+		}
+	}
+	return octosql.Type{
+		TypeID: octosql.TypeIDStruct,
+		Struct: struct{ Fields []octosql.StructField }{Fields: fields},
+	}
+}
+
+func (node *schemaNode) convertInt32(v int32) octosql.Value {
+	switch node.kind {
+	case leafKindUint32:
+		return octosql.NewInt(int(uint32(v)))
+	case leafKindDate:
+		return octosql.NewDate(time.Unix(int64(v)*24*60*60, 0).UTC())
+	case leafKindTimeMillis:
+		return octosql.NewDuration(time.Duration(v) * time.Millisecond)
+	case leafKindDecimal:
+		return node.convertDecimal(big.NewInt(int64(v)))
+	}
+	return octosql.NewInt(int(v))
+}
+
+func (node *schemaNode) convertInt64(v int64) octosql.Value {
+	switch node.kind {
+	case leafKindTimestampMillis:
+		return octosql.NewTime(time.Unix(v/1e3, v%1e3*1e6).UTC())
+	case leafKindTimestampMicros:
+		return octosql.NewTime(time.Unix(v/1e6, v%1e6*1e3).UTC())
+	case leafKindTimestampNanos:
+		return octosql.NewTime(time.Unix(0, v).UTC())
+	case leafKindTimeMicros:
+		return octosql.NewDuration(time.Duration(v) * time.Microsecond)
+	case leafKindTimeNanos:
+		return octosql.NewDuration(time.Duration(v))
+	case leafKindDecimal:
+		return node.convertDecimal(big.NewInt(v))
+	}
+	return octosql.NewInt(int(v))
+}
+
+func (node *schemaNode) convertDecimal(unscaled *big.Int) octosql.Value {
+	return octosql.NewDecimal(octosql.DecimalNumber{Unscaled: unscaled, Scale: int(node.element.Scale)})
+}
+
+func (node *schemaNode) convertInt96(data []byte) octosql.Value {
+	nanosOfDay := int64(binary.LittleEndian.Uint64(data[:8]))
+	julianDay := int64(binary.LittleEndian.Uint32(data[8:12]))
+	const julianDayOfUnixEpoch = 2440588
+	return octosql.NewTime(time.Unix((julianDay-julianDayOfUnixEpoch)*24*60*60, nanosOfDay).UTC())
+}
+
+func (node *schemaNode) convertBytes(data []byte) octosql.Value {
+	switch node.kind {
+	case leafKindDecimal:
+		// Big-endian two's complement.
+		unscaled := new(big.Int).SetBytes(data)
+		if len(data) > 0 && data[0]&0x80 != 0 {
+			unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(data)*8)))
+		}
+		return node.convertDecimal(unscaled)
+	case leafKindUUID:
+		return octosql.NewString(fmt.Sprintf("%x-%x-%x-%x-%x", data[0:4], data[4:6], data[6:8], data[8:10], data[10:16]))
+	case leafKindInt96:
+		return node.convertInt96(data)
+	}
+	return octosql.NewString(string(data))
+}
@@ -0,0 +1,963 @@
+//go:build ignore
+// +build ignore
+
+// This program generates the Parquet files used by the datasource tests.
+// It's a minimal writer following the format specification, independent of the reader.
+//
+// Run it from this directory using: go run generate.go
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+func main() {
+	writeTypes()
+	for _, codec := range []struct {
+		name  string
+		codec int32
+	}{
+		{"uncompressed", codecUncompressed},
+		{"snappy", codecSnappy},
+		{"gzip", codecGzip},
+		{"zstd", codecZstd},
+	} {
+		writeEncodings(fmt.Sprintf("encodings_%s.parquet", codec.name), codec.codec)
+	}
+	writeNested()
+	writeRowGroups()
+}
+
+const (
+	typeBoolean   = 0
+	typeInt32     = 1
+	typeInt64     = 2
+	typeInt96     = 3
+	typeFloat     = 4
+	typeDouble    = 5
+	typeByteArray = 6
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+
+	convertedUTF8            = 0
+	convertedList            = 3
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+
+	encodingPlain                = 0
+	encodingPlainDictionary      = 2
+	encodingRLE                  = 3
+	encodingDeltaBinaryPacked    = 5
+	encodingDeltaLengthByteArray = 6
+	encodingDeltaByteArray       = 7
+	encodingRLEDictionary        = 8
+	encodingByteStreamSplit      = 9
+
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+
+	pageTypeDataPage       = 0
+	pageTypeDictionaryPage = 2
+	pageTypeDataPageV2     = 3
+)
+
+var words = []string{"apple", "banana", "cherry", "date", "elderberry"}
+
+// The rows of these files are described in parquet_test.go.
+
+func writeTypes() {
+	int96 := func(t int64) []byte {
+		out := make([]byte, 12)
+		const julianDayOfUnixEpoch = 2440588
+		binary.LittleEndian.PutUint64(out, uint64(t%86400*1e9))
+		binary.LittleEndian.PutUint32(out[8:], uint32(t/86400+julianDayOfUnixEpoch))
+		return out
+	}
+	schema := &node{name: "schema", children: []*node{
+		{name: "b", repetition: repetitionRequired, physicalType: typeBoolean},
+		{name: "i32", repetition: repetitionRequired, physicalType: typeInt32},
+		{name: "i64", repetition: repetitionOptional, physicalType: typeInt64},
+		{name: "f", repetition: repetitionRequired, physicalType: typeFloat},
+		{name: "d", repetition: repetitionOptional, physicalType: typeDouble},
+		{name: "s", repetition: repetitionOptional, physicalType: typeByteArray, convertedType: convertedUTF8, logicalType: tStruct{{1, tStruct{}}}},
+		{name: "date", repetition: repetitionRequired, physicalType: typeInt32, convertedType: convertedDate, logicalType: tStruct{{6, tStruct{}}}},
+		{name: "ts", repetition: repetitionRequired, physicalType: typeInt64, logicalType: tStruct{{8, tStruct{{1, true}, {2, tStruct{{2, tStruct{}}}}}}}},
+		{name: "ts_millis", repetition: repetitionRequired, physicalType: typeInt64, convertedType: convertedTimestampMillis},
+		{name: "ts_int96", repetition: repetitionRequired, physicalType: typeInt96},
+		{name: "u32", repetition: repetitionRequired, physicalType: typeInt32, logicalType: tStruct{{10, tStruct{{1, int8(32)}, {2, false}}}}},
+		{name: "decimal", repetition: repetitionRequired, physicalType: typeInt64, convertedType: convertedDecimal, scale: 2, precision: 10},
+	}}
+	rows := [][]interface{}{
+		{true, int32(1), int64(10), float32(1.5), 2.25, []byte("one"), int32(0), int64(1614871800123456), int64(1614871800123), int96(1614871800), int32(-1), int64(12345)},
+		{false, int32(-2), nil, float32(-0.5), nil, []byte(""), int32(18690), int64(0), int64(-1000), int96(0), int32(7), int64(-5)},
+		{true, int32(2147483647), int64(-9223372036854775808), float32(3), 1e100, nil, int32(-1), int64(-1), int64(1), int96(86400 * 365), int32(0), int64(100)},
+	}
+	options := func(leaf *node) columnOptions {
+		return columnOptions{encoding: encodingPlain, pageVersion: 1, rowsPerPage: 2, codec: codecUncompressed}
+	}
+	writeFile("types.parquet", schema, [][][]interface{}{rows}, options)
+}
+
+func encodingsRow(i int) []interface{} {
+	var word, flag, path interface{}
+	if i%7 != 0 {
+		word = []byte(words[i*i%len(words)])
+	}
+	if i%11 != 0 {
+		flag = (i/50)%2 == 0
+	}
+	if i%13 != 0 {
+		path = []byte(fmt.Sprintf("/data/%d/%d", i/100, i))
+	}
+	return []interface{}{
+		int64(i),
+		word,
+		int32(i % 3),
+		flag,
+		float64(i) / 4,
+		int64(i*i - 500*i),
+		[]byte(fmt.Sprintf("name%d", i)),
+		path,
+	}
+}
+
+func writeEncodings(filename string, codec int32) {
+	schema := &node{name: "schema", children: []*node{
+		{name: "id", repetition: repetitionRequired, physicalType: typeInt64},
+		{name: "word", repetition: repetitionOptional, physicalType: typeByteArray, convertedType: convertedUTF8},
+		{name: "category", repetition: repetitionRequired, physicalType: typeInt32},
+		{name: "flag", repetition: repetitionOptional, physicalType: typeBoolean},
+		{name: "value", repetition: repetitionRequired, physicalType: typeDouble},
+		{name: "delta", repetition: repetitionRequired, physicalType: typeInt64},
+		{name: "name", repetition: repetitionRequired, physicalType: typeByteArray, convertedType: convertedUTF8},
+		{name: "path", repetition: repetitionOptional, physicalType: typeByteArray, convertedType: convertedUTF8},
+	}}
+	var rows [][]interface{}
+	for i := 0; i < 1000; i++ {
+		rows = append(rows, encodingsRow(i))
+	}
+	encodings := map[string]columnOptions{
+		"id":       {encoding: encodingPlain, pageVersion: 1},
+		"word":     {encoding: encodingRLEDictionary, pageVersion: 1},
+		"category": {encoding: encodingPlainDictionary, pageVersion: 2},
+		"flag":     {encoding: encodingRLE, pageVersion: 2},
+		"value":    {encoding: encodingByteStreamSplit, pageVersion: 1},
+		"delta":    {encoding: encodingDeltaBinaryPacked, pageVersion: 2},
+		"name":     {encoding: encodingDeltaLengthByteArray, pageVersion: 1},
+		"path":     {encoding: encodingDeltaByteArray, pageVersion: 2},
+	}
+	options := func(leaf *node) columnOptions {
+		out := encodings[leaf.name]
+		out.rowsPerPage = 300
+		out.codec = codec
+		return out
+	}
+	writeFile(filename, schema, [][][]interface{}{rows}, options)
+}
+
+func writeNested() {
+	list := func(elements ...interface{}) []interface{} {
+		// Each element of a three-level list is wrapped in the repeated group.
+		out := make([]interface{}, len(elements))
+		for i := range elements {
+			out[i] = []interface{}{elements[i]}
+		}
+		return []interface{}{out}
+	}
+	points := func(elements ...interface{}) []interface{} {
+		return []interface{}{elements}
+	}
+	schema := &node{name: "schema", children: []*node{
+		{name: "id", repetition: repetitionRequired, physicalType: typeInt32},
+		{name: "person", repetition: repetitionOptional, children: []*node{
+			{name: "name", repetition: repetitionRequired, physicalType: typeByteArray, convertedType: convertedUTF8},
+			{name: "age", repetition: repetitionOptional, physicalType: typeInt32},
+		}},
+		{name: "tags", repetition: repetitionOptional, convertedType: convertedList, children: []*node{
+			{name: "list", repetition: repetitionRepeated, children: []*node{
+				{name: "element", repetition: repetitionOptional, physicalType: typeByteArray, convertedType: convertedUTF8},
+			}},
+		}},
+		{name: "scores", repetition: repetitionRepeated, physicalType: typeInt32},
+		{name: "points", repetition: repetitionRequired, convertedType: convertedList, children: []*node{
+			{name: "array", repetition: repetitionRepeated, children: []*node{
+				{name: "x", repetition: repetitionRequired, physicalType: typeInt32},
+				{name: "y", repetition: repetitionRequired, physicalType: typeInt32},
+			}},
+		}},
+	}}
+	rows := [][]interface{}{
+		{int32(1), []interface{}{[]byte("Alice"), int32(30)}, list([]byte("a"), []byte("b")), []interface{}{int32(1), int32(2), int32(3)}, points([]interface{}{int32(1), int32(2)})},
+		{int32(2), nil, nil, []interface{}{}, points()},
+		{int32(3), []interface{}{[]byte("Bob"), nil}, list(), []interface{}{int32(4)}, points([]interface{}{int32(3), int32(4)}, []interface{}{int32(5), int32(6)})},
+		{int32(4), []interface{}{[]byte("Carol"), int32(41)}, list(nil, []byte("c"), nil), []interface{}{}, points([]interface{}{int32(7), int32(8)})},
+	}
+	options := func(leaf *node) columnOptions {
+		out := columnOptions{encoding: encodingPlain, pageVersion: 1, rowsPerPage: 3, codec: codecSnappy}
+		if leaf.name == "element" {
+			out.encoding = encodingRLEDictionary
+			out.pageVersion = 2
+		}
+		return out
+	}
+	writeFile("nested.parquet", schema, [][][]interface{}{rows}, options)
+}
+
+func writeRowGroups() {
+	schema := &node{name: "schema", children: []*node{
+		{name: "id", repetition: repetitionRequired, physicalType: typeInt64},
+		{name: "name", repetition: repetitionRequired, physicalType: typeByteArray, convertedType: convertedUTF8},
+		{name: "optional", repetition: repetitionOptional, physicalType: typeInt64},
+	}}
+	var groups [][][]interface{}
+	for group := 0; group < 3; group++ {
+		var rows [][]interface{}
+		for i := group * 100; i < (group+1)*100; i++ {
+			var optional interface{}
+			if group > 0 {
+				optional = int64(i % 10)
+			}
+			rows = append(rows, []interface{}{int64(i), []byte(fmt.Sprintf("name%03d", i)), optional})
+		}
+		groups = append(groups, rows)
+	}
+	options := func(leaf *node) columnOptions {
+		return columnOptions{encoding: encodingPlain, pageVersion: 1, rowsPerPage: 100, codec: codecUncompressed}
+	}
+	writeFile("row_groups.parquet", schema, groups, options)
+}
+
+// Schema and shredding.
+
+type node struct {
+	name          string
+	repetition    int32
+	physicalType  int32
+	convertedType int32
+	logicalType   tStruct
+	scale         int32
+	precision     int32
+	children      []*node
+
+	maxDefinitionLevel, maxRepetitionLevel int32
+	path                                   []string
+}
+
+func (n *node) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+func (n *node) leaves() []*node {
+	if n.isLeaf() {
+		return []*node{n}
+	}
+	var out []*node
+	for _, child := range n.children {
+		out = append(out, child.leaves()...)
+	}
+	return out
+}
+
+func (n *node) computeLevels(definitionLevel, repetitionLevel int32, path []string) {
+	switch n.repetition {
+	case repetitionOptional:
+		definitionLevel++
+	case repetitionRepeated:
+		definitionLevel++
+		repetitionLevel++
+	}
+	n.maxDefinitionLevel, n.maxRepetitionLevel = definitionLevel, repetitionLevel
+	n.path = append(append([]string{}, path...), n.name)
+	for _, child := range n.children {
+		child.computeLevels(definitionLevel, repetitionLevel, n.path)
+	}
+}
+
+type columnData struct {
+	definitionLevels []int32
+	repetitionLevels []int32
+	// values are only the defined values.
+	values []interface{}
+}
+
+// shred splits a value of the node into its leaf columns.
+func shred(n *node, value interface{}, definitionLevel, repetitionLevel int32, columns map[*node]*columnData) {
+	switch n.repetition {
+	case repetitionOptional:
+		if value == nil {
+			shredAbsent(n, definitionLevel, repetitionLevel, columns)
+			return
+		}
+		shredPresent(n, value, definitionLevel+1, repetitionLevel, columns)
+	case repetitionRepeated:
+		elements := value.([]interface{})
+		if len(elements) == 0 {
+			shredAbsent(n, definitionLevel, repetitionLevel, columns)
+			return
+		}
+		for i, element := range elements {
+			if i > 0 {
+				repetitionLevel = n.maxRepetitionLevel
+			}
+			shredPresent(n, element, definitionLevel+1, repetitionLevel, columns)
+		}
+	default:
+		shredPresent(n, value, definitionLevel, repetitionLevel, columns)
+	}
+}
+
+func shredPresent(n *node, value interface{}, definitionLevel, repetitionLevel int32, columns map[*node]*columnData) {
+	if n.isLeaf() {
+		column := columns[n]
+		column.definitionLevels = append(column.definitionLevels, definitionLevel)
+		column.repetitionLevels = append(column.repetitionLevels, repetitionLevel)
+		column.values = append(column.values, value)
+		return
+	}
+	fields := value.([]interface{})
+	for i, child := range n.children {
+		shred(child, fields[i], definitionLevel, repetitionLevel, columns)
+	}
+}
+
+func shredAbsent(n *node, definitionLevel, repetitionLevel int32, columns map[*node]*columnData) {
+	for _, leaf := range n.leaves() {
+		column := columns[leaf]
+		column.definitionLevels = append(column.definitionLevels, definitionLevel)
+		column.repetitionLevels = append(column.repetitionLevels, repetitionLevel)
+	}
+}
+
+// File writing.
+
+type columnOptions struct {
+	encoding    int32
+	pageVersion int
+	rowsPerPage int
+	codec       int32
+}
+
+func writeFile(filename string, schema *node, rowGroups [][][]interface{}, getOptions func(leaf *node) columnOptions) {
+	for _, child := range schema.children {
+		child.computeLevels(0, 0, nil)
+	}
+	leaves := schema.leaves()
+
+	var file bytes.Buffer
+	file.WriteString("PAR1")
+
+	var rowGroupStructs []interface{}
+	var totalRows int64
+	for _, rows := range rowGroups {
+		columns := make(map[*node]*columnData)
+		for _, leaf := range leaves {
+			columns[leaf] = &columnData{}
+		}
+		for _, row := range rows {
+			for i, child := range schema.children {
+				shred(child, row[i], 0, 0, columns)
+			}
+		}
+
+		var chunks []interface{}
+		for _, leaf := range leaves {
+			chunks = append(chunks, writeColumnChunk(&file, leaf, columns[leaf], getOptions(leaf)))
+		}
+		rowGroupStructs = append(rowGroupStructs, tStruct{
+			{1, tList{12, chunks}},
+			{2, int64(0)},
+			{3, int64(len(rows))},
+		})
+		totalRows += int64(len(rows))
+	}
+
+	var schemaElements []interface{}
+	var addElement func(n *node, isRoot bool)
+	addElement = func(n *node, isRoot bool) {
+		element := tStruct{}
+		if n.isLeaf() {
+			element = append(element, tField{1, n.physicalType})
+		}
+		if !isRoot {
+			element = append(element, tField{3, n.repetition})
+		}
+		element = append(element, tField{4, []byte(n.name)})
+		if !n.isLeaf() {
+			element = append(element, tField{5, int32(len(n.children))})
+		}
+		if n.convertedType != 0 || n.physicalType == typeByteArray && n.isLeaf() {
+			element = append(element, tField{6, n.convertedType})
+		}
+		if n.convertedType == convertedDecimal {
+			element = append(element, tField{7, n.scale}, tField{8, n.precision})
+		}
+		if n.logicalType != nil {
+			element = append(element, tField{10, n.logicalType})
+		}
+		schemaElements = append(schemaElements, element)
+		for _, child := range n.children {
+			addElement(child, false)
+		}
+	}
+	addElement(schema, true)
+
+	var metadata bytes.Buffer
+	writeStruct(&metadata, tStruct{
+		{1, int32(1)},
+		{2, tList{12, schemaElements}},
+		{3, totalRows},
+		{4, tList{12, rowGroupStructs}},
+		{6, []byte("octosql test data generator")},
+	})
+	file.Write(metadata.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(metadata.Len()))
+	file.WriteString("PAR1")
+
+	if err := ioutil.WriteFile(filename, file.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeColumnChunk(file *bytes.Buffer, leaf *node, column *columnData, options columnOptions) tStruct {
+	start := int64(file.Len())
+	var dictionaryPageOffset int64 = -1
+
+	// Dictionary indices replace the values in the data pages.
+	values := column.values
+	var definedValues []interface{}
+	for _, value := range values {
+		if value != nil {
+			definedValues = append(definedValues, value)
+		}
+	}
+	var dictionaryIndices []int32
+	if options.encoding == encodingPlainDictionary || options.encoding == encodingRLEDictionary {
+		var dictionary []interface{}
+		indices := map[string]int32{}
+		for _, value := range definedValues {
+			key := string(encodePlain(leaf.physicalType, []interface{}{value}))
+			index, ok := indices[key]
+			if !ok {
+				index = int32(len(dictionary))
+				indices[key] = index
+				dictionary = append(dictionary, value)
+			}
+			dictionaryIndices = append(dictionaryIndices, index)
+		}
+		dictionaryPageOffset = int64(file.Len())
+		page := encodePlain(leaf.physicalType, dictionary)
+		compressed := compress(options.codec, page)
+		writeStruct(file, tStruct{
+			{1, int32(pageTypeDictionaryPage)},
+			{2, int32(len(page))},
+			{3, int32(len(compressed))},
+			{7, tStruct{{1, int32(len(dictionary))}, {2, int32(encodingPlain)}}},
+		})
+		file.Write(compressed)
+	}
+
+	dataPageOffset := int64(file.Len())
+	levelIndex, valueIndex := 0, 0
+	for levelIndex < len(column.definitionLevels) {
+		// Pages start at row boundaries.
+		end := levelIndex
+		for rows := 0; end < len(column.definitionLevels); end++ {
+			if column.repetitionLevels[end] == 0 {
+				if rows == options.rowsPerPage {
+					break
+				}
+				rows++
+			}
+		}
+		definitionLevels := column.definitionLevels[levelIndex:end]
+		repetitionLevels := column.repetitionLevels[levelIndex:end]
+		definedCount, nullCount := 0, 0
+		for _, level := range definitionLevels {
+			if level == leaf.maxDefinitionLevel {
+				definedCount++
+			} else {
+				nullCount++
+			}
+		}
+		var encodedValues []byte
+		if dictionaryIndices != nil {
+			width := bits.Len32(uint32(maxInt32(dictionaryIndices)))
+			encodedValues = append([]byte{byte(width)}, encodeHybrid(dictionaryIndices[valueIndex:valueIndex+definedCount], width)...)
+		} else {
+			encodedValues = encodeValues(leaf.physicalType, options.encoding, definedValues[valueIndex:valueIndex+definedCount])
+		}
+		rowCount := 0
+		for _, level := range repetitionLevels {
+			if level == 0 {
+				rowCount++
+			}
+		}
+
+		if options.pageVersion == 1 {
+			var page []byte
+			if leaf.maxRepetitionLevel > 0 {
+				page = append(page, lengthPrefixed(encodeHybrid(repetitionLevels, bits.Len32(uint32(leaf.maxRepetitionLevel))))...)
+			}
+			if leaf.maxDefinitionLevel > 0 {
+				page = append(page, lengthPrefixed(encodeHybrid(definitionLevels, bits.Len32(uint32(leaf.maxDefinitionLevel))))...)
+			}
+			page = append(page, encodedValues...)
+			compressed := compress(options.codec, page)
+			writeStruct(file, tStruct{
+				{1, int32(pageTypeDataPage)},
+				{2, int32(len(page))},
+				{3, int32(len(compressed))},
+				{5, tStruct{{1, int32(len(definitionLevels))}, {2, options.encoding}, {3, int32(encodingRLE)}, {4, int32(encodingRLE)}}},
+			})
+			file.Write(compressed)
+		} else {
+			var levels []byte
+			var repetitionLevelsLength, definitionLevelsLength int
+			if leaf.maxRepetitionLevel > 0 {
+				encoded := encodeHybrid(repetitionLevels, bits.Len32(uint32(leaf.maxRepetitionLevel)))
+				repetitionLevelsLength = len(encoded)
+				levels = append(levels, encoded...)
+			}
+			if leaf.maxDefinitionLevel > 0 {
+				encoded := encodeHybrid(definitionLevels, bits.Len32(uint32(leaf.maxDefinitionLevel)))
+				definitionLevelsLength = len(encoded)
+				levels = append(levels, encoded...)
+			}
+			compressed := compress(options.codec, encodedValues)
+			writeStruct(file, tStruct{
+				{1, int32(pageTypeDataPageV2)},
+				{2, int32(len(levels) + len(encodedValues))},
+				{3, int32(len(levels) + len(compressed))},
+				{8, tStruct{
+					{1, int32(len(definitionLevels))},
+					{2, int32(nullCount)},
+					{3, int32(rowCount)},
+					{4, options.encoding},
+					{5, int32(definitionLevelsLength)},
+					{6, int32(repetitionLevelsLength)},
+					{7, options.codec != codecUncompressed},
+				}},
+			})
+			file.Write(levels)
+			file.Write(compressed)
+		}
+
+		levelIndex = end
+		valueIndex += definedCount
+	}
+
+	metadata := tStruct{
+		{1, leaf.physicalType},
+		{2, tList{5, []interface{}{options.encoding, int32(encodingRLE)}}},
+		{3, tList{8, pathList(leaf.path)}},
+		{4, options.codec},
+		{5, int64(len(column.definitionLevels))},
+		{6, int64(file.Len()) - start},
+		{7, int64(file.Len()) - start},
+		{9, dataPageOffset},
+	}
+	if dictionaryPageOffset != -1 {
+		metadata = append(metadata, tField{11, dictionaryPageOffset})
+	}
+	statistics := tStruct{{3, int64(len(column.definitionLevels) - len(definedValues))}}
+	if min, max, ok := minMax(leaf.physicalType, definedValues); ok {
+		statistics = append(statistics, tField{5, max}, tField{6, min})
+	}
+	metadata = append(metadata, tField{12, statistics})
+
+	return tStruct{
+		{2, start},
+		{3, metadata},
+	}
+}
+
+func pathList(path []string) []interface{} {
+	out := make([]interface{}, len(path))
+	for i := range path {
+		out[i] = []byte(path[i])
+	}
+	return out
+}
+
+func maxInt32(values []int32) int32 {
+	var out int32
+	for _, value := range values {
+		if value > out {
+			out = value
+		}
+	}
+	return out
+}
+
+// minMax returns the plain encoded minimum and maximum of the values, byte arrays without the length prefix.
+func minMax(physicalType int32, values []interface{}) ([]byte, []byte, bool) {
+	if len(values) == 0 || physicalType == typeInt96 || physicalType == typeBoolean {
+		return nil, nil, false
+	}
+	sorted := append([]interface{}{}, values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		switch left := sorted[i].(type) {
+		case int32:
+			return left < sorted[j].(int32)
+		case int64:
+			return left < sorted[j].(int64)
+		case float32:
+			return left < sorted[j].(float32)
+		case float64:
+			return left < sorted[j].(float64)
+		case []byte:
+			return bytes.Compare(left, sorted[j].([]byte)) < 0
+		}
+		panic("unsupported statistics type")
+	})
+	encode := func(value interface{}) []byte {
+		if value, ok := value.([]byte); ok {
+			return value
+		}
+		return encodePlain(physicalType, []interface{}{value})
+	}
+	return encode(sorted[0]), encode(sorted[len(sorted)-1]), true
+}
+
+func compress(codec int32, data []byte) []byte {
+	switch codec {
+	case codecSnappy:
+		return snappy.Encode(nil, data)
+	case codecGzip:
+		var out bytes.Buffer
+		w := gzip.NewWriter(&out)
+		w.Write(data)
+		w.Close()
+		return out.Bytes()
+	case codecZstd:
+		w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return w.EncodeAll(data, nil)
+	}
+	return data
+}
+
+// Encodings.
+
+func encodeValues(physicalType, encoding int32, values []interface{}) []byte {
+	switch encoding {
+	case encodingPlain:
+		return encodePlain(physicalType, values)
+	case encodingRLE:
+		levels := make([]int32, len(values))
+		for i := range values {
+			if values[i].(bool) {
+				levels[i] = 1
+			}
+		}
+		return lengthPrefixed(encodeHybrid(levels, 1))
+	case encodingByteStreamSplit:
+		plain := encodePlain(physicalType, values)
+		width := len(plain) / len(values)
+		out := make([]byte, len(plain))
+		for i := range values {
+			for b := 0; b < width; b++ {
+				out[b*len(values)+i] = plain[i*width+b]
+			}
+		}
+		return out
+	case encodingDeltaBinaryPacked:
+		integers := make([]int64, len(values))
+		for i := range values {
+			integers[i] = values[i].(int64)
+		}
+		return encodeDeltaBinaryPacked(integers)
+	case encodingDeltaLengthByteArray:
+		return encodeDeltaLengthByteArray(byteArrays(values))
+	case encodingDeltaByteArray:
+		arrays := byteArrays(values)
+		prefixLengths := make([]int64, len(arrays))
+		suffixes := make([][]byte, len(arrays))
+		for i := range arrays {
+			if i > 0 {
+				previous := arrays[i-1]
+				for int(prefixLengths[i]) < len(previous) && int(prefixLengths[i]) < len(arrays[i]) && previous[prefixLengths[i]] == arrays[i][prefixLengths[i]] {
+					prefixLengths[i]++
+				}
+			}
+			suffixes[i] = arrays[i][prefixLengths[i]:]
+		}
+		return append(encodeDeltaBinaryPacked(prefixLengths), encodeDeltaLengthByteArray(suffixes)...)
+	}
+	panic(fmt.Sprintf("unsupported encoding: %d", encoding))
+}
+
+func byteArrays(values []interface{}) [][]byte {
+	out := make([][]byte, len(values))
+	for i := range values {
+		out[i] = values[i].([]byte)
+	}
+	return out
+}
+
+func encodePlain(physicalType int32, values []interface{}) []byte {
+	var out bytes.Buffer
+	if physicalType == typeBoolean {
+		packed := make([]byte, (len(values)+7)/8)
+		for i := range values {
+			if values[i].(bool) {
+				packed[i/8] |= 1 << (i % 8)
+			}
+		}
+		return packed
+	}
+	for _, value := range values {
+		switch value := value.(type) {
+		case int32:
+			binary.Write(&out, binary.LittleEndian, value)
+		case int64:
+			binary.Write(&out, binary.LittleEndian, value)
+		case float32:
+			binary.Write(&out, binary.LittleEndian, math.Float32bits(value))
+		case float64:
+			binary.Write(&out, binary.LittleEndian, math.Float64bits(value))
+		case []byte:
+			if physicalType == typeInt96 {
+				out.Write(value)
+			} else {
+				binary.Write(&out, binary.LittleEndian, uint32(len(value)))
+				out.Write(value)
+			}
+		default:
+			panic(fmt.Sprintf("unsupported value: %T", value))
+		}
+	}
+	return out.Bytes()
+}
+
+func lengthPrefixed(data []byte) []byte {
+	out := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(out, uint32(len(data)))
+	copy(out[4:], data)
+	return out
+}
+
+// encodeHybrid uses rle runs for runs of at least 8 equal values and bit-packed runs otherwise.
+func encodeHybrid(values []int32, bitWidth int) []byte {
+	var out []byte
+	var packed []int32
+	flushPacked := func() {
+		if len(packed) == 0 {
+			return
+		}
+		groups := (len(packed) + 7) / 8
+		out = binary.AppendUvarint(out, uint64(groups)<<1|1)
+		out = append(out, packBits(packed, bitWidth, groups*8)...)
+		packed = nil
+	}
+	for i := 0; i < len(values); {
+		run := 1
+		for i+run < len(values) && values[i+run] == values[i] {
+			run++
+		}
+		if run >= 8 && len(packed)%8 == 0 {
+			flushPacked()
+			out = binary.AppendUvarint(out, uint64(run)<<1)
+			for b := 0; b < (bitWidth+7)/8; b++ {
+				out = append(out, byte(values[i]>>(8*b)))
+			}
+			i += run
+			continue
+		}
+		packed = append(packed, values[i])
+		i++
+	}
+	flushPacked()
+	return out
+}
+
+func packBits(values []int32, bitWidth int, count int) []byte {
+	out := make([]byte, (count*bitWidth+7)/8)
+	for i, value := range values {
+		for b := 0; b < bitWidth; b++ {
+			if value&(1<<b) != 0 {
+				position := i*bitWidth + b
+				out[position/8] |= 1 << (position % 8)
+			}
+		}
+	}
+	return out
+}
+
+func encodeDeltaBinaryPacked(values []int64) []byte {
+	const blockSize, miniblockCount, miniblockSize = 128, 4, 32
+	var out []byte
+	out = binary.AppendUvarint(out, blockSize)
+	out = binary.AppendUvarint(out, miniblockCount)
+	out = binary.AppendUvarint(out, uint64(len(values)))
+	var first int64
+	if len(values) > 0 {
+		first = values[0]
+	}
+	out = binary.AppendVarint(out, first)
+
+	for blockStart := 1; blockStart < len(values); blockStart += blockSize {
+		blockEnd := blockStart + blockSize
+		if blockEnd > len(values) {
+			blockEnd = len(values)
+		}
+		deltas := make([]int64, blockEnd-blockStart)
+		minDelta := int64(math.MaxInt64)
+		for i := range deltas {
+			deltas[i] = values[blockStart+i] - values[blockStart+i-1]
+			if deltas[i] < minDelta {
+				minDelta = deltas[i]
+			}
+		}
+		out = binary.AppendVarint(out, minDelta)
+
+		widths := make([]byte, miniblockCount)
+		for m := 0; m*miniblockSize < len(deltas); m++ {
+			for _, delta := range deltas[m*miniblockSize : minInt((m+1)*miniblockSize, len(deltas))] {
+				if width := byte(bits.Len64(uint64(delta - minDelta))); width > widths[m] {
+					widths[m] = width
+				}
+			}
+		}
+		out = append(out, widths...)
+		for m := 0; m*miniblockSize < len(deltas); m++ {
+			miniblock := deltas[m*miniblockSize : minInt((m+1)*miniblockSize, len(deltas))]
+			packed := make([]byte, miniblockSize*int(widths[m])/8)
+			for i, delta := range miniblock {
+				value := uint64(delta - minDelta)
+				for b := 0; b < int(widths[m]); b++ {
+					if value&(1<<b) != 0 {
+						position := i*int(widths[m]) + b
+						packed[position/8] |= 1 << (position % 8)
+					}
+				}
+			}
+			out = append(out, packed...)
+		}
+	}
+	return out
+}
+
+func encodeDeltaLengthByteArray(values [][]byte) []byte {
+	lengths := make([]int64, len(values))
+	for i := range values {
+		lengths[i] = int64(len(values[i]))
+	}
+	out := encodeDeltaBinaryPacked(lengths)
+	for _, value := range values {
+		out = append(out, value...)
+	}
+	return out
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Thrift compact protocol.
+
+type tField struct {
+	id    int16
+	value interface{}
+}
+
+type tStruct []tField
+
+type tList struct {
+	elementType byte
+	elements    []interface{}
+}
+
+func thriftType(value interface{}) byte {
+	switch value := value.(type) {
+	case bool:
+		if value {
+			return 1
+		}
+		return 2
+	case int8:
+		return 3
+	case int32:
+		return 5
+	case int64:
+		return 6
+	case []byte:
+		return 8
+	case tList:
+		return 9
+	case tStruct:
+		return 12
+	}
+	panic(fmt.Sprintf("unsupported thrift value: %T", value))
+}
+
+func writeStruct(out *bytes.Buffer, s tStruct) {
+	var lastID int16
+	for _, field := range s {
+		fieldType := thriftType(field.value)
+		if delta := field.id - lastID; delta > 0 && delta <= 15 {
+			out.WriteByte(byte(delta)<<4 | fieldType)
+		} else {
+			out.WriteByte(fieldType)
+			out.Write(binary.AppendVarint(nil, int64(field.id)))
+		}
+		lastID = field.id
+		if _, ok := field.value.(bool); !ok {
+			writeValue(out, field.value)
+		}
+	}
+	out.WriteByte(0)
+}
+
+func writeValue(out *bytes.Buffer, value interface{}) {
+	switch value := value.(type) {
+	case bool:
+		if value {
+			out.WriteByte(1)
+		} else {
+			out.WriteByte(2)
+		}
+	case int8:
+		out.WriteByte(byte(value))
+	case int32:
+		out.Write(binary.AppendVarint(nil, int64(value)))
+	case int64:
+		out.Write(binary.AppendVarint(nil, value))
+	case []byte:
+		out.Write(binary.AppendUvarint(nil, uint64(len(value))))
+		out.Write(value)
+	case tList:
+		if len(value.elements) < 15 {
+			out.WriteByte(byte(len(value.elements))<<4 | value.elementType)
+		} else {
+			out.WriteByte(0xf0 | value.elementType)
+			out.Write(binary.AppendUvarint(nil, uint64(len(value.elements))))
+		}
+		for _, element := range value.elements {
+			writeValue(out, element)
+		}
+	case tStruct:
+		writeStruct(out, value)
+	}
+}
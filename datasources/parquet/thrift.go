@@ -0,0 +1,226 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// The Parquet metadata is encoded using the Thrift compact protocol.
+// We decode it into a generic representation and then read the fields we need out of it.
+
+const (
+	thriftTypeStop         = 0
+	thriftTypeBooleanTrue  = 1
+	thriftTypeBooleanFalse = 2
+	thriftTypeByte         = 3
+	thriftTypeI16          = 4
+	thriftTypeI32          = 5
+	thriftTypeI64          = 6
+	thriftTypeDouble       = 7
+	thriftTypeBinary       = 8
+	thriftTypeList         = 9
+	thriftTypeSet          = 10
+	thriftTypeMap          = 11
+	thriftTypeStruct       = 12
+)
+
+// thriftStruct maps field ids to their values.
+// Values are bool, int64, float64, []byte, []interface{} or thriftStruct.
+type thriftStruct map[int16]interface{}
+
+func (s thriftStruct) Int(id int16) (int64, bool) {
+	v, ok := s[id].(int64)
+	return v, ok
+}
+
+func (s thriftStruct) Bool(id int16) (bool, bool) {
+	v, ok := s[id].(bool)
+	return v, ok
+}
+
+func (s thriftStruct) Binary(id int16) ([]byte, bool) {
+	v, ok := s[id].([]byte)
+	return v, ok
+}
+
+func (s thriftStruct) Struct(id int16) (thriftStruct, bool) {
+	v, ok := s[id].(thriftStruct)
+	return v, ok
+}
+
+func (s thriftStruct) List(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+// maxThriftDepth limits the nesting of structs and collections, so that malicious files can't overflow the stack.
+const maxThriftDepth = 64
+
+type thriftReader struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, fmt.Errorf("unexpected end of thrift data")
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid thrift varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) readZigZag() (int64, error) {
+	v, err := r.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) readStruct() (thriftStruct, error) {
+	out := thriftStruct{}
+	var lastID int16
+	for {
+		header, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		fieldType := header & 0x0f
+		if fieldType == thriftTypeStop {
+			return out, nil
+		}
+
+		var id int16
+		if delta := header >> 4; delta != 0 {
+			id = lastID + int16(delta)
+		} else {
+			v, err := r.readZigZag()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		lastID = id
+
+		var value interface{}
+		switch fieldType {
+		case thriftTypeBooleanTrue:
+			value = true
+		case thriftTypeBooleanFalse:
+			value = false
+		default:
+			value, err = r.readValue(fieldType)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't read field %d: %w", id, err)
+			}
+		}
+		out[id] = value
+	}
+}
+
+func (r *thriftReader) readValue(valueType byte) (interface{}, error) {
+	r.depth++
+	defer func() { r.depth-- }()
+	if r.depth > maxThriftDepth {
+		return nil, fmt.Errorf("thrift data nested too deeply")
+	}
+
+	switch valueType {
+	case thriftTypeBooleanTrue, thriftTypeBooleanFalse:
+		// Inside of collections booleans are encoded as a single byte.
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		return b == thriftTypeBooleanTrue, nil
+	case thriftTypeByte:
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		return int64(int8(b)), nil
+	case thriftTypeI16, thriftTypeI32, thriftTypeI64:
+		return r.readZigZag()
+	case thriftTypeDouble:
+		if r.pos+8 > len(r.data) {
+			return nil, fmt.Errorf("unexpected end of thrift data")
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(r.data[r.pos:]))
+		r.pos += 8
+		return v, nil
+	case thriftTypeBinary:
+		length, err := r.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(r.data)-r.pos) < length {
+			return nil, fmt.Errorf("unexpected end of thrift data")
+		}
+		v := r.data[r.pos : r.pos+int(length)]
+		r.pos += int(length)
+		return v, nil
+	case thriftTypeList, thriftTypeSet:
+		header, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		size := uint64(header >> 4)
+		if size == 15 {
+			size, err = r.readUvarint()
+			if err != nil {
+				return nil, err
+			}
+		}
+		// Each element takes at least one byte.
+		if uint64(len(r.data)-r.pos) < size {
+			return nil, fmt.Errorf("unexpected end of thrift data")
+		}
+		elementType := header & 0x0f
+		out := make([]interface{}, 0, size)
+		for i := uint64(0); i < size; i++ {
+			v, err := r.readValue(elementType)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		return out, nil
+	case thriftTypeMap:
+		size, err := r.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return nil, nil
+		}
+		types, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		// Maps aren't used by the metadata we read, so we just skip them.
+		for i := uint64(0); i < size; i++ {
+			if _, err := r.readValue(types >> 4); err != nil {
+				return nil, err
+			}
+			if _, err := r.readValue(types & 0x0f); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	case thriftTypeStruct:
+		return r.readStruct()
+	}
+	return nil, fmt.Errorf("unknown thrift type: %d", valueType)
+}
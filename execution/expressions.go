@@ -2,6 +2,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cube2222/octosql/octosql"
 )
@@ -94,6 +95,15 @@ func (c *Cast) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	if c.targetType.TypeID == octosql.TypeIDStruct {
 		return octosql.ZeroValue, fmt.Errorf("type assertions for structures aren't yet supported")
 	}
+	if value.TypeID == octosql.TypeIDDecimal {
+		// Decimals are rounded to the scale of the target type.
+		if decimalType, ok := c.targetType.DecimalAlternative(); ok {
+			if decimal, ok := value.Decimal.ToType(decimalType); ok {
+				return octosql.NewDecimal(decimal), nil
+			}
+			return octosql.NewNull(), nil
+		}
+	}
 	if value.Type().Is(c.targetType) != octosql.TypeRelationIs {
 		return octosql.NewNull(), nil
 	}
@@ -210,22 +220,34 @@ func NewSingleColumnQueryExpression(source Node) *SingleColumnQueryExpression {
 }
 
 func (e *SingleColumnQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
-	// TODO: Handle retractions.
 	var values []octosql.Value
-	e.source.Run(
+	if err := e.source.Run(
 		ctx,
 		func(ctx ProduceContext, record Record) error {
 			if record.Retraction {
-				return fmt.Errorf("query expression currently can't handle retractions")
+				values = removeRetractedValue(values, record.Values[0])
+				return nil
 			}
 			values = append(values, record.Values[0])
 			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error { return nil },
-	)
+	); err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't run query expression source: %w", err)
+	}
 	return octosql.NewList(values), nil
 }
 
+// removeRetractedValue removes the most recently added value equal to the retracted one.
+func removeRetractedValue(values []octosql.Value, retracted octosql.Value) []octosql.Value {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i].Compare(retracted) == 0 {
+			return append(values[:i], values[i+1:]...)
+		}
+	}
+	return values
+}
+
 type MultiColumnQueryExpression struct {
 	source Node
 }
@@ -237,22 +259,53 @@ func NewMultiColumnQueryExpression(source Node) *MultiColumnQueryExpression {
 }
 
 func (e *MultiColumnQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
-	// TODO: Handle retractions.
 	var values []octosql.Value
-	e.source.Run(
+	if err := e.source.Run(
 		ctx,
 		func(ctx ProduceContext, record Record) error {
 			if record.Retraction {
-				return fmt.Errorf("query expression currently can't handle retractions")
+				values = removeRetractedValue(values, octosql.NewStruct(record.Values))
+				return nil
 			}
 			values = append(values, octosql.NewStruct(record.Values))
 			return nil
 		},
 		func(ctx ProduceContext, msg MetadataMessage) error { return nil },
-	)
+	); err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't run query expression source: %w", err)
+	}
 	return octosql.NewList(values), nil
 }
 
+type ExistsQueryExpression struct {
+	source Node
+}
+
+func NewExistsQueryExpression(source Node) *ExistsQueryExpression {
+	return &ExistsQueryExpression{
+		source: source,
+	}
+}
+
+func (e *ExistsQueryExpression) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	count := 0
+	if err := e.source.Run(
+		ctx,
+		func(ctx ProduceContext, record Record) error {
+			if record.Retraction {
+				count--
+			} else {
+				count++
+			}
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error { return nil },
+	); err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't run EXISTS query expression source: %w", err)
+	}
+	return octosql.NewBoolean(count > 0), nil
+}
+
 type Coalesce struct {
 	args []Expression
 }
@@ -276,6 +329,148 @@ func (c *Coalesce) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
 	return octosql.NewNull(), nil
 }
 
+type Case struct {
+	value      Expression
+	conditions []Expression
+	results    []Expression
+	elseResult Expression
+}
+
+// NewCase creates a case expression. The value may be nil, the conditions are then used as predicates.
+func NewCase(value Expression, conditions []Expression, results []Expression, elseResult Expression) *Case {
+	return &Case{
+		value:      value,
+		conditions: conditions,
+		results:    results,
+		elseResult: elseResult,
+	}
+}
+
+func (c *Case) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	var value octosql.Value
+	if c.value != nil {
+		var err error
+		value, err = c.value.Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate CASE value: %w", err)
+		}
+	}
+
+	for i := range c.conditions {
+		if c.value != nil && value.TypeID == octosql.TypeIDNull {
+			break
+		}
+		condition, err := c.conditions[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE condition: %w", i, err)
+		}
+
+		var matches bool
+		if c.value != nil {
+			matches = condition.TypeID != octosql.TypeIDNull && value.Compare(condition) == 0
+		} else {
+			matches = condition.TypeID == octosql.TypeIDBoolean && condition.Boolean
+		}
+		if matches {
+			result, err := c.results[i].Evaluate(ctx)
+			if err != nil {
+				return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d CASE result: %w", i, err)
+			}
+			return result, nil
+		}
+	}
+
+	result, err := c.elseResult.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate CASE else result: %w", err)
+	}
+	return result, nil
+}
+
+type Object struct {
+	args []Expression
+}
+
+func NewObject(args []Expression) *Object {
+	return &Object{
+		args: args,
+	}
+}
+
+func (c *Object) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	values := make([]octosql.Value, len(c.args))
+	for i := range c.args {
+		value, err := c.args[i].Evaluate(ctx)
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate %d object field: %w", i, err)
+		}
+		values[i] = value
+	}
+	return octosql.NewStruct(values), nil
+}
+
+type FieldAccess struct {
+	expr  Expression
+	index int
+}
+
+func NewFieldAccess(expr Expression, index int) *FieldAccess {
+	return &FieldAccess{
+		expr:  expr,
+		index: index,
+	}
+}
+
+func (c *FieldAccess) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	value, err := c.expr.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate field access source expression: %w", err)
+	}
+	if value.TypeID != octosql.TypeIDStruct {
+		return octosql.NewNull(), nil
+	}
+	return value.Struct[c.index], nil
+}
+
+type ListLambda struct {
+	list   Expression
+	body   Expression
+	filter bool
+}
+
+func NewListLambda(list Expression, body Expression, filter bool) *ListLambda {
+	return &ListLambda{
+		list:   list,
+		body:   body,
+		filter: filter,
+	}
+}
+
+func (c *ListLambda) Evaluate(ctx ExecutionContext) (octosql.Value, error) {
+	list, err := c.list.Evaluate(ctx)
+	if err != nil {
+		return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda list expression: %w", err)
+	}
+	if list.TypeID != octosql.TypeIDList {
+		return octosql.NewNull(), nil
+	}
+
+	out := make([]octosql.Value, 0, len(list.List))
+	for i := range list.List {
+		// The lambda parameter is bound as a single value record.
+		value, err := c.body.Evaluate(ctx.WithRecord(NewRecord([]octosql.Value{list.List[i]}, false, time.Time{})))
+		if err != nil {
+			return octosql.ZeroValue, fmt.Errorf("couldn't evaluate lambda body for element %d: %w", i, err)
+		}
+		if !c.filter {
+			out = append(out, value)
+		} else if value.TypeID == octosql.TypeIDBoolean && value.Boolean {
+			out = append(out, list.List[i])
+		}
+	}
+	return octosql.NewList(out), nil
+}
+
 type Tuple struct {
 	args []Expression
 }
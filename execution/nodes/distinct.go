@@ -41,7 +41,7 @@ func (item *distinctItem) Less(than btree.Item) bool {
 
 func (o *Distinct) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
 	recordCounts := btree.New(BTreeDefaultDegree)
-	o.source.Run(
+	if err := o.source.Run(
 		execCtx,
 		func(ctx ProduceContext, record Record) error {
 			item := recordCounts.Get(&distinctItem{Values: record.Values})
@@ -79,10 +79,10 @@ func (o *Distinct) Run(execCtx ExecutionContext, produce ProduceFn, metaSend Met
 			}
 			return nil
 		},
-		func(ctx ProduceContext, msg MetadataMessage) error {
-			return nil
-		},
-	)
+		metaSend,
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
 
 	return nil
 }
@@ -40,10 +40,17 @@ func NewGroupBy(
 }
 
 type Aggregate interface {
-	Add(retraction bool, value octosql.Value) bool
+	Add(retraction bool, value octosql.Value) (bool, error)
 	Trigger() octosql.Value
 }
 
+// EventTimeAggregate is an aggregate which also depends on the event time of the records.
+// Group by calls AddWithEventTime instead of Add for those.
+type EventTimeAggregate interface {
+	Aggregate
+	AddWithEventTime(retraction bool, value octosql.Value, eventTime time.Time) (bool, error)
+}
+
 type aggregatesItem struct {
 	GroupKey
 	Aggregates []Aggregate
@@ -65,8 +72,9 @@ func (g *GroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSend
 	aggregates := btree.New(BTreeDefaultDegree)
 	previouslySentValues := btree.New(BTreeDefaultDegree)
 	trigger := g.triggerPrototype()
+	var watermark time.Time
 
-	if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+	processRecord := func(produceCtx ProduceContext, record Record) error {
 		ctx := ctx.WithRecord(record)
 
 		key := make(GroupKey, len(g.keyExprs))
@@ -125,7 +133,14 @@ func (g *GroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSend
 					} else {
 						itemTyped.AggregatedSetSize[i]--
 					}
-					itemTyped.Aggregates[i].Add(record.Retraction, aggregateInput)
+					if eventTimeAggregate, ok := itemTyped.Aggregates[i].(EventTimeAggregate); ok {
+						_, err = eventTimeAggregate.AddWithEventTime(record.Retraction, aggregateInput, record.EventTime)
+					} else {
+						_, err = itemTyped.Aggregates[i].Add(record.Retraction, aggregateInput)
+					}
+					if err != nil {
+						return fmt.Errorf("couldn't add record to aggregate %d: %w", i, err)
+					}
 				}
 			}
 
@@ -142,16 +157,36 @@ func (g *GroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSend
 		}
 
 		return nil
-	}, func(ctx ProduceContext, msg MetadataMessage) error {
+	}
+	processMetadata := func(ctx ProduceContext, msg MetadataMessage) error {
 		if msg.Type == MetadataMessageTypeWatermark {
+			watermark = msg.Watermark
 			trigger.WatermarkReceived(msg.Watermark)
 			if err := g.trigger(ctx, aggregates, previouslySentValues, trigger, msg.Watermark, produce); err != nil {
 				return fmt.Errorf("couldn't trigger keys on watermark")
 			}
 		}
 		return metaSend(ctx, msg)
-	}); err != nil {
-		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	var tickInterval time.Duration
+	if ticking, ok := trigger.(TickingTrigger); ok {
+		tickInterval = ticking.TickInterval()
+	}
+
+	if tickInterval == 0 {
+		if err := g.source.Run(ctx, processRecord, processMetadata); err != nil {
+			return fmt.Errorf("couldn't run source: %w", err)
+		}
+	} else {
+		if err := g.runTicking(ctx, tickInterval, processRecord, processMetadata, func() error {
+			if err := g.trigger(ProduceFromExecutionContext(ctx), aggregates, previouslySentValues, trigger, watermark, produce); err != nil {
+				return fmt.Errorf("couldn't trigger keys on tick")
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
 	}
 
 	trigger.EndOfStreamReached()
@@ -163,6 +198,73 @@ func (g *GroupBy) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSend
 	return nil
 }
 
+// runTicking runs the source in a separate goroutine, so that the trigger can also be polled in regular intervals,
+// even when the source isn't sending anything.
+func (g *GroupBy) runTicking(ctx ExecutionContext, tickInterval time.Duration, processRecord ProduceFn, processMetadata MetaSendFn, tick func() error) error {
+	type chanMessage struct {
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		err             error
+	}
+
+	messages := make(chan chanMessage, 10000)
+
+	go func() {
+		if err := g.source.Run(ctx, func(produceCtx ProduceContext, record Record) error {
+			messages <- chanMessage{
+				metadata: false,
+				record:   record,
+			}
+
+			return nil
+		}, func(ctx ProduceContext, msg MetadataMessage) error {
+			messages <- chanMessage{
+				metadata:        true,
+				metadataMessage: msg,
+			}
+
+			return nil
+		}); err != nil {
+			messages <- chanMessage{
+				err: fmt.Errorf("couldn't run source: %w", err),
+			}
+		}
+
+		close(messages)
+	}()
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			// TODO: Fix goroutine leak on error.
+			if msg.err != nil {
+				return msg.err
+			}
+			if msg.metadata {
+				if err := processMetadata(ProduceFromExecutionContext(ctx), msg.metadataMessage); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := processRecord(ProduceFromExecutionContext(ctx), msg.record); err != nil {
+				return err
+			}
+
+		case <-ticker.C:
+			if err := tick(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (g *GroupBy) trigger(produceCtx ProduceContext, aggregates, previouslySentValues *btree.BTree, trigger Trigger, curEventTime time.Time, produce ProduceFn) error {
 	toTrigger := trigger.Poll()
 
@@ -0,0 +1,243 @@
+package nodes
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type Limit struct {
+	source                Node
+	keyExprs              []Expression
+	directionMultipliers  []int
+	limit, offset         int
+	mayContainRetractions bool
+}
+
+// NewLimit creates a node which outputs the records at positions [offset, offset+limit) of the source,
+// ordered by the given key. The output is kept up to date with retractions whenever the source changes.
+// If the key is empty, records are taken in the order they arrive, see runUnordered.
+func NewLimit(source Node, keyExprs []Expression, directionMultipliers []int, limit, offset int, mayContainRetractions bool) *Limit {
+	return &Limit{
+		source:                source,
+		keyExprs:              keyExprs,
+		directionMultipliers:  directionMultipliers,
+		limit:                 limit,
+		offset:                offset,
+		mayContainRetractions: mayContainRetractions,
+	}
+}
+
+// errLimitReached is returned to the source to stop it, once no more records are needed.
+var errLimitReached = errors.New("limit reached")
+
+func (l *Limit) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	if len(l.keyExprs) == 0 {
+		return l.runUnordered(execCtx, produce, metaSend)
+	}
+
+	recordCounts := btree.New(BTreeDefaultDegree)
+	// Currently sent records, ordered.
+	var window []*orderByItem
+
+	if err := l.source.Run(
+		execCtx,
+		func(ctx ProduceContext, record Record) error {
+			key := make([]octosql.Value, len(l.keyExprs))
+			for i := range l.keyExprs {
+				keyValue, err := l.keyExprs[i].Evaluate(execCtx.WithRecord(record))
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate limit %d key expression: %w", i, err)
+				}
+				key[i] = keyValue
+			}
+
+			item := recordCounts.Get(&orderByItem{Key: key, Values: record.Values, DirectionMultipliers: l.directionMultipliers})
+			var itemTyped *orderByItem
+			if item == nil {
+				itemTyped = &orderByItem{
+					Key:                  key,
+					Values:               record.Values,
+					Count:                0,
+					DirectionMultipliers: l.directionMultipliers,
+				}
+			} else {
+				var ok bool
+				itemTyped, ok = item.(*orderByItem)
+				if !ok {
+					panic(fmt.Sprintf("invalid limit item: %v", item))
+				}
+			}
+			if !record.Retraction {
+				itemTyped.Count++
+			} else {
+				itemTyped.Count--
+			}
+			if itemTyped.Count > 0 {
+				recordCounts.ReplaceOrInsert(itemTyped)
+			} else {
+				recordCounts.Delete(itemTyped)
+			}
+
+			if len(window) == l.limit && (l.limit == 0 || window[len(window)-1].Less(itemTyped)) {
+				// The record is after the full window, so the window doesn't change.
+				return nil
+			}
+
+			newWindow := l.getWindow(recordCounts)
+			if err := produceWindowDiff(ctx, window, newWindow, produce); err != nil {
+				return err
+			}
+			window = newWindow
+
+			return nil
+		},
+		metaSend,
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return nil
+}
+
+// runUnordered outputs the records at positions [offset, offset+limit) in the order they arrive.
+// If the source can't contain retractions, it's stopped as soon as the limit is reached.
+// Otherwise, all further live records are buffered, to replace sent records when they get retracted.
+func (l *Limit) runUnordered(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	var skipped, window, buffer [][]octosql.Value
+
+	if err := l.source.Run(
+		execCtx,
+		func(ctx ProduceContext, record Record) error {
+			if !record.Retraction {
+				switch {
+				case len(skipped) < l.offset:
+					skipped = append(skipped, record.Values)
+				case len(window) < l.limit:
+					window = append(window, record.Values)
+					if err := produce(ctx, NewRecord(record.Values, false, time.Time{})); err != nil {
+						return fmt.Errorf("couldn't produce: %w", err)
+					}
+				default:
+					buffer = append(buffer, record.Values)
+				}
+				if !l.mayContainRetractions && len(skipped) == l.offset && len(window) == l.limit {
+					return errLimitReached
+				}
+				return nil
+			}
+
+			// Retracting a buffered record doesn't change the output, so that's checked first.
+			if i := indexOfValues(buffer, record.Values); i != -1 {
+				buffer = append(buffer[:i], buffer[i+1:]...)
+				return nil
+			}
+			if i := indexOfValues(window, record.Values); i != -1 {
+				window = append(window[:i], window[i+1:]...)
+				if err := produce(ctx, NewRecord(record.Values, true, time.Time{})); err != nil {
+					return fmt.Errorf("couldn't produce retraction: %w", err)
+				}
+			} else if i := indexOfValues(skipped, record.Values); i != -1 {
+				// The first sent record moves into the offset.
+				skipped = append(skipped[:i], skipped[i+1:]...)
+				if len(window) > 0 {
+					skipped = append(skipped, window[0])
+					if err := produce(ctx, NewRecord(window[0], true, time.Time{})); err != nil {
+						return fmt.Errorf("couldn't produce retraction: %w", err)
+					}
+					window = window[1:]
+				}
+			} else {
+				return nil
+			}
+
+			if len(buffer) > 0 {
+				window = append(window, buffer[0])
+				if err := produce(ctx, NewRecord(buffer[0], false, time.Time{})); err != nil {
+					return fmt.Errorf("couldn't produce: %w", err)
+				}
+				buffer = buffer[1:]
+			}
+			return nil
+		},
+		metaSend,
+	); err != nil && !errors.Is(err, errLimitReached) {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return nil
+}
+
+func indexOfValues(records [][]octosql.Value, values []octosql.Value) int {
+	for i := range records {
+		if orderKeysEqual(records[i], values) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (l *Limit) getWindow(recordCounts *btree.BTree) []*orderByItem {
+	window := make([]*orderByItem, 0, l.limit)
+	position := 0
+	recordCounts.Ascend(func(item btree.Item) bool {
+		itemTyped, ok := item.(*orderByItem)
+		if !ok {
+			panic(fmt.Sprintf("invalid limit item: %v", item))
+		}
+		for i := 0; i < itemTyped.Count; i++ {
+			if position >= l.offset {
+				if len(window) == l.limit {
+					return false
+				}
+				window = append(window, &orderByItem{
+					Key:                  itemTyped.Key,
+					Values:               itemTyped.Values,
+					Count:                1,
+					DirectionMultipliers: itemTyped.DirectionMultipliers,
+				})
+			}
+			position++
+		}
+		return true
+	})
+	return window
+}
+
+// produceWindowDiff retracts the records which are only in the old window and sends the records which are only in the new window.
+// Both windows must be ordered.
+func produceWindowDiff(ctx ProduceContext, oldWindow, newWindow []*orderByItem, produce ProduceFn) error {
+	var toRetract, toSend []*orderByItem
+	i, j := 0, 0
+	for i < len(oldWindow) && j < len(newWindow) {
+		if oldWindow[i].Less(newWindow[j]) {
+			toRetract = append(toRetract, oldWindow[i])
+			i++
+		} else if newWindow[j].Less(oldWindow[i]) {
+			toSend = append(toSend, newWindow[j])
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	toRetract = append(toRetract, oldWindow[i:]...)
+	toSend = append(toSend, newWindow[j:]...)
+
+	for _, item := range toRetract {
+		if err := produce(ctx, NewRecord(item.Values, true, time.Time{})); err != nil {
+			return fmt.Errorf("couldn't produce retraction: %w", err)
+		}
+	}
+	for _, item := range toSend {
+		if err := produce(ctx, NewRecord(item.Values, false, time.Time{})); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+	return nil
+}
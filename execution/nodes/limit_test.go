@@ -0,0 +1,149 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// recordsSource produces the records, counting how many of them were accepted.
+type recordsSource struct {
+	records  []Record
+	produced int
+}
+
+func (s *recordsSource) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	for _, record := range s.records {
+		if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+			return fmt.Errorf("couldn't produce record: %w", err)
+		}
+		s.produced++
+	}
+	return nil
+}
+
+func intRecord(value int, retraction bool) Record {
+	return NewRecord([]octosql.Value{octosql.NewInt(value)}, retraction, time.Time{})
+}
+
+// runNode returns the produced records as strings, like +1 or -1 for a retraction.
+func runNode(t *testing.T, node Node) []string {
+	var out []string
+	require.NoError(t, node.Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			sign := "+"
+			if record.Retraction {
+				sign = "-"
+			}
+			out = append(out, sign+record.Values[0].String())
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		},
+	))
+	return out
+}
+
+func TestUnorderedLimit(t *testing.T) {
+	tests := []struct {
+		name                  string
+		records               []Record
+		limit, offset         int
+		mayContainRetractions bool
+		expected              []string
+		expectedProduced      int
+	}{
+		{
+			name:             "stops after limit",
+			records:          []Record{intRecord(3, false), intRecord(1, false), intRecord(2, false), intRecord(4, false)},
+			limit:            2,
+			expected:         []string{"+3", "+1"},
+			expectedProduced: 1,
+		},
+		{
+			name:             "stops after offset and limit",
+			records:          []Record{intRecord(3, false), intRecord(1, false), intRecord(2, false), intRecord(4, false), intRecord(5, false)},
+			limit:            2,
+			offset:           1,
+			expected:         []string{"+1", "+2"},
+			expectedProduced: 2,
+		},
+		{
+			name:             "shorter source",
+			records:          []Record{intRecord(3, false)},
+			limit:            2,
+			expected:         []string{"+3"},
+			expectedProduced: 1,
+		},
+		{
+			name: "retraction refills from buffer",
+			records: []Record{
+				intRecord(1, false), intRecord(2, false), intRecord(3, false), intRecord(4, false),
+				intRecord(1, true), intRecord(4, true), intRecord(5, false),
+			},
+			limit:                 2,
+			mayContainRetractions: true,
+			expected:              []string{"+1", "+2", "-1", "+3"},
+			expectedProduced:      7,
+		},
+		{
+			name: "retraction of skipped record",
+			records: []Record{
+				intRecord(1, false), intRecord(2, false), intRecord(3, false), intRecord(4, false),
+				intRecord(1, true),
+			},
+			limit:                 2,
+			offset:                1,
+			mayContainRetractions: true,
+			expected:              []string{"+2", "+3", "-2", "+4"},
+			expectedProduced:      5,
+		},
+		{
+			name: "more retractions than limit",
+			records: []Record{
+				intRecord(1, false), intRecord(2, false), intRecord(3, false),
+				intRecord(1, true), intRecord(2, true),
+			},
+			limit:                 1,
+			mayContainRetractions: true,
+			expected:              []string{"+1", "-1", "+2", "-2", "+3"},
+			expectedProduced:      5,
+		},
+		{
+			name: "retraction of buffered record",
+			records: []Record{
+				intRecord(1, false), intRecord(2, false), intRecord(3, false),
+				intRecord(2, true), intRecord(1, true),
+			},
+			limit:                 1,
+			mayContainRetractions: true,
+			expected:              []string{"+1", "-1", "+3"},
+			expectedProduced:      5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &recordsSource{records: tt.records}
+			out := runNode(t, NewLimit(source, nil, nil, tt.limit, tt.offset, tt.mayContainRetractions))
+			assert.Equal(t, tt.expected, out)
+			assert.Equal(t, tt.expectedProduced, source.produced)
+		})
+	}
+}
+
+func TestOrderedLimit(t *testing.T) {
+	source := &recordsSource{records: []Record{intRecord(3, false), intRecord(1, false), intRecord(2, false), intRecord(1, true)}}
+	key := []Expression{NewVariable(0, 0)}
+	out := runNode(t, NewLimit(source, key, []int{1}, 2, 0, true))
+	assert.Equal(t, []string{"+3", "+1", "-3", "+2", "-1", "+3"}, out)
+	assert.Equal(t, 4, source.produced)
+}
@@ -9,6 +9,9 @@ import (
 
 type LookupJoin struct {
 	source, joined Node
+
+	isLeftJoin       bool
+	joinedFieldCount int
 }
 
 func NewLookupJoin(source, joined Node) *LookupJoin {
@@ -18,6 +21,16 @@ func NewLookupJoin(source, joined Node) *LookupJoin {
 	}
 }
 
+// NewLeftLookupJoin creates a lookup join which pads source records without a match with nulls.
+func NewLeftLookupJoin(source, joined Node, joinedFieldCount int) *LookupJoin {
+	return &LookupJoin{
+		source:           source,
+		joined:           joined,
+		isLeftJoin:       true,
+		joinedFieldCount: joinedFieldCount,
+	}
+}
+
 func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
 	// TODO: Add parallelism here.
 
@@ -25,7 +38,14 @@ func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 	if err := s.source.Run(ctx, func(produceCtx ProduceContext, sourceRecord Record) error {
 		ctx := ctx.WithRecord(sourceRecord)
 
+		matches := 0
 		if err := s.joined.Run(ctx, func(produceCtx ProduceContext, joinedRecord Record) error {
+			if !joinedRecord.Retraction {
+				matches++
+			} else {
+				matches--
+			}
+
 			outputValues := make([]octosql.Value, len(sourceRecord.Values)+len(joinedRecord.Values))
 
 			copy(outputValues, sourceRecord.Values)
@@ -42,6 +62,18 @@ func (s *LookupJoin) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaS
 			return fmt.Errorf("couldn't run joined stream: %w", err)
 		}
 
+		if s.isLeftJoin && matches == 0 {
+			outputValues := make([]octosql.Value, len(sourceRecord.Values)+s.joinedFieldCount)
+			copy(outputValues, sourceRecord.Values)
+			for i := len(sourceRecord.Values); i < len(outputValues); i++ {
+				outputValues[i] = octosql.NewNull()
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, sourceRecord.Retraction, sourceRecord.EventTime)); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+
 		return nil
 	}, metaSend); err != nil {
 		return fmt.Errorf("couldn't run source: %w", err)
@@ -13,6 +13,11 @@ import (
 type StreamJoin struct {
 	left, right                 Node
 	keyExprsLeft, keyExprsRight []Expression
+
+	isLeftJoin      bool
+	isSemiJoin      bool
+	isAntiJoin      bool
+	rightFieldCount int
 }
 
 func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *StreamJoin {
@@ -24,6 +29,42 @@ func NewStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *
 	}
 }
 
+// NewLeftStreamJoin creates a stream join which pads left records without a match with nulls.
+// The null-padded record gets retracted as soon as a matching right record arrives,
+// and gets sent again when the last matching right record is retracted.
+func NewLeftStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression, rightFieldCount int) *StreamJoin {
+	return &StreamJoin{
+		left:            left,
+		right:           right,
+		keyExprsLeft:    keyExprsLeft,
+		keyExprsRight:   keyExprsRight,
+		isLeftJoin:      true,
+		rightFieldCount: rightFieldCount,
+	}
+}
+
+// NewSemiStreamJoin creates a stream join which sends left records while they have at least one match.
+func NewSemiStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *StreamJoin {
+	return &StreamJoin{
+		left:          left,
+		right:         right,
+		keyExprsLeft:  keyExprsLeft,
+		keyExprsRight: keyExprsRight,
+		isSemiJoin:    true,
+	}
+}
+
+// NewAntiStreamJoin creates a stream join which sends left records while they have no match.
+func NewAntiStreamJoin(left, right Node, keyExprsLeft, keyExprsRight []Expression) *StreamJoin {
+	return &StreamJoin{
+		left:          left,
+		right:         right,
+		keyExprsLeft:  keyExprsLeft,
+		keyExprsRight: keyExprsRight,
+		isAntiJoin:    true,
+	}
+}
+
 type streamJoinItem struct {
 	GroupKey
 	// Records for this key
@@ -303,48 +344,21 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 		key[i] = value
 	}
 
-	// Update count in my record tree
-	{
-		item := myRecords.Get(key)
-		var itemTyped *streamJoinItem
+	if s.isSemiJoin || s.isAntiJoin {
+		return s.receiveSemiOrAntiJoinRecord(ctx, produce, myRecords, otherRecords, amLeft, key, record)
+	}
 
-		if item == nil {
-			itemTyped = &streamJoinItem{GroupKey: key, values: btree.New(BTreeDefaultDegree)}
-			myRecords.ReplaceOrInsert(itemTyped)
-		} else {
-			var ok bool
-			itemTyped, ok = item.(*streamJoinItem)
-			if !ok {
-				panic(fmt.Sprintf("invalid stream join item: %v", item))
-			}
-		}
+	hadRecordsWithKey := myRecords.Get(key) != nil
 
-		{
-			subitem := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
-			var subitemTyped *streamJoinSubitem
+	// Update count in my record tree
+	updateStreamJoinRecords(myRecords, key, record)
 
-			if subitem == nil {
-				subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
-				itemTyped.values.ReplaceOrInsert(subitemTyped)
-			} else {
-				var ok bool
-				subitemTyped, ok = subitem.(*streamJoinSubitem)
-				if !ok {
-					panic(fmt.Sprintf("invalid stream join subitem: %v", subitem))
-				}
-			}
-			if !record.Retraction {
-				subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
-			} else {
-				subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
-			}
-			if len(subitemTyped.EventTimes) == 0 {
-				itemTyped.values.Delete(subitemTyped)
-			}
-		}
+	hasRecordsWithKey := myRecords.Get(key) != nil
 
-		if itemTyped.values.Len() == 0 {
-			myRecords.Delete(itemTyped)
+	if s.isLeftJoin && !amLeft && !hadRecordsWithKey && hasRecordsWithKey {
+		// The left records with this key just got their first match.
+		if err := s.produceLeftRecords(ctx, produce, otherRecords, key, true); err != nil {
+			return err
 		}
 	}
 
@@ -354,6 +368,17 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 		var itemTyped *streamJoinItem
 
 		if item == nil {
+			if s.isLeftJoin && amLeft {
+				outputValues := make([]octosql.Value, len(record.Values)+s.rightFieldCount)
+				copy(outputValues, record.Values)
+				for i := len(record.Values); i < len(outputValues); i++ {
+					outputValues[i] = octosql.NewNull()
+				}
+
+				if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, record.Retraction, record.EventTime)); err != nil {
+					return fmt.Errorf("couldn't produce: %w", err)
+				}
+			}
 			// Nothing to trigger
 			return nil
 		} else {
@@ -401,5 +426,129 @@ func (s *StreamJoin) receiveRecord(ctx ExecutionContext, produce ProduceFn, myRe
 		}
 	}
 
+	if s.isLeftJoin && !amLeft && hadRecordsWithKey && !hasRecordsWithKey {
+		// The left records with this key just lost their last match.
+		if err := s.produceLeftRecords(ctx, produce, otherRecords, key, false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// receiveSemiOrAntiJoinRecord handles a record in a semi or anti join, which only ever sends left records.
+func (s *StreamJoin) receiveSemiOrAntiJoinRecord(ctx ExecutionContext, produce ProduceFn, myRecords, otherRecords *btree.BTree, amLeft bool, key GroupKey, record Record) error {
+	for i := range key {
+		if key[i].TypeID == octosql.TypeIDNull {
+			// Null keys never match, like in an equality predicate.
+			if amLeft && s.isAntiJoin {
+				if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+					return fmt.Errorf("couldn't produce: %w", err)
+				}
+			}
+			return nil
+		}
+	}
+
+	hadRecordsWithKey := myRecords.Get(key) != nil
+	updateStreamJoinRecords(myRecords, key, record)
+	hasRecordsWithKey := myRecords.Get(key) != nil
+
+	if amLeft {
+		if hasMatch := otherRecords.Get(key) != nil; hasMatch == s.isSemiJoin {
+			if err := produce(ProduceFromExecutionContext(ctx), record); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if hadRecordsWithKey != hasRecordsWithKey {
+		// The left records with this key just got their first match or lost their last one.
+		if err := s.produceLeftRecords(ctx, produce, otherRecords, key, hasRecordsWithKey == s.isAntiJoin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStreamJoinRecords adds the record to the record tree, or removes it if it's a retraction.
+func updateStreamJoinRecords(records *btree.BTree, key GroupKey, record Record) {
+	item := records.Get(key)
+	var itemTyped *streamJoinItem
+
+	if item == nil {
+		itemTyped = &streamJoinItem{GroupKey: key, values: btree.New(BTreeDefaultDegree)}
+		records.ReplaceOrInsert(itemTyped)
+	} else {
+		var ok bool
+		itemTyped, ok = item.(*streamJoinItem)
+		if !ok {
+			panic(fmt.Sprintf("invalid stream join item: %v", item))
+		}
+	}
+
+	{
+		subitem := itemTyped.values.Get(&streamJoinSubitem{GroupKey: record.Values})
+		var subitemTyped *streamJoinSubitem
+
+		if subitem == nil {
+			subitemTyped = &streamJoinSubitem{GroupKey: record.Values}
+			itemTyped.values.ReplaceOrInsert(subitemTyped)
+		} else {
+			var ok bool
+			subitemTyped, ok = subitem.(*streamJoinSubitem)
+			if !ok {
+				panic(fmt.Sprintf("invalid stream join subitem: %v", subitem))
+			}
+		}
+		if !record.Retraction {
+			subitemTyped.EventTimes = append(subitemTyped.EventTimes, record.EventTime)
+		} else {
+			subitemTyped.EventTimes = subitemTyped.EventTimes[1:]
+		}
+		if len(subitemTyped.EventTimes) == 0 {
+			itemTyped.values.Delete(subitemTyped)
+		}
+	}
+
+	if itemTyped.values.Len() == 0 {
+		records.Delete(itemTyped)
+	}
+}
+
+// produceLeftRecords sends all left records with the given key, padded with nulls in case of a left join.
+func (s *StreamJoin) produceLeftRecords(ctx ExecutionContext, produce ProduceFn, leftRecords *btree.BTree, key GroupKey, retraction bool) error {
+	item := leftRecords.Get(key)
+	if item == nil {
+		return nil
+	}
+	itemTyped, ok := item.(*streamJoinItem)
+	if !ok {
+		panic(fmt.Sprintf("invalid stream join item: %v", item))
+	}
+
+	var outErr error
+	itemTyped.values.Ascend(func(subitem btree.Item) bool {
+		subitemTyped, ok := subitem.(*streamJoinSubitem)
+		if !ok {
+			panic(fmt.Sprintf("invalid stream join subitem: %v", subitem))
+		}
+
+		for i := 0; i < len(subitemTyped.EventTimes); i++ {
+			outputValues := make([]octosql.Value, len(subitemTyped.GroupKey)+s.rightFieldCount)
+			copy(outputValues, subitemTyped.GroupKey)
+			for j := len(subitemTyped.GroupKey); j < len(outputValues); j++ {
+				outputValues[j] = octosql.NewNull()
+			}
+
+			if err := produce(ProduceFromExecutionContext(ctx), NewRecord(outputValues, retraction, subitemTyped.EventTimes[i])); err != nil {
+				outErr = fmt.Errorf("couldn't produce: %w", err)
+				return false
+			}
+		}
+
+		return true
+	})
+	return outErr
+}
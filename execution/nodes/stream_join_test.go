@@ -0,0 +1,145 @@
+package nodes
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type streamJoinStep struct {
+	left       bool
+	key        octosql.Value
+	name       string
+	retraction bool
+}
+
+func leftStep(key int, name string, retraction bool) streamJoinStep {
+	return streamJoinStep{left: true, key: octosql.NewInt(key), name: name, retraction: retraction}
+}
+
+func rightStep(key int, name string, retraction bool) streamJoinStep {
+	return streamJoinStep{left: false, key: octosql.NewInt(key), name: name, retraction: retraction}
+}
+
+// runStreamJoin runs the join with a record on one of the sides in each step.
+// Each record has the event time of its step, and both sides send the step as their watermark afterwards,
+// so that the join processes the records in order.
+func runStreamJoin(t *testing.T, newJoin func(left, right Node, keyExprsLeft, keyExprsRight []Expression) Node, steps []streamJoinStep) []string {
+	left, right := &streamSource{}, &streamSource{}
+	for i, step := range steps {
+		eventTime := time.Unix(int64(i+1), 0)
+		record := NewRecord([]octosql.Value{step.key, octosql.NewString(step.name)}, step.retraction, eventTime)
+		if step.left {
+			left.messages = append(left.messages, streamMessage{record: record})
+		} else {
+			right.messages = append(right.messages, streamMessage{record: record})
+		}
+		left.messages = append(left.messages, streamMessage{watermark: eventTime})
+		right.messages = append(right.messages, streamMessage{watermark: eventTime})
+	}
+
+	var out []string
+	require.NoError(t, newJoin(left, right, []Expression{NewVariable(0, 0)}, []Expression{NewVariable(0, 0)}).Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			sign := "+"
+			if record.Retraction {
+				sign = "-"
+			}
+			values := make([]string, len(record.Values))
+			for i := range record.Values {
+				values[i] = record.Values[i].String()
+			}
+			out = append(out, sign+strings.Join(values, ", "))
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			return nil
+		},
+	))
+	return out
+}
+
+func TestLeftStreamJoin(t *testing.T) {
+	newJoin := func(left, right Node, keyExprsLeft, keyExprsRight []Expression) Node {
+		return NewLeftStreamJoin(left, right, keyExprsLeft, keyExprsRight, 2)
+	}
+	assert.Equal(t, []string{
+		"+1, 'a', <null>, <null>",
+		"-1, 'a', <null>, <null>",
+		"+1, 'a', 1, 'x'",
+		"+1, 'a', 1, 'y'",
+		"-1, 'a', 1, 'x'",
+		"-1, 'a', 1, 'y'",
+		"+1, 'a', <null>, <null>",
+		"+2, 'b', <null>, <null>",
+		"-1, 'a', <null>, <null>",
+	}, runStreamJoin(t, newJoin, []streamJoinStep{
+		leftStep(1, "a", false),
+		rightStep(1, "x", false),
+		rightStep(1, "y", false),
+		rightStep(1, "x", true),
+		// Retracting the last match sends the NULL-padded left record again.
+		rightStep(1, "y", true),
+		leftStep(2, "b", false),
+		leftStep(1, "a", true),
+	}))
+}
+
+func TestSemiStreamJoin(t *testing.T) {
+	newJoin := func(left, right Node, keyExprsLeft, keyExprsRight []Expression) Node {
+		return NewSemiStreamJoin(left, right, keyExprsLeft, keyExprsRight)
+	}
+	assert.Equal(t, []string{
+		"+1, 'a'",
+		"-1, 'a'",
+		"+1, 'a'",
+		"+1, 'b'",
+		"-1, 'a'",
+	}, runStreamJoin(t, newJoin, []streamJoinStep{
+		leftStep(1, "a", false),
+		leftStep(2, "c", false),
+		rightStep(1, "x", false),
+		rightStep(1, "y", false),
+		rightStep(1, "x", true),
+		rightStep(1, "y", true),
+		rightStep(1, "z", false),
+		leftStep(1, "b", false),
+		leftStep(1, "a", true),
+		// NULL keys never match.
+		{left: true, key: octosql.NewNull(), name: "d"},
+	}))
+}
+
+func TestAntiStreamJoin(t *testing.T) {
+	newJoin := func(left, right Node, keyExprsLeft, keyExprsRight []Expression) Node {
+		return NewAntiStreamJoin(left, right, keyExprsLeft, keyExprsRight)
+	}
+	assert.Equal(t, []string{
+		"+1, 'a'",
+		"-1, 'a'",
+		"+1, 'a'",
+		"+2, 'b'",
+		"-1, 'a'",
+		"+<null>, 'c'",
+	}, runStreamJoin(t, newJoin, []streamJoinStep{
+		leftStep(1, "a", false),
+		rightStep(1, "x", false),
+		rightStep(1, "y", false),
+		rightStep(1, "x", true),
+		// Retracting the last match sends the left record again.
+		rightStep(1, "y", true),
+		leftStep(2, "b", false),
+		leftStep(1, "a", true),
+		// NULL keys never match.
+		{left: true, key: octosql.NewNull(), name: "c"},
+		{left: false, key: octosql.NewNull(), name: "c"},
+	}))
+}
@@ -0,0 +1,106 @@
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+type UnionAll struct {
+	inputs []Node
+}
+
+func NewUnionAll(inputs []Node) *UnionAll {
+	return &UnionAll{
+		inputs: inputs,
+	}
+}
+
+func (u *UnionAll) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	type chanMessage struct {
+		inputIndex      int
+		metadata        bool
+		metadataMessage MetadataMessage
+		record          Record
+		err             error
+		done            bool
+	}
+
+	messages := make(chan chanMessage, 10000)
+
+	for i := range u.inputs {
+		go func(inputIndex int) {
+			if err := u.inputs[inputIndex].Run(ctx, func(produceCtx ProduceContext, record Record) error {
+				messages <- chanMessage{
+					inputIndex: inputIndex,
+					record:     record,
+				}
+
+				return nil
+			}, func(ctx ProduceContext, msg MetadataMessage) error {
+				messages <- chanMessage{
+					inputIndex:      inputIndex,
+					metadata:        true,
+					metadataMessage: msg,
+				}
+
+				return nil
+			}); err != nil {
+				messages <- chanMessage{
+					inputIndex: inputIndex,
+					err:        fmt.Errorf("couldn't run union input with index %d: %w", inputIndex, err),
+				}
+			}
+
+			messages <- chanMessage{
+				inputIndex: inputIndex,
+				done:       true,
+			}
+		}(i)
+	}
+
+	// The output watermark is the minimum of the input watermarks.
+	// Records can be forwarded right away, as each of them is after its own input's watermark.
+	watermarks := make([]time.Time, len(u.inputs))
+	var minWatermark time.Time
+	inputsLeft := len(u.inputs)
+
+	for inputsLeft > 0 {
+		// TODO: Fix goroutine leak on error.
+		msg := <-messages
+		if msg.err != nil {
+			return msg.err
+		}
+		if msg.done {
+			inputsLeft--
+			watermarks[msg.inputIndex] = WatermarkMaxValue
+		} else if msg.metadata {
+			watermarks[msg.inputIndex] = msg.metadataMessage.Watermark
+		} else {
+			if err := produce(ProduceFromExecutionContext(ctx), msg.record); err != nil {
+				return fmt.Errorf("couldn't produce: %w", err)
+			}
+			continue
+		}
+
+		min := watermarks[0]
+		for _, watermark := range watermarks[1:] {
+			if watermark.Before(min) {
+				min = watermark
+			}
+		}
+		if min.After(minWatermark) && inputsLeft > 0 {
+			minWatermark = min
+
+			if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{
+				Type:      MetadataMessageTypeWatermark,
+				Watermark: minWatermark,
+			}); err != nil {
+				return fmt.Errorf("couldn't send metadata: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/cube2222/octosql/execution"
+)
+
+// streamSource produces the records and watermarks in order, after start is closed, and closes done afterwards.
+type streamSource struct {
+	// Each message is either a record or a watermark, if watermark isn't zero.
+	messages []streamMessage
+	start    chan struct{}
+	done     chan struct{}
+}
+
+type streamMessage struct {
+	record    Record
+	watermark time.Time
+}
+
+func (s *streamSource) Run(ctx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	if s.start != nil {
+		<-s.start
+	}
+	if s.done != nil {
+		defer close(s.done)
+	}
+	for _, msg := range s.messages {
+		if !msg.watermark.IsZero() {
+			if err := metaSend(ProduceFromExecutionContext(ctx), MetadataMessage{Type: MetadataMessageTypeWatermark, Watermark: msg.watermark}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := produce(ProduceFromExecutionContext(ctx), msg.record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestUnionAll(t *testing.T) {
+	watermark := func(seconds int64) streamMessage {
+		return streamMessage{watermark: time.Unix(seconds, 0)}
+	}
+	record := func(value int, retraction bool) streamMessage {
+		return streamMessage{record: intRecord(value, retraction)}
+	}
+
+	// The second source only starts after the first one has produced everything, so that the output order is deterministic.
+	firstDone := make(chan struct{})
+	first := &streamSource{
+		messages: []streamMessage{record(1, false), watermark(10), record(1, true), watermark(20)},
+		done:     firstDone,
+	}
+	second := &streamSource{
+		messages: []streamMessage{record(2, false), watermark(20), record(2, true), record(3, false)},
+		start:    firstDone,
+	}
+
+	var records []string
+	var watermarks []time.Time
+	require.NoError(t, NewUnionAll([]Node{first, second}).Run(
+		ExecutionContext{Context: context.Background()},
+		func(ctx ProduceContext, record Record) error {
+			sign := "+"
+			if record.Retraction {
+				sign = "-"
+			}
+			records = append(records, sign+record.Values[0].String())
+			return nil
+		},
+		func(ctx ProduceContext, msg MetadataMessage) error {
+			watermarks = append(watermarks, msg.Watermark)
+			return nil
+		},
+	))
+
+	assert.Equal(t, []string{"+1", "-1", "+2", "-2", "+3"}, records)
+	// The watermarks of the first source are held back until the second one catches up.
+	assert.Equal(t, []time.Time{time.Unix(20, 0)}, watermarks)
+}
@@ -0,0 +1,426 @@
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/btree"
+
+	. "github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/octosql"
+)
+
+type Window struct {
+	source               Node
+	partitionByExprs     []Expression
+	orderByExprs         []Expression
+	directionMultipliers []int
+	newFunction          func() WindowFunction
+	argumentExprs        []Expression
+}
+
+// NewWindow creates a node which appends the value of the window function to each record of the source.
+// The function is computed over the partition of the record, ordered by the order by key.
+// Whenever a partition changes, the records whose window function value changed get retracted and sent again.
+// A new window function is created for each partition.
+func NewWindow(source Node, partitionByExprs, orderByExprs []Expression, directionMultipliers []int, newFunction func() WindowFunction, argumentExprs []Expression) *Window {
+	return &Window{
+		source:               source,
+		partitionByExprs:     partitionByExprs,
+		orderByExprs:         orderByExprs,
+		directionMultipliers: directionMultipliers,
+		newFunction:          newFunction,
+		argumentExprs:        argumentExprs,
+	}
+}
+
+type WindowFunction interface {
+	// Lookahead is the number of following rows the value of a row depends on.
+	Lookahead() int
+	// Compute sets the values of the rows of the ordered partition, starting with the row at index from.
+	// The rows before from haven't changed since the previous call and from is always the first row of a group of peers.
+	Compute(partition []WindowRow, values []octosql.Value, from int) error
+}
+
+type WindowRow struct {
+	OrderKey  []octosql.Value
+	Values    []octosql.Value
+	Arguments []octosql.Value
+}
+
+type windowPartitionItem struct {
+	GroupKey
+	// Rows are the rows of the partition, ordered, with duplicate records repeated.
+	Rows []WindowRow
+	// Values are the currently sent window function values of the rows.
+	Values   []octosql.Value
+	Function WindowFunction
+}
+
+type windowOutputRow struct {
+	Row   WindowRow
+	Value octosql.Value
+}
+
+func (w *Window) Run(execCtx ExecutionContext, produce ProduceFn, metaSend MetaSendFn) error {
+	partitions := btree.New(BTreeDefaultDegree)
+
+	if err := w.source.Run(
+		execCtx,
+		func(ctx ProduceContext, record Record) error {
+			recordCtx := execCtx.WithRecord(record)
+
+			partitionKey := make(GroupKey, len(w.partitionByExprs))
+			for i := range w.partitionByExprs {
+				value, err := w.partitionByExprs[i].Evaluate(recordCtx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate window partition by %d expression: %w", i, err)
+				}
+				partitionKey[i] = value
+			}
+			orderKey := make([]octosql.Value, len(w.orderByExprs))
+			for i := range w.orderByExprs {
+				value, err := w.orderByExprs[i].Evaluate(recordCtx)
+				if err != nil {
+					return fmt.Errorf("couldn't evaluate window order by %d expression: %w", i, err)
+				}
+				orderKey[i] = value
+			}
+
+			var partition *windowPartitionItem
+			if item := partitions.Get(partitionKey); item != nil {
+				var ok bool
+				partition, ok = item.(*windowPartitionItem)
+				if !ok {
+					panic(fmt.Sprintf("invalid window partition item: %v", item))
+				}
+			} else {
+				partition = &windowPartitionItem{
+					GroupKey: partitionKey,
+					Function: w.newFunction(),
+				}
+				partitions.ReplaceOrInsert(partition)
+			}
+
+			row := WindowRow{
+				OrderKey: orderKey,
+				Values:   record.Values,
+			}
+			// Duplicate records are inserted after, and retracted from the end of, their equal rows.
+			index := sort.Search(len(partition.Rows), func(i int) bool {
+				return w.rowLess(row, partition.Rows[i])
+			})
+			if record.Retraction {
+				index--
+				if index < 0 || w.rowLess(partition.Rows[index], row) {
+					return nil
+				}
+			}
+
+			// Only the values of the changed row's peers, the rows after it, and the rows looking ahead at those can change.
+			from := index
+			for from > 0 && orderKeysEqual(partition.Rows[from-1].OrderKey, orderKey) {
+				from--
+			}
+			if from -= partition.Function.Lookahead(); from < 0 {
+				from = 0
+			}
+			oldRows := windowOutputRows(partition.Rows[from:], partition.Values[from:])
+
+			if !record.Retraction {
+				arguments := make([]octosql.Value, len(w.argumentExprs))
+				for i := range w.argumentExprs {
+					value, err := w.argumentExprs[i].Evaluate(recordCtx)
+					if err != nil {
+						return fmt.Errorf("couldn't evaluate window function argument %d expression: %w", i, err)
+					}
+					arguments[i] = value
+				}
+				row.Arguments = arguments
+
+				partition.Rows = append(partition.Rows, WindowRow{})
+				copy(partition.Rows[index+1:], partition.Rows[index:])
+				partition.Rows[index] = row
+				partition.Values = append(partition.Values, octosql.Value{})
+			} else {
+				partition.Rows = append(partition.Rows[:index], partition.Rows[index+1:]...)
+				partition.Values = partition.Values[:len(partition.Values)-1]
+			}
+
+			if err := partition.Function.Compute(partition.Rows, partition.Values, from); err != nil {
+				return fmt.Errorf("couldn't compute window function: %w", err)
+			}
+			newRows := windowOutputRows(partition.Rows[from:], partition.Values[from:])
+
+			if err := w.produceWindowRowsDiff(ctx, oldRows, newRows, produce); err != nil {
+				return err
+			}
+
+			if len(partition.Rows) == 0 {
+				partitions.Delete(partition)
+			}
+
+			return nil
+		},
+		metaSend,
+	); err != nil {
+		return fmt.Errorf("couldn't run source: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Window) rowLess(left, right WindowRow) bool {
+	for i := range left.OrderKey {
+		if comp := left.OrderKey[i].Compare(right.OrderKey[i]); comp != 0 {
+			return comp*w.directionMultipliers[i] == -1
+		}
+	}
+	for i := range left.Values {
+		if comp := left.Values[i].Compare(right.Values[i]); comp != 0 {
+			return comp == -1
+		}
+	}
+	return false
+}
+
+func (w *Window) outputRowLess(left, right windowOutputRow) bool {
+	if w.rowLess(left.Row, right.Row) {
+		return true
+	} else if w.rowLess(right.Row, left.Row) {
+		return false
+	}
+	return left.Value.Compare(right.Value) == -1
+}
+
+func windowOutputRows(rows []WindowRow, values []octosql.Value) []windowOutputRow {
+	out := make([]windowOutputRow, len(rows))
+	for i := range rows {
+		out[i] = windowOutputRow{
+			Row:   rows[i],
+			Value: values[i],
+		}
+	}
+	return out
+}
+
+// produceWindowRowsDiff retracts the rows which are only in the old rows and sends the rows which are only in the new rows.
+func (w *Window) produceWindowRowsDiff(ctx ProduceContext, oldRows, newRows []windowOutputRow, produce ProduceFn) error {
+	// Duplicate rows may have different values, those have to be ordered too.
+	sort.SliceStable(oldRows, func(i, j int) bool {
+		return w.outputRowLess(oldRows[i], oldRows[j])
+	})
+	sort.SliceStable(newRows, func(i, j int) bool {
+		return w.outputRowLess(newRows[i], newRows[j])
+	})
+
+	var toRetract, toSend []windowOutputRow
+	i, j := 0, 0
+	for i < len(oldRows) && j < len(newRows) {
+		if w.outputRowLess(oldRows[i], newRows[j]) {
+			toRetract = append(toRetract, oldRows[i])
+			i++
+		} else if w.outputRowLess(newRows[j], oldRows[i]) {
+			toSend = append(toSend, newRows[j])
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+	toRetract = append(toRetract, oldRows[i:]...)
+	toSend = append(toSend, newRows[j:]...)
+
+	for _, row := range toRetract {
+		if err := produce(ctx, NewRecord(row.outputValues(), true, time.Time{})); err != nil {
+			return fmt.Errorf("couldn't produce retraction: %w", err)
+		}
+	}
+	for _, row := range toSend {
+		if err := produce(ctx, NewRecord(row.outputValues(), false, time.Time{})); err != nil {
+			return fmt.Errorf("couldn't produce: %w", err)
+		}
+	}
+	return nil
+}
+
+func (row windowOutputRow) outputValues() []octosql.Value {
+	values := make([]octosql.Value, len(row.Row.Values)+1)
+	copy(values, row.Row.Values)
+	values[len(values)-1] = row.Value
+	return values
+}
+
+type RowNumber struct{}
+
+func NewRowNumber() *RowNumber {
+	return &RowNumber{}
+}
+
+func (f *RowNumber) Lookahead() int {
+	return 0
+}
+
+func (f *RowNumber) Compute(partition []WindowRow, values []octosql.Value, from int) error {
+	for i := from; i < len(partition); i++ {
+		values[i] = octosql.NewInt(i + 1)
+	}
+	return nil
+}
+
+type Rank struct {
+	dense bool
+}
+
+func NewRank() *Rank {
+	return &Rank{}
+}
+
+func NewDenseRank() *Rank {
+	return &Rank{dense: true}
+}
+
+func (f *Rank) Lookahead() int {
+	return 0
+}
+
+func (f *Rank) Compute(partition []WindowRow, values []octosql.Value, from int) error {
+	for i := from; i < len(partition); i++ {
+		switch {
+		case i == 0:
+			values[i] = octosql.NewInt(1)
+		case orderKeysEqual(partition[i-1].OrderKey, partition[i].OrderKey):
+			values[i] = values[i-1]
+		case f.dense:
+			values[i] = octosql.NewInt(values[i-1].Int + 1)
+		default:
+			values[i] = octosql.NewInt(i + 1)
+		}
+	}
+	return nil
+}
+
+// Lag returns the first argument of the row offset rows before the current one.
+// If there is no such row, the second argument of the current row is returned.
+// A negative offset is used for lead.
+type Lag struct {
+	offset int
+}
+
+func NewLag(offset int) *Lag {
+	return &Lag{offset: offset}
+}
+
+func (f *Lag) Lookahead() int {
+	if f.offset < 0 {
+		return -f.offset
+	}
+	return 0
+}
+
+func (f *Lag) Compute(partition []WindowRow, values []octosql.Value, from int) error {
+	for i := from; i < len(partition); i++ {
+		if j := i - f.offset; j >= 0 && j < len(partition) {
+			values[i] = partition[j].Arguments[0]
+		} else {
+			values[i] = partition[i].Arguments[1]
+		}
+	}
+	return nil
+}
+
+// WindowAggregate computes the aggregate over all rows from the start of the partition up to the current row, including its peers.
+// It keeps the aggregate of the partition up to its last row, and retracts the arguments of changed rows from it.
+type WindowAggregate struct {
+	prototype     func() Aggregate
+	noRetractions bool
+
+	aggregate Aggregate
+	// Arguments are the arguments of the aggregated rows.
+	arguments []octosql.Value
+	// AggregatedSetSize omits NULL inputs.
+	aggregatedSetSize int
+}
+
+// NewWindowAggregate creates a window aggregate.
+// If the aggregate doesn't support retractions, it's instead recomputed from the start of the partition when earlier rows change.
+func NewWindowAggregate(prototype func() Aggregate, noRetractions bool) *WindowAggregate {
+	return &WindowAggregate{
+		prototype:     prototype,
+		noRetractions: noRetractions,
+		aggregate:     prototype(),
+	}
+}
+
+func (f *WindowAggregate) Lookahead() int {
+	return 0
+}
+
+func (f *WindowAggregate) Compute(partition []WindowRow, values []octosql.Value, from int) error {
+	if from < len(f.arguments) {
+		if f.noRetractions {
+			f.aggregate = f.prototype()
+			f.arguments = f.arguments[:0]
+			f.aggregatedSetSize = 0
+			for i := 0; i < from; i++ {
+				if err := f.add(partition[i].Arguments[0]); err != nil {
+					return err
+				}
+			}
+		} else {
+			for i := len(f.arguments) - 1; i >= from; i-- {
+				if f.arguments[i].TypeID != octosql.TypeIDNull {
+					if _, err := f.aggregate.Add(true, f.arguments[i]); err != nil {
+						return fmt.Errorf("couldn't retract row from aggregate: %w", err)
+					}
+					f.aggregatedSetSize--
+				}
+			}
+			f.arguments = f.arguments[:from]
+		}
+	}
+
+	for groupStart := from; groupStart < len(partition); {
+		groupEnd := groupStart + 1
+		for groupEnd < len(partition) && orderKeysEqual(partition[groupStart].OrderKey, partition[groupEnd].OrderKey) {
+			groupEnd++
+		}
+
+		for i := groupStart; i < groupEnd; i++ {
+			if err := f.add(partition[i].Arguments[0]); err != nil {
+				return err
+			}
+		}
+		value := octosql.NewNull()
+		if f.aggregatedSetSize > 0 {
+			value = f.aggregate.Trigger()
+		}
+		for i := groupStart; i < groupEnd; i++ {
+			values[i] = value
+		}
+
+		groupStart = groupEnd
+	}
+	return nil
+}
+
+func (f *WindowAggregate) add(argument octosql.Value) error {
+	f.arguments = append(f.arguments, argument)
+	if argument.TypeID != octosql.TypeIDNull {
+		if _, err := f.aggregate.Add(false, argument); err != nil {
+			return fmt.Errorf("couldn't add row to aggregate: %w", err)
+		}
+		f.aggregatedSetSize++
+	}
+	return nil
+}
+
+func orderKeysEqual(left, right []octosql.Value) bool {
+	for i := range left {
+		if left[i].Compare(right[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
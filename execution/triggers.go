@@ -15,6 +15,13 @@ type Trigger interface {
 	// ExpireKeysBeforeTime(time time.Time)
 }
 
+// TickingTrigger is implemented by triggers which have to be polled periodically,
+// even if no records nor watermarks are received in the meantime.
+type TickingTrigger interface {
+	// TickInterval returns 0 if the trigger doesn't need to be polled periodically.
+	TickInterval() time.Duration
+}
+
 type CountingTrigger struct {
 	triggerAfter uint
 
@@ -222,6 +229,69 @@ func (c *EndOfStreamTrigger) Poll() []GroupKey {
 	return output
 }
 
+type DelayTrigger struct {
+	delay time.Duration
+	now   func() time.Time
+
+	keys               *btree.BTree
+	lastTrigger        time.Time
+	endOfStreamReached bool
+}
+
+// NewDelayTriggerPrototype creates a trigger which batches received keys and triggers them
+// once the given amount of wall-clock time has passed since the previous batch.
+func NewDelayTriggerPrototype(delay time.Duration) func() Trigger {
+	return newDelayTriggerPrototypeWithClock(delay, time.Now)
+}
+
+func newDelayTriggerPrototypeWithClock(delay time.Duration, now func() time.Time) func() Trigger {
+	return func() Trigger {
+		return &DelayTrigger{
+			delay:              delay,
+			now:                now,
+			keys:               btree.New(BTreeDefaultDegree),
+			lastTrigger:        now(),
+			endOfStreamReached: false,
+		}
+	}
+}
+
+func (c *DelayTrigger) EndOfStreamReached() {
+	c.endOfStreamReached = true
+}
+
+func (c *DelayTrigger) WatermarkReceived(watermark time.Time) {}
+
+func (c *DelayTrigger) KeyReceived(key GroupKey) {
+	c.keys.ReplaceOrInsert(key)
+}
+
+func (c *DelayTrigger) TickInterval() time.Duration {
+	return c.delay
+}
+
+func (c *DelayTrigger) Poll() []GroupKey {
+	now := c.now()
+	if !c.endOfStreamReached && now.Sub(c.lastTrigger) < c.delay {
+		return nil
+	}
+	c.lastTrigger = now
+
+	output := make([]GroupKey, 0, c.keys.Len())
+	c.keys.Ascend(func(item btree.Item) bool {
+		itemTyped, ok := item.(GroupKey)
+		if !ok {
+			panic(fmt.Sprintf("invalid received item: %v", item))
+		}
+
+		output = append(output, itemTyped)
+
+		return true
+	})
+	c.keys.Clear(false)
+	return output
+}
+
 type MultiTrigger struct {
 	triggers []Trigger
 }
@@ -256,6 +326,18 @@ func (c *MultiTrigger) KeyReceived(key GroupKey) {
 	}
 }
 
+func (c *MultiTrigger) TickInterval() time.Duration {
+	var interval time.Duration
+	for i := range c.triggers {
+		if ticking, ok := c.triggers[i].(TickingTrigger); ok {
+			if cur := ticking.TickInterval(); cur > 0 && (interval == 0 || cur < interval) {
+				interval = cur
+			}
+		}
+	}
+	return interval
+}
+
 func (c *MultiTrigger) Poll() []GroupKey {
 	var output []GroupKey
 	for i := range c.triggers {
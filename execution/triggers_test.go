@@ -2,6 +2,7 @@ package execution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -17,3 +18,33 @@ func TestCountingTrigger(t *testing.T) {
 	assert.Len(t, polled, 1)
 	assert.Equal(t, polled[0], GroupKey{octosql.NewInt(2), octosql.NewInt(3)})
 }
+
+func TestDelayTrigger(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	trigger := newDelayTriggerPrototypeWithClock(time.Second, func() time.Time { return now })()
+	assert.Equal(t, time.Second, trigger.(TickingTrigger).TickInterval())
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	trigger.KeyReceived(GroupKey{octosql.NewInt(2)})
+	trigger.KeyReceived(GroupKey{octosql.NewInt(1)})
+	assert.Empty(t, trigger.Poll())
+
+	now = now.Add(time.Second)
+	assert.Equal(t, []GroupKey{{octosql.NewInt(1)}, {octosql.NewInt(2)}}, trigger.Poll())
+	assert.Empty(t, trigger.Poll())
+
+	trigger.KeyReceived(GroupKey{octosql.NewInt(3)})
+	now = now.Add(time.Millisecond * 500)
+	assert.Empty(t, trigger.Poll())
+	trigger.EndOfStreamReached()
+	assert.Equal(t, []GroupKey{{octosql.NewInt(3)}}, trigger.Poll())
+}
+
+func TestMultiTriggerTickInterval(t *testing.T) {
+	trigger := NewMultiTriggerPrototype([]func() Trigger{
+		NewCountingTriggerPrototype(2),
+		NewDelayTriggerPrototype(time.Minute),
+		NewDelayTriggerPrototype(time.Second),
+	})()
+	assert.Equal(t, time.Second, trigger.(TickingTrigger).TickInterval())
+}
@@ -0,0 +1,206 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// intDecimalType is the decimal type ints are treated as in decimal arithmetic.
+var intDecimalType = octosql.NewDecimalType(19, 0)
+
+// divisionScale is the scale of the result of a decimal division.
+func divisionScale(leftScale, rightScale int) int {
+	scale := leftScale
+	if rightScale > scale {
+		scale = rightScale
+	}
+	scale += 6
+	if scale > octosql.MaxDecimalPrecision {
+		scale = octosql.MaxDecimalPrecision
+	}
+	return scale
+}
+
+// decimalOperand returns the decimal type of a, possibly nullable, decimal, int or float argument.
+// Floats are treated as decimals of unknown precision.
+func decimalOperand(t octosql.Type) (decimalType octosql.Type, isDecimal bool, ok bool) {
+	alternatives := []octosql.Type{t}
+	if t.TypeID == octosql.TypeIDUnion {
+		alternatives = t.Union.Alternatives
+	}
+	for _, alternative := range alternatives {
+		switch alternative.TypeID {
+		case octosql.TypeIDNull:
+		case octosql.TypeIDDecimal, octosql.TypeIDInt, octosql.TypeIDFloat:
+			if ok {
+				return octosql.Type{}, false, false
+			}
+			decimalType, isDecimal, ok = alternative, alternative.TypeID == octosql.TypeIDDecimal, true
+		default:
+			return octosql.Type{}, false, false
+		}
+	}
+	if ok && decimalType.TypeID == octosql.TypeIDInt {
+		decimalType = intDecimalType
+	} else if ok && decimalType.TypeID == octosql.TypeIDFloat {
+		decimalType = octosql.Decimal
+	}
+	return decimalType, isDecimal, ok
+}
+
+// decimalTypeFn accepts two decimal, int or float arguments, at least one of them being a decimal, and returns the output type based on their decimal types.
+func decimalTypeFn(outputType func(left, right octosql.Type) octosql.Type) func([]octosql.Type) (octosql.Type, bool) {
+	return func(ts []octosql.Type) (octosql.Type, bool) {
+		if len(ts) != 2 {
+			return octosql.Type{}, false
+		}
+		left, leftIsDecimal, ok := decimalOperand(ts[0])
+		if !ok {
+			return octosql.Type{}, false
+		}
+		right, rightIsDecimal, ok := decimalOperand(ts[1])
+		if !ok {
+			return octosql.Type{}, false
+		}
+		if !leftIsDecimal && !rightIsDecimal {
+			return octosql.Type{}, false
+		}
+		return outputType(left, right), true
+	}
+}
+
+// singleDecimalTypeFn accepts a single, possibly nullable, decimal argument and returns its decimal type.
+func singleDecimalTypeFn(ts []octosql.Type) (octosql.Type, bool) {
+	if len(ts) != 1 {
+		return octosql.Type{}, false
+	}
+	t, isDecimal, ok := decimalOperand(ts[0])
+	if !ok || !isDecimal {
+		return octosql.Type{}, false
+	}
+	return t, true
+}
+
+func toDecimal(value octosql.Value) (octosql.DecimalNumber, error) {
+	switch value.TypeID {
+	case octosql.TypeIDInt:
+		return octosql.DecimalFromInt(value.Int), nil
+	case octosql.TypeIDFloat:
+		d, err := octosql.DecimalFromFloat(value.Float)
+		if err != nil {
+			return octosql.DecimalNumber{}, fmt.Errorf("couldn't convert float %v to decimal: %w", value.Float, err)
+		}
+		return d, nil
+	}
+	return value.Decimal, nil
+}
+
+func toDecimals(values []octosql.Value) (octosql.DecimalNumber, octosql.DecimalNumber, error) {
+	left, err := toDecimal(values[0])
+	if err != nil {
+		return octosql.DecimalNumber{}, octosql.DecimalNumber{}, err
+	}
+	right, err := toDecimal(values[1])
+	if err != nil {
+		return octosql.DecimalNumber{}, octosql.DecimalNumber{}, err
+	}
+	return left, right, nil
+}
+
+func checkDecimalPrecision(d octosql.DecimalNumber) error {
+	if d.Precision() > octosql.MaxDecimalPrecision {
+		return fmt.Errorf("decimal overflow: %s", d)
+	}
+	return nil
+}
+
+func decimalResult(d octosql.DecimalNumber) (octosql.Value, error) {
+	if err := checkDecimalPrecision(d); err != nil {
+		return octosql.ZeroValue, err
+	}
+	return octosql.NewDecimal(d), nil
+}
+
+func decimalAdditionType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	scale := left.Decimal.Scale
+	if right.Decimal.Scale > scale {
+		scale = right.Decimal.Scale
+	}
+	integerDigits := left.Decimal.Precision - left.Decimal.Scale
+	if other := right.Decimal.Precision - right.Decimal.Scale; other > integerDigits {
+		integerDigits = other
+	}
+	return octosql.NewDecimalType(integerDigits+1+scale, scale)
+}
+
+func decimalMultiplicationType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	return octosql.NewDecimalType(left.Decimal.Precision+right.Decimal.Precision, left.Decimal.Scale+right.Decimal.Scale)
+}
+
+func decimalDivisionType(left, right octosql.Type) octosql.Type {
+	if left.Decimal.Precision == 0 || right.Decimal.Precision == 0 {
+		return octosql.Decimal
+	}
+	scale := divisionScale(left.Decimal.Scale, right.Decimal.Scale)
+	integerDigits := left.Decimal.Precision - left.Decimal.Scale + right.Decimal.Scale
+	return octosql.NewDecimalType(integerDigits+scale, scale)
+}
+
+// decimalArithmetic returns a descriptor of an arithmetic operator on decimals, which also accepts an int or a float as one of the operands.
+func decimalArithmetic(outputType func(left, right octosql.Type) octosql.Type, fn func(left, right octosql.DecimalNumber) (octosql.DecimalNumber, error)) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: decimalTypeFn(outputType),
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			left, right, err := toDecimals(values)
+			if err != nil {
+				return octosql.ZeroValue, err
+			}
+			out, err := fn(left, right)
+			if err != nil {
+				return octosql.ZeroValue, err
+			}
+			return decimalResult(out)
+		},
+	}
+}
+
+// decimalComparison returns a descriptor of a comparison operator on decimals, which also accepts an int or a float as one of the operands.
+func decimalComparison(fn func(cmp int) bool) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: decimalTypeFn(func(left, right octosql.Type) octosql.Type {
+			return octosql.Boolean
+		}),
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			left, right, err := toDecimals(values)
+			if err != nil {
+				return octosql.ZeroValue, err
+			}
+			return octosql.NewBoolean(fn(left.Compare(right))), nil
+		},
+	}
+}
+
+func decimalMultiply(left, right octosql.DecimalNumber) (octosql.DecimalNumber, error) {
+	out := left.Mul(right)
+	if out.Scale > octosql.MaxDecimalPrecision {
+		out = out.Rescale(octosql.MaxDecimalPrecision)
+	}
+	return out, nil
+}
+
+func decimalDivide(left, right octosql.DecimalNumber) (octosql.DecimalNumber, error) {
+	if right.Sign() == 0 {
+		return octosql.DecimalNumber{}, fmt.Errorf("decimal division by zero")
+	}
+	return left.Div(right, divisionScale(left.Scale, right.Scale)), nil
+}
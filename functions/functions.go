@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"log"
 	"math"
-	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/dgraph-io/ristretto"
+	"github.com/segmentio/encoding/json"
 
 	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/octosql/jsonvalue"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -35,6 +38,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(values[0].Compare(values[1]) < 0), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp < 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp < 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp < 0
+				}),
 			},
 		},
 		"<=": {
@@ -54,6 +66,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(values[0].Compare(values[1]) <= 0), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp <= 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp <= 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp <= 0
+				}),
 			},
 		},
 		"=": {
@@ -67,6 +88,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(values[0].Equal(values[1])), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp == 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp == 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp == 0
+				}),
 			},
 		},
 		"!=": {
@@ -80,6 +110,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(!values[0].Equal(values[1])), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp != 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp != 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp != 0
+				}),
 			},
 		},
 		">=": {
@@ -99,6 +138,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(values[0].Compare(values[1]) >= 0), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp >= 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp >= 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp >= 0
+				}),
 			},
 		},
 		">": {
@@ -118,6 +166,15 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewBoolean(values[0].Compare(values[1]) > 0), nil
 					},
 				},
+				decimalComparison(func(cmp int) bool {
+					return cmp > 0
+				}),
+				dateTimeComparison(func(cmp int) bool {
+					return cmp > 0
+				}),
+				durationIntervalComparison(func(cmp int) bool {
+					return cmp > 0
+				}),
 			},
 		},
 		"is null": {
@@ -195,6 +252,46 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewTime(values[1].Time.Add(values[0].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(values[1].Interval)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Duration},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(octosql.CalendarInterval{Duration: values[1].Duration})), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[1].Interval.Add(octosql.CalendarInterval{Duration: values[0].Duration})), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.Interval},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[1].Interval.AddTo(values[0].Time)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[0].Interval.AddTo(values[1].Time)), nil
+					},
+				},
 				{
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
 					OutputType:    octosql.String,
@@ -203,6 +300,42 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewString(values[0].Str + values[1].Str), nil
 					},
 				},
+				decimalArithmetic(decimalAdditionType, func(left, right octosql.DecimalNumber) (octosql.DecimalNumber, error) {
+					return left.Add(right), nil
+				}),
+				{
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.Int},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[0].Time.AddDate(0, 0, values[1].Int)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Date},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[1].Time.AddDate(0, 0, values[0].Int)), nil
+					},
+				},
+				{
+					// Any time of day in the result is truncated.
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.Interval},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[1].Interval.AddTo(values[0].Time)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Date},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[0].Interval.AddTo(values[1].Time)), nil
+					},
+				},
 			},
 		},
 		"-": {
@@ -263,6 +396,73 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewTime(values[0].Time.Add(-values[1].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(values[1].Interval.Negate())), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Duration},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Add(octosql.CalendarInterval{Duration: -values[1].Duration})), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Negate()), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.Interval},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(values[1].Interval.Negate().AddTo(values[0].Time)), nil
+					},
+				},
+				decimalArithmetic(decimalAdditionType, func(left, right octosql.DecimalNumber) (octosql.DecimalNumber, error) {
+					return left.Sub(right), nil
+				}),
+				{
+					TypeFn: singleDecimalTypeFn,
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDecimal(values[0].Decimal.Neg()), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.Int},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[0].Time.AddDate(0, 0, -values[1].Int)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.Interval},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[1].Interval.Negate().AddTo(values[0].Time)), nil
+					},
+				},
+				{
+					// Returns the number of days between the dates.
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.Date},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Time.Sub(values[1].Time) / (24 * time.Hour))), nil
+					},
+				},
 			},
 		},
 		"*": {
@@ -299,6 +499,54 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewDuration(values[1].Duration * time.Duration(values[0].Int)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Duration, octosql.Float},
+					OutputType:    octosql.Duration,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDuration(time.Duration(float64(values[0].Duration) * values[1].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Duration},
+					OutputType:    octosql.Duration,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDuration(time.Duration(float64(values[1].Duration) * values[0].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Int},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Multiply(float64(values[1].Int))), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[1].Interval.Multiply(float64(values[0].Int))), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Interval, octosql.Float},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[0].Interval.Multiply(values[1].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float, octosql.Interval},
+					OutputType:    octosql.Interval,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInterval(values[1].Interval.Multiply(values[0].Float)), nil
+					},
+				},
 				{
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
 					OutputType:    octosql.String,
@@ -315,6 +563,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewString(strings.Repeat(values[1].Str, values[0].Int)), nil
 					},
 				},
+				decimalArithmetic(decimalMultiplicationType, decimalMultiply),
 			},
 		},
 		"/": {
@@ -351,6 +600,7 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(float64(values[0].Duration) / float64(values[1].Duration)), nil
 					},
 				},
+				decimalArithmetic(decimalDivisionType, decimalDivide),
 			},
 		},
 		// math
@@ -376,6 +626,16 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(math.Abs(values[0].Float)), nil
 					},
 				},
+				{
+					TypeFn: singleDecimalTypeFn,
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						if values[0].Decimal.Sign() < 0 {
+							return octosql.NewDecimal(values[0].Decimal.Neg()), nil
+						}
+						return values[0], nil
+					},
+				},
 			},
 		},
 		"sqrt": {
@@ -492,101 +752,18 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
 					OutputType:    octosql.Boolean,
 					Strict:        true,
-					Function: func() func(values []octosql.Value) (octosql.Value, error) {
-						regexpCache, err := ristretto.NewCache(&ristretto.Config{
-							NumCounters: 128,     // number of keys to track frequency of (10M).
-							MaxCost:     1 << 26, // maximum cost of cache (64MB).
-							BufferItems: 64,      // number of keys per Get buffer.
-						})
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						pattern, err := likePatternToRegexp(values[1].Str)
 						if err != nil {
-							panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+							return octosql.Value{}, fmt.Errorf("couldn't transform LIKE pattern to regexp: %w", err)
 						}
-
-						return func(values []octosql.Value) (octosql.Value, error) {
-							// TODO: Optimize, cache compiled regexps.
-							const likeEscape = '\\'
-							const likeAny = '_'
-							const likeAll = '%'
-
-							needsEscaping := func(r rune) bool {
-								return r == '+' ||
-									r == '?' ||
-									r == '(' ||
-									r == ')' ||
-									r == '{' ||
-									r == '}' ||
-									r == '[' ||
-									r == ']' ||
-									r == '^' ||
-									r == '$' ||
-									r == '.'
-							}
-
-							// we assume that the escape character is '\'
-							likePatternToRegexp := func(pattern string) (*regexp.Regexp, error) {
-								if out, ok := regexpCache.Get(pattern); ok {
-									return out.(*regexp.Regexp), nil
-								}
-
-								var sb strings.Builder
-								sb.WriteRune('^') // match start
-
-								escaping := false // was the character previously seen an escaping \
-
-								for _, r := range pattern {
-									if escaping { // escaping \, _ and % is legal (we just write . or .*), otherwise an error occurs
-										if r != likeAny && r != likeAll && r != likeEscape {
-											return nil, fmt.Errorf("escaping invalid character in LIKE pattern: %v", r)
-										}
-
-										escaping = false
-										sb.WriteRune(r)
-
-										if r == likeEscape {
-											// since _ and % don't need to be escaped in regexp we just replace \_ with _
-											// but \ needs to be replaced in both, so we need to write an additional \
-											sb.WriteRune(likeEscape)
-										}
-									} else {
-										if r == likeEscape { // if we find an escape sequence we just handle it in the next step
-											escaping = true
-										} else if r == likeAny { // _ transforms to . (any character)
-											sb.WriteRune('.')
-										} else if r == likeAll { // % transforms to .* (any string)
-											sb.WriteString(".*")
-										} else if needsEscaping(r) { // escape characters that might break the regexp
-											sb.WriteRune('\\')
-											sb.WriteRune(r)
-										} else { // just write everything else
-											sb.WriteRune(r)
-										}
-									}
-								}
-
-								sb.WriteRune('$') // match end
-
-								if escaping {
-									return nil, fmt.Errorf("pattern ends with an escape character that doesn't escape anything")
-								}
-
-								reg, err := regexp.Compile(sb.String())
-								if err != nil {
-									return nil, fmt.Errorf("couldn't compile LIKE pattern regexp expression: '%s' => '%s': %w", values[1].Str, sb.String(), err)
-								}
-
-								regexpCache.Set(pattern, reg, 1)
-
-								return reg, nil
-							}
-
-							reg, err := likePatternToRegexp(values[1].Str)
-							if err != nil {
-								return octosql.Value{}, fmt.Errorf("couldn't transform LIKE pattern to regexp: %w", err)
-							}
-
-							return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
+						reg, err := compileRegexp(pattern)
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't compile LIKE pattern regexp expression: '%s' => '%s': %w", values[1].Str, pattern, err)
 						}
-					}(),
+
+						return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
+					},
 				},
 			},
 		},
@@ -597,35 +774,14 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
 					OutputType:    octosql.Boolean,
 					Strict:        true,
-					Function: func() func(values []octosql.Value) (octosql.Value, error) {
-						regexpCache, err := ristretto.NewCache(&ristretto.Config{
-							NumCounters: 128,     // number of keys to track frequency of (10M).
-							MaxCost:     1 << 26, // maximum cost of cache (64MB).
-							BufferItems: 64,      // number of keys per Get buffer.
-						})
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						reg, err := compileRegexp(values[1].Str)
 						if err != nil {
-							panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+							return octosql.Value{}, fmt.Errorf("couldn't compile ~ pattern regexp expression: '%s': %w", values[1].Str, err)
 						}
 
-						return func(values []octosql.Value) (octosql.Value, error) {
-							pattern := values[1].Str
-
-							var reg *regexp.Regexp
-							if cached, ok := regexpCache.Get(pattern); ok {
-								reg = cached.(*regexp.Regexp)
-							} else {
-								compiled, err := regexp.Compile(pattern)
-								if err != nil {
-									return octosql.Value{}, fmt.Errorf("couldn't compile ~ pattern regexp expression: '%s': %w", pattern, err)
-								}
-								reg = compiled
-
-								regexpCache.Set(pattern, compiled, 1)
-							}
-
-							return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
-						}
-					}(),
+						return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
+					},
 				},
 			},
 		},
@@ -636,39 +792,17 @@ func FunctionMap() map[string]physical.FunctionDetails {
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
 					OutputType:    octosql.Boolean,
 					Strict:        true,
-					Function: func() func(values []octosql.Value) (octosql.Value, error) {
-						regexpCache, err := ristretto.NewCache(&ristretto.Config{
-							NumCounters: 128,     // number of keys to track frequency of (10M).
-							MaxCost:     1 << 26, // maximum cost of cache (64MB).
-							BufferItems: 64,      // number of keys per Get buffer.
-						})
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						reg, err := compileRegexp("(?i)" + values[1].Str)
 						if err != nil {
-							panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+							return octosql.Value{}, fmt.Errorf("couldn't compile ~* pattern regexp expression: '%s': %w", values[1].Str, err)
 						}
 
-						return func(values []octosql.Value) (octosql.Value, error) {
-							pattern := strings.ToLower(values[1].Str)
-
-							var reg *regexp.Regexp
-							if cached, ok := regexpCache.Get(pattern); ok {
-								reg = cached.(*regexp.Regexp)
-							} else {
-								compiled, err := regexp.Compile(pattern)
-								if err != nil {
-									return octosql.Value{}, fmt.Errorf("couldn't compile ~ pattern regexp expression: '%s': %w", pattern, err)
-								}
-								reg = compiled
-
-								regexpCache.Set(pattern, compiled, 1)
-							}
-
-							return octosql.NewBoolean(reg.MatchString(strings.ToLower(values[0].Str))), nil
-						}
-					}(),
+						return octosql.NewBoolean(reg.MatchString(values[0].Str)), nil
+					},
 				},
 			},
 		},
-		// TODO: Regexp Match with capture of first group.
 		"upper": {
 			Description: "Returns the argument upper cased.",
 			Descriptors: []physical.FunctionDescriptor{
@@ -769,124 +903,796 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
-		// time
-		"now": {
-			Description: "Returns the current time.",
+		"split": {
+			Description: "Splits the first argument into a list of strings using the separator in the second argument.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}},
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewTime(time.Now()), nil
+						parts := strings.Split(values[0].Str, values[1].Str)
+						out := make([]octosql.Value, len(parts))
+						for i := range parts {
+							out[i] = octosql.NewString(parts[i])
+						}
+						return octosql.NewList(out), nil
 					},
 				},
 			},
 		},
-		"parse_time": {
-			Description: "Parses the time in the second argument using the pattern in the first argument. The pattern should be specified as in the Go standard library time.Parse function: https://pkg.go.dev/time#pkg-constants",
+		"trim": {
+			Description: "Removes whitespace, or any of the characters in the optional second argument, from both sides of the first argument.",
 			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(strings.TrimSpace(values[0].Str)), nil
+					},
+				},
 				{
 					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
-					OutputType:    octosql.TypeSum(octosql.Time, octosql.Null),
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						t, err := time.Parse(values[0].Str, values[1].Str)
-						if err != nil {
-							log.Printf("error parsing time: %s", err)
-							return octosql.NewNull(), nil
-						}
-						return octosql.NewTime(t), nil
+						return octosql.NewString(strings.Trim(values[0].Str, values[1].Str)), nil
 					},
 				},
 			},
 		},
-		"time_from_unix": {
-			Description: "Parses the unix timestamp as a time.",
+		"ltrim": {
+			Description: "Removes whitespace, or any of the characters in the optional second argument, from the left side of the first argument.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.Int},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewTime(time.Unix(int64(values[0].Int), 0)), nil
+						return octosql.NewString(strings.TrimLeftFunc(values[0].Str, unicode.IsSpace)), nil
 					},
 				},
 				{
-					ArgumentTypes: []octosql.Type{octosql.Float},
-					OutputType:    octosql.Time,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						i, f := math.Modf(values[0].Float)
-						return octosql.NewTime(time.Unix(int64(i), int64(float64(time.Second)*f))), nil
+						return octosql.NewString(strings.TrimLeft(values[0].Str, values[1].Str)), nil
 					},
 				},
 			},
 		},
-		// Conversions
-		"int": {
-			Description: "Converts the argument to an int.",
+		"rtrim": {
+			Description: "Removes whitespace, or any of the characters in the optional second argument, from the right side of the first argument.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					// This case will catch any types which may be int at the start of non-exact matching.
-					// So the int function can be used as a type cast.
-					ArgumentTypes: []octosql.Type{octosql.Int},
-					OutputType:    octosql.Int,
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return values[0], nil
+						return octosql.NewString(strings.TrimRightFunc(values[0].Str, unicode.IsSpace)), nil
 					},
 				},
 				{
-					ArgumentTypes: []octosql.Type{octosql.Float},
-					OutputType:    octosql.Int,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(int(values[0].Float)), nil
+						return octosql.NewString(strings.TrimRight(values[0].Str, values[1].Str)), nil
 					},
 				},
+			},
+		},
+		"lpad": {
+			Description: "Pads the first argument on the left to the length in the second argument, using spaces or the optional third argument. Longer strings are truncated.",
+			Descriptors: []physical.FunctionDescriptor{
 				{
-					ArgumentTypes: []octosql.Type{octosql.String},
-					OutputType:    octosql.Int,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						n, err := strconv.Atoi(values[0].Str)
-						if err != nil {
-							log.Printf("couldn't parse string '%s' as int: %s", values[0].Str, err)
-							return octosql.NewNull(), nil
-						}
-						return octosql.NewInt(n), nil
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", true)), nil
 					},
 				},
 				{
-					ArgumentTypes: []octosql.Type{octosql.Duration},
-					OutputType:    octosql.Int,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewInt(int(values[0].Duration)), nil
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, true)), nil
 					},
 				},
 			},
 		},
-		"float": {
-			Description: "Converts the argument to an float.",
+		"rpad": {
+			Description: "Pads the first argument on the right to the length in the second argument, using spaces or the optional third argument. Longer strings are truncated.",
 			Descriptors: []physical.FunctionDescriptor{
 				{
-					// This case will catch any types which may be float at the start of non-exact matching.
-					// So the int function can be used as a type cast.
-					ArgumentTypes: []octosql.Type{octosql.Float},
-					OutputType:    octosql.Float,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return values[0], nil
+						return octosql.NewString(pad(values[0].Str, values[1].Int, " ", false)), nil
 					},
 				},
 				{
-					ArgumentTypes: []octosql.Type{octosql.Int},
-					OutputType:    octosql.Float,
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.String},
+					OutputType:    octosql.String,
 					Strict:        true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						return octosql.NewFloat(float64(values[0].Int)), nil
+						return octosql.NewString(pad(values[0].Str, values[1].Int, values[2].Str, false)), nil
+					},
+				},
+			},
+		},
+		"position": {
+			Description: "Returns the position of the first occurrence of the first argument in the second argument, counting characters from 1. Returns 0 if it's not present.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						index := strings.Index(values[1].Str, values[0].Str)
+						if index == -1 {
+							return octosql.NewInt(0), nil
+						}
+						return octosql.NewInt(utf8.RuneCountInString(values[1].Str[:index]) + 1), nil
+					},
+				},
+			},
+		},
+		"starts_with": {
+			Description: "Returns whether the first argument starts with the second one.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasPrefix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"ends_with": {
+			Description: "Returns whether the first argument ends with the second one.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.Boolean,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewBoolean(strings.HasSuffix(values[0].Str, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"regexp_extract": {
+			Description: "Returns the first match of the regex pattern in the second argument in the first argument. If the pattern contains capturing groups, the first group is returned instead of the whole match. The optional third argument specifies the index of the group to return, 0 being the whole match. Returns NULL if there's no match.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						reg, err := compileRegexp(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't compile regexp_extract pattern regexp expression: '%s': %w", values[1].Str, err)
+						}
+						group := 0
+						if reg.NumSubexp() > 0 {
+							group = 1
+						}
+						return regexpExtract(reg, values[0].Str, group)
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.Int},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						reg, err := compileRegexp(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't compile regexp_extract pattern regexp expression: '%s': %w", values[1].Str, err)
+						}
+						return regexpExtract(reg, values[0].Str, values[2].Int)
+					},
+				},
+			},
+		},
+		"regexp_replace": {
+			Description: "Replaces all matches of the regex pattern in the second argument in the first argument by the third argument. The replacement can reference capturing groups using $1, $2, etc.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String, octosql.String},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						reg, err := compileRegexp(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't compile regexp_replace pattern regexp expression: '%s': %w", values[1].Str, err)
+						}
+						return octosql.NewString(reg.ReplaceAllString(values[0].Str, values[2].Str)), nil
+					},
+				},
+			},
+		},
+		"concat": {
+			Description: "Concatenates the string arguments. NULL arguments are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) == 0 || !allNullableStrings(ts) {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(concatStrings(values, "")), nil
+					},
+				},
+			},
+		},
+		"concat_ws": {
+			Description: "Concatenates the string arguments after the first one, using the first argument as the separator. NULL arguments are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) < 2 || ts[0].TypeID != octosql.TypeIDString || !allNullableStrings(ts[1:]) {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(concatStrings(values[1:], values[0].Str)), nil
+					},
+				},
+			},
+		},
+		"format": {
+			Description: "Formats the arguments after the first one according to the printf-style format in the first argument, i.e. format('%s: %d', name, count). The format is specified as in the Go standard library fmt package: https://pkg.go.dev/fmt. Nulls are formatted as NULL. If a verb doesn't match the type of its argument, the result is NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) == 0 || ts[0].TypeID != octosql.TypeIDString {
+							return octosql.Type{}, false
+						}
+						return octosql.TypeSum(octosql.String, octosql.Null), true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						str, err := formatValues(values[0].Str, values[1:])
+						if err != nil {
+							log.Printf("couldn't format '%s': %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewString(str), nil
+					},
+				},
+			},
+		},
+		// time
+		"now": {
+			Description: "Returns the current time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(time.Now()), nil
+					},
+				},
+			},
+		},
+		"parse_time": {
+			Description: "Parses the time in the second argument using the pattern in the first argument. The pattern should be specified as in the Go standard library time.Parse function: https://pkg.go.dev/time#pkg-constants",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Time, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := time.Parse(values[0].Str, values[1].Str)
+						if err != nil {
+							log.Printf("error parsing time: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+			},
+		},
+		"time_from_unix": {
+			Description: "Parses the unix timestamp as a time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewTime(time.Unix(int64(values[0].Int), 0)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						i, f := math.Modf(values[0].Float)
+						return octosql.NewTime(time.Unix(int64(i), int64(float64(time.Second)*f))), nil
+					},
+				},
+			},
+		},
+		"to_unix": {
+			Description: "Returns the time as a unix timestamp in seconds.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Time.Unix())), nil
+					},
+				},
+			},
+		},
+		"date_trunc": {
+			Description: "Truncates the time or date in the second argument to the unit in the first argument. Available units are nanosecond, microsecond, millisecond, second, minute, hour, day, week, month, quarter and year. Weeks start on Monday.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := truncateTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := truncateTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewDate(t), nil
+					},
+				},
+			},
+		},
+		"extract": {
+			Description: "Returns the part of the time or date in the second argument specified by the first argument. Available parts are nanosecond, microsecond, millisecond, second, minute, hour, day, dow (0 is Sunday), isodow (1 is Monday), doy, week (ISO), month, quarter, year, isoyear and epoch.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := extractTimePart(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := extractTimePart(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+			},
+		},
+		"date_part": {
+			Description: "Alias for extract.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := extractTimePart(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						part, err := extractTimePart(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(part), nil
+					},
+				},
+			},
+		},
+		"format_time": {
+			Description: "Formats the time in the second argument using the layout in the first argument. The layout can be a strftime layout, like '%Y-%m-%d %H:%M:%S', or a Go standard library layout, like '2006-01-02 15:04:05': https://pkg.go.dev/time#pkg-constants",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						formatted, err := formatTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewString(formatted), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						formatted, err := formatTime(values[0].Str, values[1].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewString(formatted), nil
+					},
+				},
+			},
+		},
+		"date_add": {
+			Description: "Adds the amount in the second argument of units in the first argument to the time or date in the third argument. Available units are the same as for date_trunc, only day and larger units can be added to dates. Adding months keeps the day of the month, clamping it to the last day of the resulting month.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.Time},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := addToTime(values[0].Str, values[1].Int, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewTime(t), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Int, octosql.Date},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := addToDate(values[0].Str, values[1].Int, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewDate(t), nil
+					},
+				},
+			},
+		},
+		"date_diff": {
+			Description: "Returns the number of whole units in the first argument between the start time in the second argument and end time in the third argument. Available units are the same as for date_trunc. Dates are treated as midnight UTC.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						diff, err := timeDiff(values[0].Str, values[1].Time, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(diff), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date, octosql.Date},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						diff, err := timeDiff(values[0].Str, values[1].Time, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(diff), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Date, octosql.Time},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						diff, err := timeDiff(values[0].Str, values[1].Time, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(diff), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.Time, octosql.Date},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						diff, err := timeDiff(values[0].Str, values[1].Time, values[2].Time)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewInt(diff), nil
+					},
+				},
+			},
+		},
+		"at_time_zone": {
+			Description: "Converts the time in the first argument to the IANA time zone in the second argument, i.e. 'Europe/Warsaw'. The point in time stays the same, but truncating, extracting parts and formatting will use the new time zone. Dates are converted to midnight in the time zone.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.String},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						loc, err := loadLocation(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						return octosql.NewTime(values[0].Time.In(loc)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Date, octosql.String},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						loc, err := loadLocation(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						year, month, day := values[0].Time.Date()
+						return octosql.NewTime(time.Date(year, month, day, 0, 0, 0, 0, loc)), nil
+					},
+				},
+			},
+		},
+		"set_time_zone": {
+			Description: "Interprets the wall clock time of the first argument as being in the IANA time zone in the second argument, i.e. 'Europe/Warsaw'. In contrast to at_time_zone, this changes the point in time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time, octosql.String},
+					OutputType:    octosql.Time,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						loc, err := loadLocation(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						t := values[0].Time
+						year, month, day := t.Date()
+						hour, minute, second := t.Clock()
+						return octosql.NewTime(time.Date(year, month, day, hour, minute, second, t.Nanosecond(), loc)), nil
+					},
+				},
+			},
+		},
+		"time_zone": {
+			Description: "Returns the name of the time zone of the time.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time},
+					OutputType:    octosql.String,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(values[0].Time.Location().String()), nil
+					},
+				},
+			},
+		},
+		"parse_interval": {
+			Description: "Parses a calendar interval, like '1 year 2 months 3 days 4 hours'. Months and days are added according to the calendar, so their length varies.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Interval, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						interval, err := octosql.ParseInterval(values[0].Str)
+						if err != nil {
+							log.Printf("error parsing interval: %s", err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewInterval(interval), nil
+					},
+				},
+			},
+		},
+		// json
+		"parse_json": {
+			Description: "Parses the JSON string into a value of the target type, which has to be provided using a cast, i.e. parse_json(x)::{name: string, tags: [string]}. Missing fields are NULL, while a document which doesn't match the type results in NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Any,
+					Strict:        true,
+					FunctionFn: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+						return func(values []octosql.Value) (octosql.Value, error) {
+							var value interface{}
+							if err := json.Unmarshal([]byte(values[0].Str), &value); err != nil {
+								log.Printf("couldn't parse string as JSON: %s", err)
+								return octosql.NewNull(), nil
+							}
+							out, ok := jsonvalue.GetOctoSQLValue(outputType, value, "")
+							if !ok {
+								return octosql.NewNull(), nil
+							}
+							return out, nil
+						}
+					},
+				},
+			},
+		},
+		"json_extract": {
+			Description: "Returns the part of the JSON document in the first argument at the path in the second argument, i.e. '$.user.tags[0]', as a JSON string. Returns NULL if the path doesn't exist.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String, octosql.String},
+					OutputType:    octosql.TypeSum(octosql.String, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						path, err := parseJSONPath(values[1].Str)
+						if err != nil {
+							return octosql.Value{}, err
+						}
+						var value interface{}
+						if err := json.Unmarshal([]byte(values[0].Str), &value); err != nil {
+							log.Printf("couldn't parse string as JSON: %s", err)
+							return octosql.NewNull(), nil
+						}
+						extracted, ok := extractJSONPath(value, path)
+						if !ok {
+							return octosql.NewNull(), nil
+						}
+						data, err := json.Marshal(extracted)
+						if err != nil {
+							return octosql.Value{}, fmt.Errorf("couldn't serialize extracted JSON: %w", err)
+						}
+						return octosql.NewString(string(data)), nil
+					},
+				},
+			},
+		},
+		"json_keys": {
+			Description: "Returns the sorted keys of the JSON object. Returns NULL if the argument isn't a JSON object.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.TypeSum(octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &octosql.String}}, octosql.Null),
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						var value interface{}
+						if err := json.Unmarshal([]byte(values[0].Str), &value); err != nil {
+							log.Printf("couldn't parse string as JSON: %s", err)
+							return octosql.NewNull(), nil
+						}
+						keys, ok := jsonKeys(value)
+						if !ok {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewList(keys), nil
+					},
+				},
+			},
+		},
+		"to_json": {
+			Description: "Serializes the argument as a JSON string. Structs become objects with their field names as keys.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 1 {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict: false,
+					FunctionFn: func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) {
+						return func(values []octosql.Value) (octosql.Value, error) {
+							data, err := appendJSON(nil, argumentTypes[0], values[0])
+							if err != nil {
+								return octosql.Value{}, fmt.Errorf("couldn't serialize value as JSON: %w", err)
+							}
+							return octosql.NewString(string(data)), nil
+						}
+					},
+				},
+			},
+		},
+		// Conversions
+		"int": {
+			Description: "Converts the argument to an int.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					// This case will catch any types which may be int at the start of non-exact matching.
+					// So the int function can be used as a type cast.
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Float)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						n, err := strconv.Atoi(values[0].Str)
+						if err != nil {
+							log.Printf("couldn't parse string '%s' as int: %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewInt(n), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Duration},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(int(values[0].Duration)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Decimal},
+					OutputType:    octosql.Int,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(values[0].Decimal.Int()), nil
+					},
+				},
+			},
+		},
+		"float": {
+			Description: "Converts the argument to an float.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					// This case will catch any types which may be float at the start of non-exact matching.
+					// So the int function can be used as a type cast.
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(float64(values[0].Int)), nil
 					},
 				},
 				{
@@ -910,6 +1716,105 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						return octosql.NewFloat(float64(values[0].Duration)), nil
 					},
 				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Decimal},
+					OutputType:    octosql.Float,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewFloat(values[0].Decimal.Float()), nil
+					},
+				},
+			},
+		},
+		"decimal": {
+			Description: "Converts the argument to a decimal. Use a cast, like decimal(x)::decimal(10, 2), to round it to a given scale. Values which aren't decimals or don't fit in the maximum precision of 38 digits are converted to NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					// This case will catch any types which may be decimal at the start of non-exact matching.
+					// So the decimal function can be used as a type cast.
+					ArgumentTypes: []octosql.Type{octosql.Decimal},
+					OutputType:    octosql.Decimal,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Int},
+					OutputType:    octosql.Decimal,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDecimal(octosql.DecimalFromInt(values[0].Int)), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Float},
+					OutputType:    octosql.Decimal,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						d, err := octosql.DecimalFromFloat(values[0].Float)
+						if err == nil {
+							err = checkDecimalPrecision(d)
+						}
+						if err != nil {
+							log.Printf("couldn't convert float %v to decimal: %s", values[0].Float, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewDecimal(d), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Decimal,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						d, err := octosql.ParseDecimal(values[0].Str)
+						if err == nil {
+							err = checkDecimalPrecision(d)
+						}
+						if err != nil {
+							log.Printf("couldn't parse string '%s' as decimal: %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewDecimal(d), nil
+					},
+				},
+			},
+		},
+		"date": {
+			Description: "Converts the argument to a date. Times are converted using the date in their time zone, while strings have to be ISO dates, like '2021-03-04'.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					// This case will catch any types which may be date at the start of non-exact matching.
+					// So the date function can be used as a type cast.
+					ArgumentTypes: []octosql.Type{octosql.Date},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return values[0], nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.Time},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewDate(values[0].Time), nil
+					},
+				},
+				{
+					ArgumentTypes: []octosql.Type{octosql.String},
+					OutputType:    octosql.Date,
+					Strict:        true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						t, err := time.Parse(octosql.DateLayout, values[0].Str)
+						if err != nil {
+							log.Printf("couldn't parse string '%s' as date: %s", values[0].Str, err)
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewDate(t), nil
+					},
+				},
 			},
 		},
 		"string": {
@@ -934,17 +1839,23 @@ func FunctionMap() map[string]physical.FunctionDetails {
 						if len(ts) != 2 {
 							return octosql.Type{}, false
 						}
-						if ts[0].TypeID != octosql.TypeIDList {
+						// Nullable lists and indices are allowed, as the function is strict.
+						list, ok := nullableList(ts[0])
+						if !ok {
 							return octosql.Type{}, false
 						}
-						if ts[1].TypeID != octosql.TypeIDInt {
+						if ts[1].Is(octosql.TypeSum(octosql.Int, octosql.Null)) != octosql.TypeRelationIs {
 							return octosql.Type{}, false
 						}
-						return octosql.TypeSum(*ts[0].List.Element, octosql.Null), true
+						if list.List.Element == nil {
+							// The list is always empty.
+							return octosql.Null, true
+						}
+						return octosql.TypeSum(*list.List.Element, octosql.Null), true
 					},
 					Strict: true,
 					Function: func(values []octosql.Value) (octosql.Value, error) {
-						if values[1].Int >= len(values[0].List) {
+						if values[1].Int < 0 || values[1].Int >= len(values[0].List) {
 							return octosql.NewNull(), nil
 						}
 						return values[0].List[values[1].Int], nil
@@ -952,6 +1863,190 @@ func FunctionMap() map[string]physical.FunctionDetails {
 				},
 			},
 		},
+		"array_length": {
+			Description: "Returns the number of elements in the list.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: singleListTypeFn(func(list octosql.Type) octosql.Type {
+						return octosql.Int
+					}),
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewInt(len(values[0].List)), nil
+					},
+				},
+			},
+		},
+		"array_contains": {
+			Description: "Returns whether the list contains the value.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						if _, ok := nullableList(ts[0]); !ok {
+							return octosql.Type{}, false
+						}
+						return octosql.Boolean, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						for i := range values[0].List {
+							if values[1].Equal(values[0].List[i]) {
+								return octosql.NewBoolean(true), nil
+							}
+						}
+						return octosql.NewBoolean(false), nil
+					},
+				},
+			},
+		},
+		"array_slice": {
+			Description: "Returns the part of the list between the zero-based start index, inclusive, and end index, exclusive. Negative indices count from the end of the list.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 3 {
+							return octosql.Type{}, false
+						}
+						list, ok := nullableList(ts[0])
+						if !ok {
+							return octosql.Type{}, false
+						}
+						if ts[1].Is(octosql.TypeSum(octosql.Int, octosql.Null)) != octosql.TypeRelationIs ||
+							ts[2].Is(octosql.TypeSum(octosql.Int, octosql.Null)) != octosql.TypeRelationIs {
+							return octosql.Type{}, false
+						}
+						return list, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						start, end := sliceBounds(len(values[0].List), values[1].Int, values[2].Int)
+						out := make([]octosql.Value, end-start)
+						copy(out, values[0].List[start:end])
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"array_concat": {
+			Description: "Concatenates the list arguments. NULL arguments are skipped, if all arguments are NULL then the result is NULL.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) == 0 {
+							return octosql.Type{}, false
+						}
+						var element *octosql.Type
+						allNullable := true
+						for i := range ts {
+							if octosql.Null.Is(ts[i]) != octosql.TypeRelationIs {
+								allNullable = false
+							}
+							if ts[i].TypeID == octosql.TypeIDNull {
+								continue
+							}
+							list, ok := nullableList(ts[i])
+							if !ok {
+								return octosql.Type{}, false
+							}
+							if list.List.Element == nil {
+								continue
+							}
+							if element == nil {
+								element = list.List.Element
+							} else {
+								sum := octosql.TypeSum(*element, *list.List.Element)
+								element = &sum
+							}
+						}
+						out := octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: element}}
+						if allNullable {
+							out = octosql.TypeSum(out, octosql.Null)
+						}
+						return out, true
+					},
+					Strict: false,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						var out []octosql.Value
+						allNull := true
+						for i := range values {
+							if values[i].TypeID != octosql.TypeIDNull {
+								allNull = false
+							}
+							out = append(out, values[i].List...)
+						}
+						if allNull {
+							return octosql.NewNull(), nil
+						}
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"array_distinct": {
+			Description: "Returns the list without duplicate elements, keeping the first occurrence of each element.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: singleListTypeFn(func(list octosql.Type) octosql.Type {
+						return list
+					}),
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewList(distinctValues(values[0].List)), nil
+					},
+				},
+			},
+		},
+		"array_sort": {
+			Description: "Returns the list sorted in ascending order.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: singleListTypeFn(func(list octosql.Type) octosql.Type {
+						return list
+					}),
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						out := make([]octosql.Value, len(values[0].List))
+						copy(out, values[0].List)
+						sort.SliceStable(out, func(i, j int) bool {
+							return out[i].Compare(out[j]) < 0
+						})
+						return octosql.NewList(out), nil
+					},
+				},
+			},
+		},
+		"array_join": {
+			Description: "Joins the elements of the list into a string, using the second argument as the separator. NULL elements are skipped.",
+			Descriptors: []physical.FunctionDescriptor{
+				{
+					TypeFn: func(ts []octosql.Type) (octosql.Type, bool) {
+						if len(ts) != 2 {
+							return octosql.Type{}, false
+						}
+						if _, ok := nullableList(ts[0]); !ok {
+							return octosql.Type{}, false
+						}
+						if ts[1].Is(octosql.TypeSum(octosql.String, octosql.Null)) != octosql.TypeRelationIs {
+							return octosql.Type{}, false
+						}
+						return octosql.String, true
+					},
+					Strict: true,
+					Function: func(values []octosql.Value) (octosql.Value, error) {
+						return octosql.NewString(joinValues(values[0].List, values[1].Str)), nil
+					},
+				},
+			},
+		},
+		"transform": {
+			Description: "Applies the lambda in the second argument to each element of the list, i.e. transform(list, x -> x * 2).",
+		},
+		"filter": {
+			Description: "Returns the elements of the list for which the lambda in the second argument is true, i.e. filter(list, x -> x > 2).",
+		},
 		"in": {
 			Description: "",
 			Descriptors: []physical.FunctionDescriptor{
@@ -0,0 +1,213 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// parseJSONPath parses paths like '$.a.b[0]' or '$["a b"]' into a list of object keys and list indices.
+// The leading '$' is optional.
+func parseJSONPath(path string) ([]interface{}, error) {
+	rest := strings.TrimPrefix(path, "$")
+	var out []interface{}
+	for i := 0; i < len(rest); {
+		if rest[i] == '[' {
+			end := strings.IndexByte(rest[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed '[' in JSON path '%s'", path)
+			}
+			inner := rest[i+1 : i+end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				out = append(out, inner[1:len(inner)-1])
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index '%s' in JSON path '%s'", inner, path)
+				}
+				out = append(out, index)
+			}
+			i += end + 1
+			continue
+		}
+
+		if rest[i] == '.' {
+			i++
+		}
+		end := strings.IndexAny(rest[i:], ".[")
+		if end == -1 {
+			end = len(rest) - i
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("empty key in JSON path '%s'", path)
+		}
+		out = append(out, rest[i:i+end])
+		i += end
+	}
+	return out, nil
+}
+
+// extractJSONPath returns the part of the decoded JSON document at the given path, ok is false if it doesn't exist.
+func extractJSONPath(value interface{}, path []interface{}) (out interface{}, ok bool) {
+	for _, part := range path {
+		switch part := part.(type) {
+		case string:
+			object, isObject := value.(map[string]interface{})
+			if !isObject {
+				return nil, false
+			}
+			if value, ok = object[part]; !ok {
+				return nil, false
+			}
+		case int:
+			list, isList := value.([]interface{})
+			if !isList || part < 0 || part >= len(list) {
+				return nil, false
+			}
+			value = list[part]
+		}
+	}
+	return value, true
+}
+
+// jsonKeys returns the sorted keys of the JSON object, ok is false if the value isn't an object.
+func jsonKeys(value interface{}) (keys []octosql.Value, ok bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	keys = make([]octosql.Value, len(names))
+	for i := range names {
+		keys[i] = octosql.NewString(names[i])
+	}
+	return keys, true
+}
+
+// appendJSON serializes the value of the given type as JSON.
+// Structs become objects using the field names of their type, times are formatted as RFC3339
+// and durations and intervals use their string representation.
+func appendJSON(buf []byte, t octosql.Type, value octosql.Value) ([]byte, error) {
+	t = valueAlternative(t, value)
+
+	switch value.TypeID {
+	case octosql.TypeIDNull:
+		return append(buf, "null"...), nil
+	case octosql.TypeIDInt:
+		return strconv.AppendInt(buf, int64(value.Int), 10), nil
+	case octosql.TypeIDFloat:
+		if math.IsNaN(value.Float) || math.IsInf(value.Float, 0) {
+			return nil, fmt.Errorf("can't serialize %v as JSON", value.Float)
+		}
+		return strconv.AppendFloat(buf, value.Float, 'g', -1, 64), nil
+	case octosql.TypeIDBoolean:
+		return strconv.AppendBool(buf, value.Boolean), nil
+	case octosql.TypeIDString:
+		return appendJSONString(buf, value.Str)
+	case octosql.TypeIDTime:
+		return appendJSONString(buf, value.Time.Format(time.RFC3339Nano))
+	case octosql.TypeIDDate:
+		return appendJSONString(buf, value.Time.Format(octosql.DateLayout))
+	case octosql.TypeIDDuration:
+		return appendJSONString(buf, value.Duration.String())
+	case octosql.TypeIDInterval:
+		return appendJSONString(buf, value.Interval.String())
+	case octosql.TypeIDDecimal:
+		return append(buf, value.Decimal.String()...), nil
+	case octosql.TypeIDList:
+		elementType := octosql.Any
+		if t.TypeID == octosql.TypeIDList && t.List.Element != nil {
+			elementType = *t.List.Element
+		}
+		elementTypes := make([]octosql.Type, len(value.List))
+		for i := range elementTypes {
+			elementTypes[i] = elementType
+		}
+		return appendJSONArray(buf, elementTypes, value.List)
+	case octosql.TypeIDTuple:
+		elementTypes := make([]octosql.Type, len(value.Tuple))
+		for i := range elementTypes {
+			elementTypes[i] = octosql.Any
+			if t.TypeID == octosql.TypeIDTuple && i < len(t.Tuple.Elements) {
+				elementTypes[i] = t.Tuple.Elements[i]
+			}
+		}
+		return appendJSONArray(buf, elementTypes, value.Tuple)
+	case octosql.TypeIDStruct:
+		if t.TypeID != octosql.TypeIDStruct || len(t.Struct.Fields) != len(value.Struct) {
+			// Without the type we don't know the field names.
+			elementTypes := make([]octosql.Type, len(value.Struct))
+			for i := range elementTypes {
+				elementTypes[i] = octosql.Any
+			}
+			return appendJSONArray(buf, elementTypes, value.Struct)
+		}
+		buf = append(buf, '{')
+		for i := range value.Struct {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			var err error
+			if buf, err = appendJSONString(buf, t.Struct.Fields[i].Name); err != nil {
+				return nil, err
+			}
+			buf = append(buf, ':')
+			if buf, err = appendJSON(buf, t.Struct.Fields[i].Type, value.Struct[i]); err != nil {
+				return nil, fmt.Errorf("couldn't serialize field '%s': %w", t.Struct.Fields[i].Name, err)
+			}
+		}
+		return append(buf, '}'), nil
+	default:
+		return nil, fmt.Errorf("can't serialize value with type id %d as JSON", value.TypeID)
+	}
+}
+
+func appendJSONArray(buf []byte, types []octosql.Type, values []octosql.Value) ([]byte, error) {
+	buf = append(buf, '[')
+	for i := range values {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		var err error
+		if buf, err = appendJSON(buf, types[i], values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, ']'), nil
+}
+
+func appendJSONString(buf []byte, str string) ([]byte, error) {
+	data, err := json.Marshal(str)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, data...), nil
+}
+
+// valueAlternative returns the alternative of the union type that the value belongs to.
+func valueAlternative(t octosql.Type, value octosql.Value) octosql.Type {
+	if t.TypeID != octosql.TypeIDUnion {
+		return t
+	}
+	for _, alternative := range t.Union.Alternatives {
+		if alternative.TypeID != value.TypeID {
+			continue
+		}
+		if alternative.TypeID == octosql.TypeIDStruct && len(alternative.Struct.Fields) != len(value.Struct) {
+			continue
+		}
+		return alternative
+	}
+	return octosql.Any
+}
@@ -0,0 +1,107 @@
+package functions
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// nullableList returns the list type of a list or nullable list.
+func nullableList(t octosql.Type) (octosql.Type, bool) {
+	list, ok := physical.ListAlternative(t)
+	if !ok {
+		return octosql.Type{}, false
+	}
+	if t.TypeID == octosql.TypeIDUnion {
+		for _, alternative := range t.Union.Alternatives {
+			if alternative.TypeID != octosql.TypeIDList && alternative.TypeID != octosql.TypeIDNull {
+				return octosql.Type{}, false
+			}
+		}
+	}
+	return list, true
+}
+
+// singleListTypeFn accepts a single, possibly nullable, list argument and returns the output type based on it.
+func singleListTypeFn(outputType func(list octosql.Type) octosql.Type) func([]octosql.Type) (octosql.Type, bool) {
+	return func(ts []octosql.Type) (octosql.Type, bool) {
+		if len(ts) != 1 {
+			return octosql.Type{}, false
+		}
+		list, ok := nullableList(ts[0])
+		if !ok {
+			return octosql.Type{}, false
+		}
+		return outputType(list), true
+	}
+}
+
+// sliceBounds returns the bounds of the slice of a list with the given length.
+// Negative indices count from the end of the list, and both indices are clamped to the list.
+func sliceBounds(length, start, end int) (int, int) {
+	clamp := func(i int) int {
+		if i < 0 {
+			i += length
+		}
+		if i < 0 {
+			return 0
+		}
+		if i > length {
+			return length
+		}
+		return i
+	}
+	start, end = clamp(start), clamp(end)
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// distinctValues returns the values without duplicates, keeping the first occurrence of each value.
+func distinctValues(values []octosql.Value) []octosql.Value {
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Compare(values[indices[j]]) < 0
+	})
+	duplicate := make([]bool, len(values))
+	for i := 1; i < len(indices); i++ {
+		if values[indices[i]].Compare(values[indices[i-1]]) == 0 {
+			duplicate[indices[i]] = true
+		}
+	}
+
+	out := make([]octosql.Value, 0, len(values))
+	for i := range values {
+		if !duplicate[i] {
+			out = append(out, values[i])
+		}
+	}
+	return out
+}
+
+// joinValues joins the string representations of the values using the separator. NULL values are skipped.
+func joinValues(values []octosql.Value, separator string) string {
+	var sb strings.Builder
+	first := true
+	for _, value := range values {
+		if value.TypeID == octosql.TypeIDNull {
+			continue
+		}
+		if !first {
+			sb.WriteString(separator)
+		}
+		first = false
+		if value.TypeID == octosql.TypeIDString {
+			sb.WriteString(value.Str)
+		} else {
+			sb.WriteString(value.String())
+		}
+	}
+	return sb.String()
+}
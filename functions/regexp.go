@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dgraph-io/ristretto"
+)
+
+// regexpCache holds compiled regular expressions, so that patterns don't have to be recompiled for each record.
+var regexpCache = func() *ristretto.Cache {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1 << 14, // number of keys to track frequency of, 10x the expected maximum number of patterns.
+		MaxCost:     1 << 10, // maximum number of cached patterns, each has a cost of 1.
+		BufferItems: 64,      // number of keys per Get buffer.
+	})
+	if err != nil {
+		panic(fmt.Errorf("couldn't initialize regexp cache: %w", err))
+	}
+	return cache
+}()
+
+// compileRegexp compiles the pattern, or returns the cached regexp if it has already been compiled.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := regexpCache.Get(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	reg, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Set(pattern, reg, 1)
+	return reg, nil
+}
+
+// likePatternToRegexp transforms a LIKE pattern into an equivalent regexp pattern.
+// We assume that the escape character is '\'.
+func likePatternToRegexp(pattern string) (string, error) {
+	const likeEscape = '\\'
+	const likeAny = '_'
+	const likeAll = '%'
+
+	needsEscaping := func(r rune) bool {
+		return r == '+' ||
+			r == '?' ||
+			r == '(' ||
+			r == ')' ||
+			r == '{' ||
+			r == '}' ||
+			r == '[' ||
+			r == ']' ||
+			r == '^' ||
+			r == '$' ||
+			r == '.'
+	}
+
+	var sb strings.Builder
+	sb.WriteRune('^') // match start
+
+	escaping := false // was the character previously seen an escaping \
+
+	for _, r := range pattern {
+		if escaping { // escaping \, _ and % is legal (we just write . or .*), otherwise an error occurs
+			if r != likeAny && r != likeAll && r != likeEscape {
+				return "", fmt.Errorf("escaping invalid character in LIKE pattern: %v", r)
+			}
+
+			escaping = false
+			sb.WriteRune(r)
+
+			if r == likeEscape {
+				// since _ and % don't need to be escaped in regexp we just replace \_ with _
+				// but \ needs to be replaced in both, so we need to write an additional \
+				sb.WriteRune(likeEscape)
+			}
+		} else {
+			if r == likeEscape { // if we find an escape sequence we just handle it in the next step
+				escaping = true
+			} else if r == likeAny { // _ transforms to . (any character)
+				sb.WriteRune('.')
+			} else if r == likeAll { // % transforms to .* (any string)
+				sb.WriteString(".*")
+			} else if needsEscaping(r) { // escape characters that might break the regexp
+				sb.WriteRune('\\')
+				sb.WriteRune(r)
+			} else { // just write everything else
+				sb.WriteRune(r)
+			}
+		}
+	}
+
+	sb.WriteRune('$') // match end
+
+	if escaping {
+		return "", fmt.Errorf("pattern ends with an escape character that doesn't escape anything")
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,153 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// pad pads the string to the given length in characters, repeating the fill string as needed.
+// Strings longer than the length are truncated.
+func pad(str string, length int, fill string, left bool) string {
+	runes := []rune(str)
+	if length < 0 {
+		length = 0
+	}
+	if len(runes) >= length {
+		return string(runes[:length])
+	}
+	fillRunes := []rune(fill)
+	if len(fillRunes) == 0 {
+		return str
+	}
+	padding := make([]rune, length-len(runes))
+	for i := range padding {
+		padding[i] = fillRunes[i%len(fillRunes)]
+	}
+	if left {
+		return string(padding) + str
+	}
+	return str + string(padding)
+}
+
+func regexpExtract(reg *regexp.Regexp, str string, group int) (octosql.Value, error) {
+	if group < 0 || group > reg.NumSubexp() {
+		return octosql.NewNull(), nil
+	}
+	match := reg.FindStringSubmatchIndex(str)
+	if match == nil || match[2*group] == -1 {
+		return octosql.NewNull(), nil
+	}
+	return octosql.NewString(str[match[2*group]:match[2*group+1]]), nil
+}
+
+func allNullableStrings(ts []octosql.Type) bool {
+	for _, t := range ts {
+		if t.Is(octosql.TypeSum(octosql.String, octosql.Null)) != octosql.TypeRelationIs {
+			return false
+		}
+	}
+	return true
+}
+
+// concatStrings concatenates the string values using the separator, skipping nulls.
+func concatStrings(values []octosql.Value, separator string) string {
+	var sb strings.Builder
+	first := true
+	for _, value := range values {
+		if value.TypeID != octosql.TypeIDString {
+			continue
+		}
+		if !first {
+			sb.WriteString(separator)
+		}
+		sb.WriteString(value.Str)
+		first = false
+	}
+	return sb.String()
+}
+
+// formatValues formats the values according to the printf-style format, checking that each verb matches the type of its value.
+// Nulls are formatted as NULL using any verb.
+func formatValues(format string, values []octosql.Value) (string, error) {
+	var sb strings.Builder
+	argIndex := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			sb.WriteByte(format[i])
+			continue
+		}
+		start := i
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) != -1 {
+			i++
+		}
+		if i == len(format) {
+			return "", fmt.Errorf("missing verb at the end of the format")
+		}
+		verb := format[i]
+		if verb == '%' {
+			sb.WriteByte('%')
+			continue
+		}
+		if verb == '*' || verb == '[' {
+			return "", fmt.Errorf("argument widths and indices aren't supported")
+		}
+		if argIndex == len(values) {
+			return "", fmt.Errorf("missing argument for verb %s", format[start:i+1])
+		}
+		value := values[argIndex]
+		argIndex++
+
+		if value.TypeID == octosql.TypeIDNull {
+			spec := format[start:i]
+			if dot := strings.IndexByte(spec, '.'); dot != -1 {
+				spec = spec[:dot]
+			}
+			sb.WriteString(fmt.Sprintf(spec+"s", "NULL"))
+			continue
+		}
+		if !formatVerbAccepts(verb, value.TypeID) {
+			return "", fmt.Errorf("verb %s can't format %s value %s", format[start:i+1], value.Type(), value)
+		}
+		var arg interface{}
+		switch {
+		case verb == 's' || verb == 'q':
+			if value.TypeID == octosql.TypeIDString {
+				arg = value.Str
+			} else {
+				arg = value.String()
+			}
+		case value.TypeID == octosql.TypeIDInt && strings.IndexByte("eEfFgG", verb) != -1:
+			arg = float64(value.Int)
+		case value.TypeID == octosql.TypeIDList || value.TypeID == octosql.TypeIDStruct || value.TypeID == octosql.TypeIDTuple:
+			arg = value.String()
+		default:
+			arg = value.ToRawGoValue()
+		}
+		sb.WriteString(fmt.Sprintf(format[start:i+1], arg))
+	}
+	if argIndex < len(values) {
+		return "", fmt.Errorf("too many arguments, the format uses %d but got %d", argIndex, len(values))
+	}
+	return sb.String(), nil
+}
+
+func formatVerbAccepts(verb byte, typeID octosql.TypeID) bool {
+	switch verb {
+	case 'v', 's', 'q':
+		return true
+	case 'd', 'b', 'o', 'c', 'U':
+		return typeID == octosql.TypeIDInt
+	case 'x', 'X':
+		return typeID == octosql.TypeIDInt || typeID == octosql.TypeIDFloat || typeID == octosql.TypeIDString
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		return typeID == octosql.TypeIDInt || typeID == octosql.TypeIDFloat
+	case 't':
+		return typeID == octosql.TypeIDBoolean
+	default:
+		return false
+	}
+}
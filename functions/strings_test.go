@@ -0,0 +1,114 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+func TestFormatValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		args   []octosql.Value
+		want   string
+		err    bool
+	}{
+		{
+			name:   "matching verbs",
+			format: "%s: %d, %.2f, %t, %x",
+			args:   []octosql.Value{octosql.NewString("a"), octosql.NewInt(3), octosql.NewFloat(1.5), octosql.NewBoolean(true), octosql.NewInt(255)},
+			want:   "a: 3, 1.50, true, ff",
+		},
+		{
+			name:   "string verb formats any value",
+			format: "%s %s %v",
+			args:   []octosql.Value{octosql.NewInt(3), octosql.NewList([]octosql.Value{octosql.NewInt(1)}), octosql.NewFloat(0.5)},
+			want:   "3 [1] 0.5",
+		},
+		{
+			name:   "list",
+			format: "%v",
+			args:   []octosql.Value{octosql.NewList([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")})},
+			want:   "[1, 'a']",
+		},
+		{
+			name:   "struct",
+			format: "%v",
+			args:   []octosql.Value{octosql.NewStruct([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")})},
+			want:   "{ 1, 'a' }",
+		},
+		{
+			name:   "tuple",
+			format: "%v",
+			args:   []octosql.Value{octosql.NewTuple([]octosql.Value{octosql.NewInt(1), octosql.NewString("a")})},
+			want:   "(1, 'a')",
+		},
+		{
+			name:   "float verb formats ints",
+			format: "%.1f",
+			args:   []octosql.Value{octosql.NewInt(2)},
+			want:   "2.0",
+		},
+		{
+			name:   "nulls",
+			format: "[%5d] [%.2f]",
+			args:   []octosql.Value{octosql.NewNull(), octosql.NewNull()},
+			want:   "[ NULL] [NULL]",
+		},
+		{
+			name:   "percent sign",
+			format: "%d%%",
+			args:   []octosql.Value{octosql.NewInt(50)},
+			want:   "50%",
+		},
+		{
+			name:   "int verb with string",
+			format: "%d",
+			args:   []octosql.Value{octosql.NewString("a")},
+			err:    true,
+		},
+		{
+			name:   "bool verb with int",
+			format: "%t",
+			args:   []octosql.Value{octosql.NewInt(1)},
+			err:    true,
+		},
+		{
+			name:   "missing argument",
+			format: "%s %s",
+			args:   []octosql.Value{octosql.NewString("a")},
+			err:    true,
+		},
+		{
+			name:   "too many arguments",
+			format: "%s",
+			args:   []octosql.Value{octosql.NewString("a"), octosql.NewString("b")},
+			err:    true,
+		},
+		{
+			name:   "missing verb",
+			format: "100%",
+			err:    true,
+		},
+		{
+			name:   "argument index",
+			format: "%[1]d",
+			args:   []octosql.Value{octosql.NewInt(1)},
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatValues(tt.format, tt.args)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
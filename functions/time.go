@@ -0,0 +1,386 @@
+package functions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// normalizeTimeUnit lower cases the unit and removes the plural form, so that i.e. 'Days' and 'day' are the same.
+func normalizeTimeUnit(unit string) string {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	if unit != "ms" && unit != "us" && unit != "ns" {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+	switch unit {
+	case "ms":
+		return "millisecond"
+	case "us":
+		return "microsecond"
+	case "ns":
+		return "nanosecond"
+	}
+	return unit
+}
+
+// truncateTime truncates the time to the beginning of the unit, in the time's location.
+// Weeks start on Monday.
+func truncateTime(unit string, t time.Time) (time.Time, error) {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	nanosecond := t.Nanosecond()
+
+	switch normalizeTimeUnit(unit) {
+	case "nanosecond":
+		return t, nil
+	case "microsecond":
+		return time.Date(year, month, day, hour, minute, second, nanosecond-nanosecond%int(time.Microsecond), t.Location()), nil
+	case "millisecond":
+		return time.Date(year, month, day, hour, minute, second, nanosecond-nanosecond%int(time.Millisecond), t.Location()), nil
+	case "second":
+		return time.Date(year, month, day, hour, minute, second, 0, t.Location()), nil
+	case "minute":
+		return time.Date(year, month, day, hour, minute, 0, 0, t.Location()), nil
+	case "hour":
+		return time.Date(year, month, day, hour, 0, 0, 0, t.Location()), nil
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location()), nil
+	case "week":
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location()), nil
+	case "month":
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location()), nil
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location()), nil
+	case "year":
+		return time.Date(year, 1, 1, 0, 0, 0, 0, t.Location()), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit for truncation: '%s'", unit)
+	}
+}
+
+// extractTimePart returns the given part of the time, in the time's location.
+func extractTimePart(part string, t time.Time) (int, error) {
+	switch normalizeTimeUnit(part) {
+	case "nanosecond":
+		return t.Nanosecond(), nil
+	case "microsecond":
+		return t.Nanosecond() / int(time.Microsecond), nil
+	case "millisecond":
+		return t.Nanosecond() / int(time.Millisecond), nil
+	case "second":
+		return t.Second(), nil
+	case "minute":
+		return t.Minute(), nil
+	case "hour":
+		return t.Hour(), nil
+	case "day":
+		return t.Day(), nil
+	case "dow", "dayofweek":
+		return int(t.Weekday()), nil
+	case "isodow":
+		return (int(t.Weekday())+6)%7 + 1, nil
+	case "doy", "dayofyear":
+		return t.YearDay(), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return week, nil
+	case "month":
+		return int(t.Month()), nil
+	case "quarter":
+		return (int(t.Month())-1)/3 + 1, nil
+	case "year":
+		return t.Year(), nil
+	case "isoyear":
+		year, _ := t.ISOWeek()
+		return year, nil
+	case "epoch":
+		return int(t.Unix()), nil
+	default:
+		return 0, fmt.Errorf("invalid time part to extract: '%s'", part)
+	}
+}
+
+// addToTime adds the amount of units to the time.
+// Adding months keeps the day of the month, unless it doesn't exist in the resulting month, in which case its last day is used.
+func addToTime(unit string, amount int, t time.Time) (time.Time, error) {
+	switch normalizeTimeUnit(unit) {
+	case "nanosecond":
+		return t.Add(time.Duration(amount)), nil
+	case "microsecond":
+		return t.Add(time.Duration(amount) * time.Microsecond), nil
+	case "millisecond":
+		return t.Add(time.Duration(amount) * time.Millisecond), nil
+	case "second":
+		return t.Add(time.Duration(amount) * time.Second), nil
+	case "minute":
+		return t.Add(time.Duration(amount) * time.Minute), nil
+	case "hour":
+		return t.Add(time.Duration(amount) * time.Hour), nil
+	case "day":
+		return t.AddDate(0, 0, amount), nil
+	case "week":
+		return t.AddDate(0, 0, amount*7), nil
+	case "month":
+		return addMonths(t, amount), nil
+	case "quarter":
+		return addMonths(t, amount*3), nil
+	case "year":
+		return addMonths(t, amount*12), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit to add: '%s'", unit)
+	}
+}
+
+// addToDate adds the amount of units to the date, only day and larger units are allowed.
+func addToDate(unit string, amount int, t time.Time) (time.Time, error) {
+	switch normalizeTimeUnit(unit) {
+	case "day", "week", "month", "quarter", "year":
+		return addToTime(unit, amount, t)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time unit to add to a date: '%s', convert the date to a time to add smaller units", unit)
+	}
+}
+
+func addMonths(t time.Time, months int) time.Time {
+	return octosql.CalendarInterval{Months: months}.AddTo(t)
+}
+
+// timeDiff returns the number of whole units between start and end.
+// It's negative if end is before start.
+func timeDiff(unit string, start, end time.Time) (int, error) {
+	switch normalizeTimeUnit(unit) {
+	case "nanosecond":
+		return int(end.Sub(start)), nil
+	case "microsecond":
+		return int(end.Sub(start) / time.Microsecond), nil
+	case "millisecond":
+		return int(end.Sub(start) / time.Millisecond), nil
+	case "second":
+		return int(end.Sub(start) / time.Second), nil
+	case "minute":
+		return int(end.Sub(start) / time.Minute), nil
+	case "hour":
+		return int(end.Sub(start) / time.Hour), nil
+	case "day":
+		return int(end.Sub(start) / (24 * time.Hour)), nil
+	case "week":
+		return int(end.Sub(start) / (7 * 24 * time.Hour)), nil
+	case "month":
+		return monthsDiff(start, end), nil
+	case "quarter":
+		return monthsDiff(start, end) / 3, nil
+	case "year":
+		return monthsDiff(start, end) / 12, nil
+	default:
+		return 0, fmt.Errorf("invalid time unit to diff: '%s'", unit)
+	}
+}
+
+func monthsDiff(start, end time.Time) int {
+	end = end.In(start.Location())
+	months := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	if months > 0 && addMonths(start, months).After(end) {
+		months--
+	} else if months < 0 && addMonths(start, months).Before(end) {
+		months++
+	}
+	return months
+}
+
+// nullableOperand returns the type ID of a, possibly nullable, argument of one of the given types.
+func nullableOperand(t octosql.Type, typeIDs ...octosql.TypeID) (octosql.TypeID, bool) {
+	alternatives := []octosql.Type{t}
+	if t.TypeID == octosql.TypeIDUnion {
+		alternatives = t.Union.Alternatives
+	}
+	var out octosql.TypeID
+	ok := false
+alternativeLoop:
+	for _, alternative := range alternatives {
+		if alternative.TypeID == octosql.TypeIDNull {
+			continue
+		}
+		for _, typeID := range typeIDs {
+			if alternative.TypeID == typeID && !ok {
+				out, ok = typeID, true
+				continue alternativeLoop
+			}
+		}
+		return 0, false
+	}
+	return out, ok
+}
+
+// mixedTypeFn returns a type function accepting two arguments of different types out of the given ones, in any order.
+func mixedTypeFn(outputType octosql.Type, typeIDs ...octosql.TypeID) func([]octosql.Type) (octosql.Type, bool) {
+	return func(ts []octosql.Type) (octosql.Type, bool) {
+		if len(ts) != 2 {
+			return octosql.Type{}, false
+		}
+		left, ok := nullableOperand(ts[0], typeIDs...)
+		if !ok {
+			return octosql.Type{}, false
+		}
+		right, ok := nullableOperand(ts[1], typeIDs...)
+		if !ok || left == right {
+			return octosql.Type{}, false
+		}
+		return outputType, true
+	}
+}
+
+// dateTimeComparison returns a descriptor of a comparison operator between a date and a time, in any order.
+// Dates are compared as midnight UTC.
+func dateTimeComparison(fn func(cmp int) bool) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: mixedTypeFn(octosql.Boolean, octosql.TypeIDDate, octosql.TypeIDTime),
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			cmp := 0
+			if values[0].Time.Before(values[1].Time) {
+				cmp = -1
+			} else if values[0].Time.After(values[1].Time) {
+				cmp = 1
+			}
+			return octosql.NewBoolean(fn(cmp)), nil
+		},
+	}
+}
+
+// durationIntervalComparison returns a descriptor of a comparison operator between a duration and an interval, in any order.
+// Intervals containing months are compared using their approximate length.
+func durationIntervalComparison(fn func(cmp int) bool) physical.FunctionDescriptor {
+	return physical.FunctionDescriptor{
+		TypeFn: mixedTypeFn(octosql.Boolean, octosql.TypeIDDuration, octosql.TypeIDInterval),
+		Strict: true,
+		Function: func(values []octosql.Value) (octosql.Value, error) {
+			left, right := toInterval(values[0]), toInterval(values[1])
+			leftDuration, leftOk := left.FixedDuration()
+			rightDuration, rightOk := right.FixedDuration()
+			if !leftOk || !rightOk {
+				return octosql.NewBoolean(fn(left.Compare(right))), nil
+			}
+			cmp := 0
+			if leftDuration < rightDuration {
+				cmp = -1
+			} else if leftDuration > rightDuration {
+				cmp = 1
+			}
+			return octosql.NewBoolean(fn(cmp)), nil
+		},
+	}
+}
+
+func toInterval(value octosql.Value) octosql.CalendarInterval {
+	if value.TypeID == octosql.TypeIDDuration {
+		return octosql.CalendarInterval{Duration: value.Duration}
+	}
+	return value.Interval
+}
+
+// formatTime formats the time using a strftime layout if it contains any % directives, or a Go layout otherwise.
+func formatTime(layout string, t time.Time) (string, error) {
+	if !strings.Contains(layout, "%") {
+		return t.Format(layout), nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			sb.WriteByte(layout[i])
+			continue
+		}
+		i++
+		if i == len(layout) {
+			return "", fmt.Errorf("strftime layout can't end with a single %%: '%s'", layout)
+		}
+		switch layout[i] {
+		case 'Y':
+			sb.WriteString(strconv.Itoa(t.Year()))
+		case 'y':
+			sb.WriteString(t.Format("06"))
+		case 'm':
+			sb.WriteString(t.Format("01"))
+		case 'd':
+			sb.WriteString(t.Format("02"))
+		case 'e':
+			sb.WriteString(t.Format("_2"))
+		case 'H':
+			sb.WriteString(t.Format("15"))
+		case 'I':
+			sb.WriteString(t.Format("03"))
+		case 'M':
+			sb.WriteString(t.Format("04"))
+		case 'S':
+			sb.WriteString(t.Format("05"))
+		case 'f':
+			sb.WriteString(fmt.Sprintf("%06d", t.Nanosecond()/int(time.Microsecond)))
+		case 'p':
+			sb.WriteString(t.Format("PM"))
+		case 'b', 'h':
+			sb.WriteString(t.Format("Jan"))
+		case 'B':
+			sb.WriteString(t.Format("January"))
+		case 'a':
+			sb.WriteString(t.Format("Mon"))
+		case 'A':
+			sb.WriteString(t.Format("Monday"))
+		case 'j':
+			sb.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case 'u':
+			sb.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'w':
+			sb.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'V':
+			_, week := t.ISOWeek()
+			sb.WriteString(fmt.Sprintf("%02d", week))
+		case 'G':
+			year, _ := t.ISOWeek()
+			sb.WriteString(strconv.Itoa(year))
+		case 'z':
+			sb.WriteString(t.Format("-0700"))
+		case 'Z':
+			sb.WriteString(t.Format("MST"))
+		case 's':
+			sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'F':
+			sb.WriteString(t.Format("2006-01-02"))
+		case 'T':
+			sb.WriteString(t.Format("15:04:05"))
+		case 'D':
+			sb.WriteString(t.Format("01/02/06"))
+		case 'R':
+			sb.WriteString(t.Format("15:04"))
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case '%':
+			sb.WriteByte('%')
+		default:
+			return "", fmt.Errorf("unsupported strftime directive: '%%%c'", layout[i])
+		}
+	}
+	return sb.String(), nil
+}
+
+var locationCache sync.Map
+
+// loadLocation loads the IANA time zone with the given name, caching the result.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load time zone '%s': %w", name, err)
+	}
+	locationCache.Store(name, loc)
+	return loc, nil
+}
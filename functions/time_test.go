@@ -0,0 +1,432 @@
+package functions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cube2222/octosql/octosql"
+)
+
+// callFunction calls the overload of the function whose argument types match the values exactly.
+func callFunction(t *testing.T, name string, args ...octosql.Value) (octosql.Value, error) {
+	t.Helper()
+	details, ok := FunctionMap()[name]
+	require.True(t, ok, "unknown function %s", name)
+
+descriptorLoop:
+	for _, descriptor := range details.Descriptors {
+		if len(descriptor.ArgumentTypes) != len(args) {
+			continue
+		}
+		for i := range args {
+			if !args[i].Type().Equals(descriptor.ArgumentTypes[i]) {
+				continue descriptorLoop
+			}
+		}
+		return descriptor.Function(args)
+	}
+	require.FailNow(t, "no matching overload", "function %s", name)
+	return octosql.Value{}, nil
+}
+
+func mustParseTime(t *testing.T, str string) octosql.Value {
+	parsed, err := time.Parse(time.RFC3339Nano, str)
+	require.NoError(t, err)
+	return octosql.NewTime(parsed)
+}
+
+func mustParseDate(t *testing.T, str string) octosql.Value {
+	parsed, err := time.Parse(octosql.DateLayout, str)
+	require.NoError(t, err)
+	return octosql.NewDate(parsed)
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+type timeFunctionTest struct {
+	name string
+	args []octosql.Value
+	// want is the string representation of the result, with times formatted using RFC3339Nano.
+	want string
+	err  bool
+}
+
+func runTimeFunctionTests(t *testing.T, function string, tests []timeFunctionTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := callFunction(t, function, tt.args...)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if got.TypeID == octosql.TypeIDTime {
+				assert.Equal(t, tt.want, got.Time.Format(time.RFC3339Nano))
+			} else {
+				assert.Equal(t, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestToUnix(t *testing.T) {
+	runTimeFunctionTests(t, "to_unix", []timeFunctionTest{
+		{
+			name: "utc",
+			args: []octosql.Value{mustParseTime(t, "2021-03-04T05:06:07.9Z")},
+			want: "1614834367",
+		},
+		{
+			name: "time zone",
+			args: []octosql.Value{mustParseTime(t, "2021-03-04T06:06:07+01:00")},
+			want: "1614834367",
+		},
+		{
+			name: "before epoch",
+			args: []octosql.Value{mustParseTime(t, "1969-12-31T23:59:59Z")},
+			want: "-1",
+		},
+	})
+}
+
+func TestDateTrunc(t *testing.T) {
+	runTimeFunctionTests(t, "date_trunc", []timeFunctionTest{
+		{
+			name: "millisecond",
+			args: []octosql.Value{octosql.NewString("ms"), mustParseTime(t, "2021-03-04T05:06:07.123456789Z")},
+			want: "2021-03-04T05:06:07.123Z",
+		},
+		{
+			name: "hour",
+			args: []octosql.Value{octosql.NewString("Hours"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			want: "2021-03-04T05:00:00Z",
+		},
+		{
+			name: "week starts on monday",
+			args: []octosql.Value{octosql.NewString("week"), mustParseTime(t, "2021-03-07T05:06:07Z")},
+			want: "2021-03-01T00:00:00Z",
+		},
+		{
+			name: "week across months",
+			args: []octosql.Value{octosql.NewString("week"), mustParseTime(t, "2021-05-01T00:00:00Z")},
+			want: "2021-04-26T00:00:00Z",
+		},
+		{
+			name: "quarter",
+			args: []octosql.Value{octosql.NewString("quarter"), mustParseTime(t, "2021-06-30T23:59:59Z")},
+			want: "2021-04-01T00:00:00Z",
+		},
+		{
+			name: "day in time zone",
+			args: []octosql.Value{octosql.NewString("day"), mustParseTime(t, "2021-03-04T00:30:00+01:00")},
+			want: "2021-03-04T00:00:00+01:00",
+		},
+		{
+			name: "date",
+			args: []octosql.Value{octosql.NewString("month"), mustParseDate(t, "2021-03-04")},
+			want: "2021-03-01",
+		},
+		{
+			name: "invalid unit",
+			args: []octosql.Value{octosql.NewString("fortnight"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+	})
+}
+
+func TestExtract(t *testing.T) {
+	runTimeFunctionTests(t, "extract", []timeFunctionTest{
+		{
+			name: "microsecond",
+			args: []octosql.Value{octosql.NewString("us"), mustParseTime(t, "2021-03-04T05:06:07.123456789Z")},
+			want: "123456",
+		},
+		{
+			name: "day of week of sunday",
+			args: []octosql.Value{octosql.NewString("dow"), mustParseTime(t, "2021-03-07T05:06:07Z")},
+			want: "0",
+		},
+		{
+			name: "iso day of week of sunday",
+			args: []octosql.Value{octosql.NewString("isodow"), mustParseTime(t, "2021-03-07T05:06:07Z")},
+			want: "7",
+		},
+		{
+			name: "day of year",
+			args: []octosql.Value{octosql.NewString("doy"), mustParseTime(t, "2020-12-31T00:00:00Z")},
+			want: "366",
+		},
+		{
+			name: "iso week of new year",
+			args: []octosql.Value{octosql.NewString("week"), mustParseTime(t, "2021-01-01T00:00:00Z")},
+			want: "53",
+		},
+		{
+			name: "iso year of new year",
+			args: []octosql.Value{octosql.NewString("isoyear"), mustParseTime(t, "2021-01-01T00:00:00Z")},
+			want: "2020",
+		},
+		{
+			name: "quarter",
+			args: []octosql.Value{octosql.NewString("Quarters"), mustParseTime(t, "2021-10-01T00:00:00Z")},
+			want: "4",
+		},
+		{
+			name: "hour in time zone",
+			args: []octosql.Value{octosql.NewString("hour"), mustParseTime(t, "2021-03-04T23:30:00-05:00")},
+			want: "23",
+		},
+		{
+			name: "epoch",
+			args: []octosql.Value{octosql.NewString("epoch"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			want: "1614834367",
+		},
+		{
+			name: "date",
+			args: []octosql.Value{octosql.NewString("day"), mustParseDate(t, "2021-03-04")},
+			want: "4",
+		},
+		{
+			name: "invalid part",
+			args: []octosql.Value{octosql.NewString("century"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+	})
+}
+
+func TestFormatTime(t *testing.T) {
+	runTimeFunctionTests(t, "format_time", []timeFunctionTest{
+		{
+			name: "strftime",
+			args: []octosql.Value{octosql.NewString("%Y-%m-%d %H:%M:%S.%f"), mustParseTime(t, "2021-03-04T05:06:07.123456789Z")},
+			want: "'2021-03-04 05:06:07.123456'",
+		},
+		{
+			name: "strftime names",
+			args: []octosql.Value{octosql.NewString("%a %A %b %B %e %I%p"), mustParseTime(t, "2021-03-04T15:06:07Z")},
+			want: "'Thu Thursday Mar March  4 03PM'",
+		},
+		{
+			name: "strftime iso weeks",
+			args: []octosql.Value{octosql.NewString("%G-W%V-%u %j"), mustParseTime(t, "2021-01-03T00:00:00Z")},
+			want: "'2020-W53-7 003'",
+		},
+		{
+			name: "strftime time zone",
+			args: []octosql.Value{octosql.NewString("%F %T %z %%"), mustParseTime(t, "2021-03-04T05:06:07+01:00")},
+			want: "'2021-03-04 05:06:07 +0100 %'",
+		},
+		{
+			name: "go layout",
+			args: []octosql.Value{octosql.NewString("Jan 2, 2006 at 3:04pm"), mustParseTime(t, "2021-03-04T15:06:07Z")},
+			want: "'Mar 4, 2021 at 3:06pm'",
+		},
+		{
+			name: "date",
+			args: []octosql.Value{octosql.NewString("%d/%m/%Y"), mustParseDate(t, "2021-03-04")},
+			want: "'04/03/2021'",
+		},
+		{
+			name: "trailing percent",
+			args: []octosql.Value{octosql.NewString("%Y %"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+		{
+			name: "unsupported directive",
+			args: []octosql.Value{octosql.NewString("%Q"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+	})
+}
+
+func TestDateAdd(t *testing.T) {
+	runTimeFunctionTests(t, "date_add", []timeFunctionTest{
+		{
+			name: "hours",
+			args: []octosql.Value{octosql.NewString("hour"), octosql.NewInt(25), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			want: "2021-03-05T06:06:07Z",
+		},
+		{
+			name: "month end",
+			args: []octosql.Value{octosql.NewString("month"), octosql.NewInt(1), mustParseTime(t, "2021-01-31T05:06:07Z")},
+			want: "2021-02-28T05:06:07Z",
+		},
+		{
+			name: "month end of leap year",
+			args: []octosql.Value{octosql.NewString("month"), octosql.NewInt(1), mustParseTime(t, "2020-01-31T00:00:00Z")},
+			want: "2020-02-29T00:00:00Z",
+		},
+		{
+			name: "negative months",
+			args: []octosql.Value{octosql.NewString("months"), octosql.NewInt(-1), mustParseTime(t, "2021-03-31T00:00:00Z")},
+			want: "2021-02-28T00:00:00Z",
+		},
+		{
+			name: "quarter",
+			args: []octosql.Value{octosql.NewString("quarter"), octosql.NewInt(1), mustParseTime(t, "2021-11-30T00:00:00Z")},
+			want: "2022-02-28T00:00:00Z",
+		},
+		{
+			name: "year from leap day",
+			args: []octosql.Value{octosql.NewString("year"), octosql.NewInt(1), mustParseTime(t, "2020-02-29T00:00:00Z")},
+			want: "2021-02-28T00:00:00Z",
+		},
+		{
+			name: "day across daylight saving time keeps the wall clock",
+			args: []octosql.Value{octosql.NewString("day"), octosql.NewInt(1), octosql.NewTime(time.Date(2021, 3, 27, 12, 0, 0, 0, mustLoadLocation(t, "Europe/Warsaw")))},
+			want: "2021-03-28T12:00:00+02:00",
+		},
+		{
+			name: "hours across daylight saving time",
+			args: []octosql.Value{octosql.NewString("hour"), octosql.NewInt(24), octosql.NewTime(time.Date(2021, 3, 27, 12, 0, 0, 0, mustLoadLocation(t, "Europe/Warsaw")))},
+			want: "2021-03-28T13:00:00+02:00",
+		},
+		{
+			name: "date",
+			args: []octosql.Value{octosql.NewString("month"), octosql.NewInt(1), mustParseDate(t, "2021-01-31")},
+			want: "2021-02-28",
+		},
+		{
+			name: "hours to date",
+			args: []octosql.Value{octosql.NewString("hour"), octosql.NewInt(1), mustParseDate(t, "2021-01-31")},
+			err:  true,
+		},
+		{
+			name: "invalid unit",
+			args: []octosql.Value{octosql.NewString("decade"), octosql.NewInt(1), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+	})
+}
+
+func TestDateDiff(t *testing.T) {
+	runTimeFunctionTests(t, "date_diff", []timeFunctionTest{
+		{
+			name: "seconds",
+			args: []octosql.Value{octosql.NewString("second"), mustParseTime(t, "2021-03-04T05:06:07Z"), mustParseTime(t, "2021-03-04T05:07:06.9Z")},
+			want: "59",
+		},
+		{
+			name: "negative days",
+			args: []octosql.Value{octosql.NewString("day"), mustParseTime(t, "2021-03-04T00:00:00Z"), mustParseTime(t, "2021-03-01T12:00:00Z")},
+			want: "-2",
+		},
+		{
+			name: "whole months",
+			args: []octosql.Value{octosql.NewString("month"), mustParseTime(t, "2021-01-15T00:00:00Z"), mustParseTime(t, "2021-03-14T23:59:59Z")},
+			want: "1",
+		},
+		{
+			name: "months from month end",
+			args: []octosql.Value{octosql.NewString("month"), mustParseTime(t, "2021-01-31T00:00:00Z"), mustParseTime(t, "2021-02-28T00:00:00Z")},
+			want: "1",
+		},
+		{
+			name: "negative months",
+			args: []octosql.Value{octosql.NewString("month"), mustParseTime(t, "2021-03-15T00:00:00Z"), mustParseTime(t, "2021-01-16T00:00:00Z")},
+			want: "-1",
+		},
+		{
+			name: "years",
+			args: []octosql.Value{octosql.NewString("year"), mustParseTime(t, "2020-02-29T00:00:00Z"), mustParseTime(t, "2021-02-28T00:00:00Z")},
+			want: "1",
+		},
+		{
+			name: "dates",
+			args: []octosql.Value{octosql.NewString("week"), mustParseDate(t, "2021-03-01"), mustParseDate(t, "2021-03-15")},
+			want: "2",
+		},
+		{
+			name: "date and time",
+			args: []octosql.Value{octosql.NewString("hour"), mustParseDate(t, "2021-03-01"), mustParseTime(t, "2021-03-01T05:30:00Z")},
+			want: "5",
+		},
+		{
+			name: "invalid unit",
+			args: []octosql.Value{octosql.NewString("decade"), mustParseTime(t, "2021-03-04T05:06:07Z"), mustParseTime(t, "2021-03-04T05:06:07Z")},
+			err:  true,
+		},
+	})
+}
+
+func TestAtTimeZone(t *testing.T) {
+	runTimeFunctionTests(t, "at_time_zone", []timeFunctionTest{
+		{
+			name: "winter",
+			args: []octosql.Value{mustParseTime(t, "2021-01-04T12:00:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-01-04T13:00:00+01:00",
+		},
+		{
+			name: "before daylight saving time starts",
+			args: []octosql.Value{mustParseTime(t, "2021-03-28T00:30:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-03-28T01:30:00+01:00",
+		},
+		{
+			name: "after daylight saving time starts",
+			args: []octosql.Value{mustParseTime(t, "2021-03-28T01:30:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-03-28T03:30:00+02:00",
+		},
+		{
+			name: "repeated hour when daylight saving time ends",
+			args: []octosql.Value{mustParseTime(t, "2021-10-31T01:30:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-10-31T02:30:00+01:00",
+		},
+		{
+			name: "date",
+			args: []octosql.Value{mustParseDate(t, "2021-03-28"), octosql.NewString("America/New_York")},
+			want: "2021-03-28T00:00:00-04:00",
+		},
+		{
+			name: "unknown time zone",
+			args: []octosql.Value{mustParseTime(t, "2021-01-04T12:00:00Z"), octosql.NewString("Europe/Atlantis")},
+			err:  true,
+		},
+	})
+}
+
+func TestSetTimeZone(t *testing.T) {
+	runTimeFunctionTests(t, "set_time_zone", []timeFunctionTest{
+		{
+			name: "summer",
+			args: []octosql.Value{mustParseTime(t, "2021-07-01T12:00:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-07-01T12:00:00+02:00",
+		},
+		{
+			name: "from another time zone",
+			args: []octosql.Value{mustParseTime(t, "2021-01-04T12:00:00-05:00"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-01-04T12:00:00+01:00",
+		},
+		{
+			name: "skipped hour when daylight saving time starts",
+			args: []octosql.Value{mustParseTime(t, "2021-03-28T02:30:00Z"), octosql.NewString("Europe/Warsaw")},
+			want: "2021-03-28T03:30:00+02:00",
+		},
+		{
+			name: "unknown time zone",
+			args: []octosql.Value{mustParseTime(t, "2021-01-04T12:00:00Z"), octosql.NewString("Europe/Atlantis")},
+			err:  true,
+		},
+	})
+}
+
+func TestTimeZone(t *testing.T) {
+	runTimeFunctionTests(t, "time_zone", []timeFunctionTest{
+		{
+			name: "utc",
+			args: []octosql.Value{mustParseTime(t, "2021-01-04T12:00:00Z")},
+			want: "'UTC'",
+		},
+		{
+			name: "location",
+			args: []octosql.Value{octosql.NewTime(time.Date(2021, 1, 4, 12, 0, 0, 0, mustLoadLocation(t, "Europe/Warsaw")))},
+			want: "'Europe/Warsaw'",
+		},
+	})
+}
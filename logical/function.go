@@ -39,7 +39,15 @@ descriptorLoop:
 				ts[i] = arguments[i].Type
 			}
 
-			if outputType, ok := descriptor.TypeFn(ts); ok {
+			outputType, ok := descriptor.TypeFn(ts)
+			if !ok && descriptor.Strict {
+				// Strict functions return null for null arguments, so they only have to handle the other alternatives.
+				for i := range ts {
+					ts[i] = withoutNull(ts[i])
+				}
+				outputType, ok = descriptor.TypeFn(ts)
+			}
+			if ok {
 				found = true
 				out = physical.Expression{
 					Type:           outputType,
@@ -133,3 +141,24 @@ descriptorLoop:
 
 	return out
 }
+
+// withoutNull returns the type without its Null alternative, unless it's only Null.
+func withoutNull(t octosql.Type) octosql.Type {
+	if t.TypeID != octosql.TypeIDUnion {
+		return t
+	}
+	var alternatives []octosql.Type
+	for _, alternative := range t.Union.Alternatives {
+		if alternative.TypeID != octosql.TypeIDNull {
+			alternatives = append(alternatives, alternative)
+		}
+	}
+	if len(alternatives) == 0 {
+		return t
+	}
+	out := alternatives[0]
+	for _, alternative := range alternatives[1:] {
+		out = octosql.TypeSum(out, alternative)
+	}
+	return out
+}
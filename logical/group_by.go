@@ -38,7 +38,26 @@ func NewDelayTrigger(delay Expression) *DelayTrigger {
 }
 
 func (w *DelayTrigger) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment, keyTimeIndex int) physical.Trigger {
-	panic("implement me")
+	delay := fixedDurationConstant(w.Delay.Typecheck(ctx, env, logicalEnv))
+	if delay.ExpressionType != physical.ExpressionTypeConstant {
+		panic(fmt.Errorf("delay trigger parameter must be a constant interval, is: %s", delay.ExpressionType))
+	}
+	if delay.Constant.Value.TypeID == octosql.TypeIDInterval {
+		panic(fmt.Errorf("delay trigger interval can't contain months, is: %s", delay.Constant.Value.Interval))
+	}
+	if delay.Constant.Value.TypeID != octosql.TypeIDDuration {
+		panic(fmt.Errorf("expected %s, got %s", octosql.Duration, delay.Type))
+	}
+	if delay.Constant.Value.Duration <= 0 {
+		panic(fmt.Errorf("delay trigger interval must be positive, is: %s", delay.Constant.Value.Duration))
+	}
+
+	return physical.Trigger{
+		TriggerType: physical.TriggerTypeDelay,
+		DelayTrigger: &physical.DelayTrigger{
+			Delay: delay.Constant.Value.Duration,
+		},
+	}
 }
 
 type WatermarkTrigger struct {
@@ -112,63 +131,14 @@ func (node *GroupBy) Typecheck(ctx context.Context, env physical.Environment, lo
 	}
 
 	aggregates := make([]physical.Aggregate, len(node.aggregates))
-aggregateLoop:
 	for i, aggname := range node.aggregates {
-		details := env.Aggregates[aggname]
-		for _, descriptor := range details.Descriptors {
-			if descriptor.TypeFn != nil {
-				if outputType, ok := descriptor.TypeFn(expressions[i].Type); ok {
-					if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-						outputType = octosql.TypeSum(outputType, octosql.Null)
-					}
-
-					aggregates[i] = physical.Aggregate{
-						Name:                node.aggregates[i],
-						OutputType:          outputType,
-						AggregateDescriptor: descriptor,
-					}
-					continue aggregateLoop
-				}
-			} else if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(expressions[i].Type) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
+		aggregates[i], expressions[i] = typecheckAggregate(env, aggname, expressions[i])
+		if aggregates[i].AggregateDescriptor.NoRetractions && source.MayContainRetractions() {
+			panic(fmt.Errorf("aggregate %s doesn't support retractions, but its input may contain them", aggname))
 		}
-		for _, descriptor := range details.Descriptors {
-			if expressions[i].Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
-				assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expressions[i].Type)
-				expressions[i] = physical.Expression{
-					ExpressionType: physical.ExpressionTypeTypeAssertion,
-					Type:           assertedExprType,
-					TypeAssertion: &physical.TypeAssertion{
-						Expression: expressions[i],
-						TargetType: descriptor.ArgumentType,
-					},
-				}
-
-				outputType := descriptor.OutputType
-				if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
-					outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
-				}
-
-				aggregates[i] = physical.Aggregate{
-					Name:                node.aggregates[i],
-					OutputType:          outputType,
-					AggregateDescriptor: descriptor,
-				}
-				continue aggregateLoop
-			}
+		if aggregates[i].AggregateDescriptor.RequiresEventTime && source.Schema.TimeField == -1 {
+			panic(fmt.Errorf("aggregate %s requires an input with an event time field", aggname))
 		}
-		panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expressions[i].Type))
 	}
 
 	triggers := make([]physical.Trigger, len(node.triggers))
@@ -224,3 +194,60 @@ aggregateLoop:
 		},
 	}, outMapping
 }
+
+// typecheckAggregate finds the overload of the aggregate matching the argument expression.
+// The argument expression may get wrapped in a type assertion.
+func typecheckAggregate(env physical.Environment, aggname string, expression physical.Expression) (physical.Aggregate, physical.Expression) {
+	details := env.Aggregates[aggname]
+	for _, descriptor := range details.Descriptors {
+		if descriptor.TypeFn != nil {
+			if outputType, ok := descriptor.TypeFn(expression.Type); ok {
+				if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+					outputType = octosql.TypeSum(outputType, octosql.Null)
+				}
+
+				return physical.Aggregate{
+					Name:                aggname,
+					OutputType:          outputType,
+					AggregateDescriptor: descriptor,
+				}, expression
+			}
+		} else if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationIs {
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(expression.Type) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	for _, descriptor := range details.Descriptors {
+		if expression.Type.Is(octosql.TypeSum(descriptor.ArgumentType, octosql.Null)) == octosql.TypeRelationMaybe {
+			assertedExprType := *octosql.TypeIntersection(octosql.TypeSum(descriptor.ArgumentType, octosql.Null), expression.Type)
+			expression = physical.Expression{
+				ExpressionType: physical.ExpressionTypeTypeAssertion,
+				Type:           assertedExprType,
+				TypeAssertion: &physical.TypeAssertion{
+					Expression: expression,
+					TargetType: descriptor.ArgumentType,
+				},
+			}
+
+			outputType := descriptor.OutputType
+			if octosql.Null.Is(assertedExprType) == octosql.TypeRelationIs {
+				outputType = octosql.TypeSum(descriptor.OutputType, octosql.Null)
+			}
+
+			return physical.Aggregate{
+				Name:                aggname,
+				OutputType:          outputType,
+				AggregateDescriptor: descriptor,
+			}, expression
+		}
+	}
+	panic(fmt.Sprintf("unknown aggregate: %s(%s)", aggname, expression.Type))
+}
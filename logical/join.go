@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -21,16 +23,29 @@ const (
 	JoinStrategyStream    JoinStrategy = "STREAM"
 )
 
-// TODO: Support left joins.
-
 type Join struct {
 	left, right Node
+	joinType    JoinType
+	// Only used by left joins, inner joins get the predicate as a filter above them.
+	predicate Expression
 }
 
 func NewJoin(left, right Node) *Join {
 	return &Join{
-		left:  left,
-		right: right,
+		left:     left,
+		right:    right,
+		joinType: JoinTypeInner,
+	}
+}
+
+// NewLeftJoin creates a join which outputs left records without a match padded with nulls.
+// The predicate may be nil, in which case all records match.
+func NewLeftJoin(left, right Node, predicate Expression) *Join {
+	return &Join{
+		left:      left,
+		right:     right,
+		joinType:  JoinTypeLeft,
+		predicate: predicate,
 	}
 }
 
@@ -44,51 +59,158 @@ func (node *Join) Typecheck(ctx context.Context, env physical.Environment, logic
 		rightMapping[k] = v
 	}
 
+	fields := append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...)
+
+	if node.joinType == JoinTypeInner {
+		return physical.Node{
+			Schema: physical.Schema{
+				Fields:    fields,
+				TimeField: left.Schema.TimeField,
+			},
+			NodeType: physical.NodeTypeStreamJoin,
+			StreamJoin: &physical.StreamJoin{
+				Left:  left,
+				Right: right,
+			},
+		}, rightMapping
+	}
+
+	// The predicate has to be part of the join itself, as a filter above it would remove the null-padded records.
+	var leftKey, rightKey, rightPredicates []physical.Expression
+	if node.predicate != nil {
+		predicate := TypecheckExpression(
+			ctx,
+			env.WithRecordSchema(physical.NewSchema(fields, -1)),
+			logicalEnv.WithRecordUniqueVariableNames(rightMapping),
+			octosql.TypeSum(octosql.Boolean, octosql.Null),
+			node.predicate,
+		)
+
+		for i, part := range predicate.SplitByAnd() {
+			usesLeft, usesRight := usesVariablesFromLeftOrRight(left.Schema, right.Schema, part.VariablesUsed())
+			if !usesRight {
+				// Left records for which this is false simply have no matches.
+				leftKey = append(leftKey, part)
+				rightKey = append(rightKey, physical.Expression{
+					Type:           octosql.Boolean,
+					ExpressionType: physical.ExpressionTypeConstant,
+					Constant:       &physical.Constant{Value: octosql.NewBoolean(true)},
+				})
+				continue
+			}
+			if !usesLeft {
+				rightPredicates = append(rightPredicates, part)
+				continue
+			}
+
+			if part.ExpressionType != physical.ExpressionTypeFunctionCall || part.FunctionCall.Name != "=" {
+				panic(fmt.Errorf("only equality predicates between both sides are supported in stream left joins, got predicate with index %d, use a lookup join instead", i))
+			}
+			firstPart := part.FunctionCall.Arguments[0]
+			secondPart := part.FunctionCall.Arguments[1]
+			firstPartUsesLeft, firstPartUsesRight := usesVariablesFromLeftOrRight(left.Schema, right.Schema, firstPart.VariablesUsed())
+			secondPartUsesLeft, secondPartUsesRight := usesVariablesFromLeftOrRight(left.Schema, right.Schema, secondPart.VariablesUsed())
+
+			if !firstPartUsesRight && !secondPartUsesLeft {
+				leftKey = append(leftKey, firstPart)
+				rightKey = append(rightKey, secondPart)
+			} else if !firstPartUsesLeft && !secondPartUsesRight {
+				leftKey = append(leftKey, secondPart)
+				rightKey = append(rightKey, firstPart)
+			} else {
+				panic(fmt.Errorf("each side of the equality predicate with index %d in a stream left join must use variables from only one input table", i))
+			}
+		}
+	}
+
+	if len(rightPredicates) > 0 {
+		right = physical.Node{
+			Schema:   right.Schema,
+			NodeType: physical.NodeTypeFilter,
+			Filter: &physical.Filter{
+				Source: right,
+				Predicate: physical.Expression{
+					Type:           octosql.Boolean,
+					ExpressionType: physical.ExpressionTypeAnd,
+					And: &physical.And{
+						Arguments: rightPredicates,
+					},
+				},
+			},
+		}
+	}
+
 	return physical.Node{
 		Schema: physical.Schema{
-			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...),
+			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], nullableFields(right.Schema.Fields)...),
 			TimeField: left.Schema.TimeField,
 		},
 		NodeType: physical.NodeTypeStreamJoin,
 		StreamJoin: &physical.StreamJoin{
-			Left:  left,
-			Right: right,
+			Left:       left,
+			Right:      right,
+			LeftKey:    leftKey,
+			RightKey:   rightKey,
+			IsLeftJoin: true,
 		},
 	}, rightMapping
 }
 
-// func usesVariablesFromLeftOrRight(left, right physical.Schema, variables []string) (usesLeft bool, usesRight bool) {
-// 	for _, name := range variables {
-// 		var matchedLeft, matchedRight bool
-// 		for _, field := range left.Fields {
-// 			if physical.VariableNameMatchesField(name, field.Name) {
-// 				usesLeft = true
-// 				matchedLeft = true
-// 				break
-// 			}
-// 		}
-// 		for _, field := range right.Fields {
-// 			if physical.VariableNameMatchesField(name, field.Name) {
-// 				usesRight = true
-// 				matchedRight = true
-// 				break
-// 			}
-// 		}
-// 		if matchedLeft && matchedRight {
-// 			panic(fmt.Errorf("ambiguous variable Name in join predicate: %s", name))
-// 		}
-// 	}
-// 	return
-// }
+func usesVariablesFromLeftOrRight(left, right physical.Schema, variables []string) (usesLeft bool, usesRight bool) {
+	for _, name := range variables {
+		var matchedLeft, matchedRight bool
+		for _, field := range left.Fields {
+			if physical.VariableNameMatchesField(name, field.Name) {
+				usesLeft = true
+				matchedLeft = true
+				break
+			}
+		}
+		for _, field := range right.Fields {
+			if physical.VariableNameMatchesField(name, field.Name) {
+				usesRight = true
+				matchedRight = true
+				break
+			}
+		}
+		if matchedLeft && matchedRight {
+			panic(fmt.Errorf("ambiguous variable Name in join predicate: %s", name))
+		}
+	}
+	return
+}
+
+func nullableFields(fields []physical.SchemaField) []physical.SchemaField {
+	out := make([]physical.SchemaField, len(fields))
+	for i := range fields {
+		out[i] = physical.SchemaField{
+			Name: fields[i].Name,
+			Type: octosql.TypeSum(fields[i].Type, octosql.Null),
+		}
+	}
+	return out
+}
 
 type LateralJoin struct {
 	left, right Node
+	joinType    JoinType
 }
 
 func NewLateralJoin(left, right Node) *LateralJoin {
 	return &LateralJoin{
-		left:  left,
-		right: right,
+		left:     left,
+		right:    right,
+		joinType: JoinTypeInner,
+	}
+}
+
+// NewLeftLateralJoin creates a lateral join which outputs left records without a match padded with nulls.
+// Any join predicate should be a filter in the right node.
+func NewLeftLateralJoin(left, right Node) *LateralJoin {
+	return &LateralJoin{
+		left:     left,
+		right:    right,
+		joinType: JoinTypeLeft,
 	}
 }
 
@@ -103,15 +225,21 @@ func (node *LateralJoin) Typecheck(ctx context.Context, env physical.Environment
 		rightMapping[k] = v
 	}
 
+	rightFields := right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]
+	if node.joinType == JoinTypeLeft {
+		rightFields = nullableFields(rightFields)
+	}
+
 	return physical.Node{
 		Schema: physical.Schema{
-			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], right.Schema.Fields[:len(right.Schema.Fields):len(right.Schema.Fields)]...),
+			Fields:    append(left.Schema.Fields[:len(left.Schema.Fields):len(left.Schema.Fields)], rightFields...),
 			TimeField: left.Schema.TimeField,
 		},
 		NodeType: physical.NodeTypeLookupJoin,
 		LookupJoin: &physical.LookupJoin{
-			Source: left,
-			Joined: right,
+			Source:     left,
+			Joined:     right,
+			IsLeftJoin: node.joinType == JoinTypeLeft,
 		},
 	}, rightMapping
 }
@@ -0,0 +1,87 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// Lambda is a single parameter lambda, like x -> x + 1. It's only valid as an argument of a higher-order function.
+type Lambda struct {
+	parameter string
+	body      Expression
+}
+
+func NewLambda(parameter string, body Expression) *Lambda {
+	return &Lambda{parameter: parameter, body: body}
+}
+
+func (l *Lambda) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	panic(fmt.Errorf("lambda '%s -> ...' can only be used as an argument of transform or filter", l.parameter))
+}
+
+// ListLambda applies the lambda to each element of the list.
+// Transform returns the lambda results, while filter returns the elements for which the lambda is true.
+type ListLambda struct {
+	list   Expression
+	lambda *Lambda
+	filter bool
+}
+
+func NewListLambda(list Expression, lambda *Lambda, filter bool) *ListLambda {
+	return &ListLambda{list: list, lambda: lambda, filter: filter}
+}
+
+func (l *ListLambda) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	list := l.list.Typecheck(ctx, env, logicalEnv)
+	listType, ok := physical.ListAlternative(list.Type)
+	if !ok {
+		panic(fmt.Errorf("%s expects a list as its first argument, got %s", l.functionName(), list.Type))
+	}
+	elementType := octosql.Null
+	if listType.List.Element != nil {
+		elementType = *listType.List.Element
+	}
+
+	parameter := logicalEnv.GetUnique(l.lambda.parameter)
+	bodyEnv := env
+	bodyEnv.VariableContext = env.VariableContext.WithLambdaParameter(physical.SchemaField{
+		Name: parameter,
+		Type: elementType,
+	})
+	bodyLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(map[string]string{l.lambda.parameter: parameter})
+
+	var body physical.Expression
+	var outputType octosql.Type
+	if l.filter {
+		body = TypecheckExpression(ctx, bodyEnv, bodyLogicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), l.lambda.body)
+		outputType = listType
+	} else {
+		body = l.lambda.body.Typecheck(ctx, bodyEnv, bodyLogicalEnv)
+		outputType = octosql.Type{TypeID: octosql.TypeIDList, List: struct{ Element *octosql.Type }{Element: &body.Type}}
+	}
+	if list.Type.TypeID != octosql.TypeIDList {
+		// Values which aren't lists result in NULL.
+		outputType = octosql.TypeSum(outputType, octosql.Null)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeListLambda,
+		ListLambda: &physical.ListLambda{
+			List:      list,
+			Parameter: parameter,
+			Body:      body,
+			Filter:    l.filter,
+		},
+	}
+}
+
+func (l *ListLambda) functionName() string {
+	if l.filter {
+		return "filter"
+	}
+	return "transform"
+}
@@ -0,0 +1,45 @@
+package logical
+
+import (
+	"context"
+
+	"github.com/cube2222/octosql/physical"
+)
+
+type Limit struct {
+	limit, offset int
+	source        Node
+}
+
+func NewLimit(limit, offset int, source Node) *Limit {
+	return &Limit{
+		limit:  limit,
+		offset: offset,
+		source: source,
+	}
+}
+
+func (node *Limit) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+
+	// A directly underlying order by gets merged into the limit, which keeps the records ordered itself.
+	var key []physical.Expression
+	var directionMultipliers []int
+	if source.NodeType == physical.NodeTypeOrderBy {
+		key = source.OrderBy.Key
+		directionMultipliers = source.OrderBy.DirectionMultipliers
+		source = source.OrderBy.Source
+	}
+
+	return physical.Node{
+		Schema:   source.Schema,
+		NodeType: physical.NodeTypeLimit,
+		Limit: &physical.Limit{
+			Source:               source,
+			Key:                  key,
+			DirectionMultipliers: directionMultipliers,
+			Limit:                node.limit,
+			Offset:               node.offset,
+		},
+	}, mapping
+}
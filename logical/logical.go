@@ -3,6 +3,7 @@ package logical
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -140,8 +141,122 @@ func NewStarExpression(qualifier string) *StarExpression {
 	return &StarExpression{qualifier: qualifier}
 }
 
+// Typecheck returns a structure of all fields matched by the star.
+// If the qualifier is not a table but a structure, then that structure is returned.
 func (se *StarExpression) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
-	panic("implement me")
+	if env.VariableContext == nil || logicalEnv.UniqueVariableNames == nil {
+		panic(fmt.Errorf("star expression used outside of a record context"))
+	}
+	fields := env.VariableContext.Fields
+	reverseMapping := ReverseMapping(logicalEnv.UniqueVariableNames.Mapping)
+
+	matched := starFields(fields, reverseMapping, se.qualifier)
+	if len(matched) == 0 && se.qualifier != "" {
+		if _, _, ok := splitFieldPath(&VariableMapping{Mapping: logicalEnv.UniqueVariableNames.Mapping}, se.qualifier); ok {
+			return NewVariable(se.qualifier).Typecheck(ctx, env, logicalEnv)
+		}
+		panic(fmt.Errorf("unknown table or structure: '%s'", se.qualifier))
+	}
+
+	args := make([]physical.Expression, len(matched))
+	structFields := make([]octosql.StructField, len(matched))
+	for i, fieldIndex := range matched {
+		field := fields[fieldIndex]
+		args[i] = physical.Expression{
+			Type:           field.Type,
+			ExpressionType: physical.ExpressionTypeVariable,
+			Variable: &physical.Variable{
+				Name:     field.Name,
+				IsLevel0: true,
+			},
+		}
+		structFields[i] = octosql.StructField{
+			Name: strings.TrimPrefix(reverseMapping[field.Name], se.qualifier+"."),
+			Type: field.Type,
+		}
+	}
+
+	return physical.Expression{
+		Type:           octosql.Type{TypeID: octosql.TypeIDStruct, Struct: struct{ Fields []octosql.StructField }{Fields: structFields}},
+		ExpressionType: physical.ExpressionTypeObject,
+		Object: &physical.Object{
+			Arguments: args,
+		},
+	}
+}
+
+// starFields returns the indices of the fields matched by a star with the given qualifier.
+func starFields(fields []physical.SchemaField, reverseMapping map[string]string, qualifier string) []int {
+	var out []int
+	for i, field := range fields {
+		if strings.HasPrefix(reverseMapping[field.Name], WindowFieldNamePrefix) {
+			continue
+		}
+		if qualifier != "" && !strings.HasPrefix(reverseMapping[field.Name], qualifier+".") {
+			continue
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// expandStruct returns an access expression for each field of the structure, together with the field names.
+func expandStruct(expr physical.Expression) ([]physical.Expression, []string) {
+	structType, ok := physical.StructAlternative(expr.Type)
+	if !ok {
+		panic(fmt.Errorf("only structures can be expanded using *, got %s", expr.Type))
+	}
+
+	exprs := make([]physical.Expression, len(structType.Struct.Fields))
+	names := make([]string, len(structType.Struct.Fields))
+	for i, field := range structType.Struct.Fields {
+		exprs[i] = typecheckFieldAccess(expr, field.Name)
+		names[i] = field.Name
+	}
+	return exprs, names
+}
+
+type FieldAccess struct {
+	expr  Expression
+	field string
+}
+
+func NewFieldAccess(expr Expression, field string) *FieldAccess {
+	return &FieldAccess{expr: expr, field: field}
+}
+
+func (fa *FieldAccess) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	return typecheckFieldAccess(fa.expr.Typecheck(ctx, env, logicalEnv), fa.field)
+}
+
+func (fa *FieldAccess) FieldName() string {
+	return fa.field
+}
+
+func typecheckFieldAccess(expr physical.Expression, field string) physical.Expression {
+	structType, ok := physical.StructAlternative(expr.Type)
+	if !ok {
+		panic(fmt.Errorf("can't access field '%s' of non-structure type %s", field, expr.Type))
+	}
+	for _, structField := range structType.Struct.Fields {
+		if structField.Name != field {
+			continue
+		}
+		fieldType := structField.Type
+		if expr.Type.TypeID != octosql.TypeIDStruct {
+			// Non-structure values, like NULL, have no fields.
+			fieldType = octosql.TypeSum(fieldType, octosql.Null)
+		}
+		return physical.Expression{
+			Type:           fieldType,
+			ExpressionType: physical.ExpressionTypeFieldAccess,
+			FieldAccess: &physical.FieldAccess{
+				Expression: expr,
+				Field:      field,
+			},
+		}
+	}
+	panic(fmt.Errorf("structure of type %s has no field '%s'", expr.Type, field))
 }
 
 type Variable struct {
@@ -152,10 +267,20 @@ func NewVariable(name string) *Variable {
 	return &Variable{name: name}
 }
 
+// Typecheck resolves the variable. If there's no variable with the full name,
+// then a dotted name may also be a variable followed by structure field accesses, like payload.user.id.
 func (v *Variable) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
 	uniqueName, ok := logicalEnv.UniqueVariableNames.GetUniqueName(v.name)
 	if !ok {
-		panic(fmt.Errorf("unknown variable: '%s'", v.name))
+		variableName, fields, ok := splitFieldPath(logicalEnv.UniqueVariableNames, v.name)
+		if !ok {
+			panic(fmt.Errorf("unknown variable: '%s'", v.name))
+		}
+		var expr Expression = NewVariable(variableName)
+		for _, field := range fields {
+			expr = NewFieldAccess(expr, field)
+		}
+		return expr.Typecheck(ctx, env, logicalEnv)
 	}
 
 	isLevel0 := true
@@ -167,12 +292,15 @@ func (v *Variable) Typecheck(ctx context.Context, env physical.Environment, logi
 					ExpressionType: physical.ExpressionTypeVariable,
 					Variable: &physical.Variable{
 						Name:     uniqueName,
-						IsLevel0: isLevel0,
+						IsLevel0: isLevel0 && !varCtx.Lambda,
 					},
 				}
 			}
 		}
-		isLevel0 = false
+		// Lambda parameters aren't part of the record, so the record stays at level 0.
+		if !varCtx.Lambda {
+			isLevel0 = false
+		}
 	}
 	// TODO: Expression typecheck errors should contain context. (position in input SQL)
 	panic(fmt.Errorf("unknown variable: '%s'", uniqueName))
@@ -182,6 +310,18 @@ func (v *Variable) FieldName() string {
 	return v.name
 }
 
+// splitFieldPath splits a dotted name into the longest prefix which is a known variable, and the structure fields accessed on it.
+func splitFieldPath(mapping *VariableMapping, name string) (string, []string, bool) {
+	parts := strings.Split(name, ".")
+	for i := len(parts); i > 0; i-- {
+		variableName := strings.Join(parts[:i], ".")
+		if _, ok := mapping.GetUniqueName(variableName); ok {
+			return variableName, parts[i:], true
+		}
+	}
+	return "", nil, false
+}
+
 type Constant struct {
 	value octosql.Value
 }
@@ -315,6 +455,26 @@ func (ne *QueryExpression) Typecheck(ctx context.Context, env physical.Environme
 	}
 }
 
+type Exists struct {
+	node Node
+}
+
+func NewExists(node Node) *Exists {
+	return &Exists{node: node}
+}
+
+func (e *Exists) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	source, _ := e.node.Typecheck(ctx, env, logicalEnv)
+
+	return physical.Expression{
+		Type:           octosql.Boolean,
+		ExpressionType: physical.ExpressionTypeExists,
+		Exists: &physical.Exists{
+			Source: source,
+		},
+	}
+}
+
 type Coalesce struct {
 	args []Expression
 }
@@ -347,6 +507,70 @@ func (c *Coalesce) Typecheck(ctx context.Context, env physical.Environment, logi
 	}
 }
 
+// Case is either a simple case expression, comparing the value to each condition, or a searched one, if the value is nil.
+type Case struct {
+	value      Expression
+	conditions []Expression
+	results    []Expression
+	elseResult Expression
+}
+
+func NewCase(value Expression, conditions []Expression, results []Expression, elseResult Expression) *Case {
+	return &Case{value: value, conditions: conditions, results: results, elseResult: elseResult}
+}
+
+func (c *Case) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	if len(c.conditions) == 0 {
+		panic("CASE must be provided at least 1 WHEN clause")
+	}
+
+	var value *physical.Expression
+	if c.value != nil {
+		expr := c.value.Typecheck(ctx, env, logicalEnv)
+		value = &expr
+	}
+
+	conditions := make([]physical.Expression, len(c.conditions))
+	for i := range c.conditions {
+		if value == nil {
+			conditions[i] = TypecheckExpression(ctx, env, logicalEnv, octosql.TypeSum(octosql.Boolean, octosql.Null), c.conditions[i])
+			continue
+		}
+		conditions[i] = c.conditions[i].Typecheck(ctx, env, logicalEnv)
+		if octosql.TypeIntersection(value.Type, conditions[i].Type) == nil {
+			panic(fmt.Errorf("CASE value of type %s can't be compared to WHEN value of type %s", value.Type, conditions[i].Type))
+		}
+	}
+
+	results := make([]physical.Expression, len(c.results))
+	for i := range c.results {
+		results[i] = c.results[i].Typecheck(ctx, env, logicalEnv)
+	}
+
+	var elseResult physical.Expression
+	if c.elseResult != nil {
+		elseResult = c.elseResult.Typecheck(ctx, env, logicalEnv)
+	} else {
+		elseResult = NewConstant(octosql.NewNull()).Typecheck(ctx, env, logicalEnv)
+	}
+
+	outputType := elseResult.Type
+	for _, expr := range results {
+		outputType = octosql.TypeSum(outputType, expr.Type)
+	}
+
+	return physical.Expression{
+		Type:           outputType,
+		ExpressionType: physical.ExpressionTypeCase,
+		Case: &physical.Case{
+			Value:      value,
+			Conditions: conditions,
+			Results:    results,
+			Else:       elseResult,
+		},
+	}
+}
+
 type Cast struct {
 	arg        Expression
 	targetType octosql.Type
@@ -359,7 +583,7 @@ func NewCast(arg Expression, targetType octosql.Type) *Cast {
 func (c *Cast) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
 	expr := c.arg.Typecheck(ctx, env, logicalEnv)
 
-	if rel := c.targetType.Is(expr.Type); rel != octosql.TypeRelationIs {
+	if rel := c.targetType.Is(expr.Type); rel != octosql.TypeRelationIs && !isDecimalRescale(c.targetType, expr.Type) {
 		panic(fmt.Errorf("typecast target type '%s' isn't a subtype of the expression type '%s'", c.targetType.String(), expr.Type.String()))
 	}
 
@@ -373,6 +597,68 @@ func (c *Cast) Typecheck(ctx context.Context, env physical.Environment, logicalE
 	}
 }
 
+// isDecimalRescale checks whether the cast is between decimal types, in which case it rounds the decimal to the target scale.
+func isDecimalRescale(targetType, exprType octosql.Type) bool {
+	if targetType.TypeID != octosql.TypeIDDecimal {
+		return false
+	}
+	_, ok := exprType.DecimalAlternative()
+	return ok
+}
+
+type ParseJSON struct {
+	arg        Expression
+	targetType octosql.Type
+}
+
+func NewParseJSON(arg Expression, targetType octosql.Type) *ParseJSON {
+	return &ParseJSON{arg: arg, targetType: targetType}
+}
+
+func (p *ParseJSON) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) physical.Expression {
+	out := NewFunctionExpression("parse_json", []Expression{p.arg}).Typecheck(ctx, env, logicalEnv)
+	out.Type = octosql.TypeSum(nullableJSONFields(p.targetType), octosql.Null)
+	return out
+}
+
+// nullableJSONFields makes struct fields and list elements nullable, as they may be missing or null in JSON documents.
+func nullableJSONFields(t octosql.Type) octosql.Type {
+	switch t.TypeID {
+	case octosql.TypeIDList:
+		element := octosql.TypeSum(nullableJSONFields(*t.List.Element), octosql.Null)
+		t.List.Element = &element
+	case octosql.TypeIDStruct:
+		fields := make([]octosql.StructField, len(t.Struct.Fields))
+		for i, field := range t.Struct.Fields {
+			fields[i] = octosql.StructField{
+				Name: field.Name,
+				Type: octosql.TypeSum(nullableJSONFields(field.Type), octosql.Null),
+			}
+		}
+		t.Struct.Fields = fields
+	}
+	return t
+}
+
+// fixedDurationConstant converts a constant Interval without months, like INTERVAL 1 DAY, into a Duration constant,
+// so that it can be used where a Duration is expected.
+func fixedDurationConstant(expr physical.Expression) physical.Expression {
+	if expr.ExpressionType != physical.ExpressionTypeConstant || expr.Constant.Value.TypeID != octosql.TypeIDInterval {
+		return expr
+	}
+	duration, ok := expr.Constant.Value.Interval.FixedDuration()
+	if !ok {
+		return expr
+	}
+	return physical.Expression{
+		Type:           octosql.Duration,
+		ExpressionType: physical.ExpressionTypeConstant,
+		Constant: &physical.Constant{
+			Value: octosql.NewDuration(duration),
+		},
+	}
+}
+
 func TypecheckExpression(ctx context.Context, env physical.Environment, logicalEnv Environment, expected octosql.Type, expression Expression) physical.Expression {
 	expr := expression.Typecheck(ctx, env, logicalEnv)
 	rel := expr.Type.Is(expected)
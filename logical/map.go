@@ -3,7 +3,6 @@ package logical
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
@@ -48,12 +47,24 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 				aliases = append(aliases, nil)
 			}
 		} else {
-			for _, field := range source.Schema.Fields {
-				if qualifier := node.starQualifier[i]; qualifier != "" {
-					if !strings.HasPrefix(reverseMapping[field.Name], qualifier+".") {
-						continue
-					}
+			qualifier := node.starQualifier[i]
+			matched := starFields(source.Schema.Fields, reverseMapping, qualifier)
+			if len(matched) == 0 && qualifier != "" {
+				if _, _, ok := splitFieldPath(&VariableMapping{Mapping: mapping}, qualifier); !ok {
+					panic(fmt.Errorf("unknown table or structure: '%s'", qualifier))
 				}
+				// The qualifier is a structure, not a table, so we expand its fields.
+				structExpr := NewVariable(qualifier).Typecheck(ctx, env.WithRecordSchema(source.Schema), logicalEnv.WithRecordUniqueVariableNames(mapping))
+				fieldExprs, names := expandStruct(structExpr)
+				for j := range fieldExprs {
+					expressions = append(expressions, fieldExprs[j])
+					aliases = append(aliases, &names[j])
+					unnests = append(unnests, 0)
+				}
+				continue
+			}
+			for _, fieldIndex := range matched {
+				field := source.Schema.Fields[fieldIndex]
 				expressions = append(expressions, physical.Expression{
 					Type:           field.Type,
 					ExpressionType: physical.ExpressionTypeVariable,
@@ -77,6 +88,8 @@ func (node *Map) Typecheck(ctx context.Context, env physical.Environment, logica
 			name = *aliases[i]
 		} else if expressions[i].ExpressionType == physical.ExpressionTypeVariable {
 			name = reverseMapping[expressions[i].Variable.Name]
+		} else if expressions[i].ExpressionType == physical.ExpressionTypeFieldAccess {
+			name = expressions[i].FieldAccess.Field
 		} else {
 			name = fmt.Sprintf("col_%d", i)
 		}
@@ -25,6 +25,8 @@ type TableValuedFunctionDescriptor struct {
 	// Here we can check the inputs.
 	OutputSchema func(context.Context, physical.Environment, Environment, map[string]TableValuedFunctionTypecheckedArgument) (physical.Schema, map[string]string, error)
 	Materialize  func(context.Context, physical.Environment, map[string]physical.TableValuedFunctionArgument) (execution.Node, error)
+	// ProducesRetractions should be set if the function may produce retractions on its own.
+	ProducesRetractions bool
 }
 
 type TableValuedFunctionArgumentMatcher struct {
@@ -66,7 +68,7 @@ func (arg *TableValuedFunctionArgumentValueExpression) Typecheck(ctx context.Con
 	return physical.TableValuedFunctionArgument{
 		TableValuedFunctionArgumentType: physical.TableValuedFunctionArgumentTypeExpression,
 		Expression: &physical.TableValuedFunctionArgumentExpression{
-			Expression: arg.expression.Typecheck(ctx, env, logicalEnv),
+			Expression: fixedDurationConstant(arg.expression.Typecheck(ctx, env, logicalEnv)),
 		},
 	}
 }
@@ -216,7 +218,8 @@ descriptorLoop:
 				Name:      node.name,
 				Arguments: physicalArguments,
 				FunctionDescriptor: physical.TableValuedFunctionDescriptor{
-					Materialize: descriptor.Materialize,
+					Materialize:         descriptor.Materialize,
+					ProducesRetractions: descriptor.ProducesRetractions,
 				},
 			},
 		}, outputMapping
@@ -258,7 +261,8 @@ descriptorLoop2:
 				Name:      node.name,
 				Arguments: physicalArguments,
 				FunctionDescriptor: physical.TableValuedFunctionDescriptor{
-					Materialize: descriptor.Materialize,
+					Materialize:         descriptor.Materialize,
+					ProducesRetractions: descriptor.ProducesRetractions,
 				},
 			},
 		}, outputMapping
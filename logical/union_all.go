@@ -2,7 +2,9 @@ package logical
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
 
@@ -15,5 +17,32 @@ func NewUnionAll(first, second Node) *UnionAll {
 }
 
 func (node *UnionAll) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
-	panic("implement me")
+	first, mapping := node.first.Typecheck(ctx, env, logicalEnv)
+	second, _ := node.second.Typecheck(ctx, env, logicalEnv)
+
+	if len(first.Schema.Fields) != len(second.Schema.Fields) {
+		panic(fmt.Errorf("union inputs must have the same number of fields, got %d and %d", len(first.Schema.Fields), len(second.Schema.Fields)))
+	}
+
+	// Fields are matched by position, the names come from the first input.
+	fields := make([]physical.SchemaField, len(first.Schema.Fields))
+	for i := range first.Schema.Fields {
+		fields[i] = physical.SchemaField{
+			Name: first.Schema.Fields[i].Name,
+			Type: octosql.TypeSum(first.Schema.Fields[i].Type, second.Schema.Fields[i].Type),
+		}
+	}
+
+	timeField := -1
+	if first.Schema.TimeField != -1 && first.Schema.TimeField == second.Schema.TimeField {
+		timeField = first.Schema.TimeField
+	}
+
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, timeField),
+		NodeType: physical.NodeTypeUnionAll,
+		UnionAll: &physical.UnionAll{
+			Inputs: []physical.Node{first, second},
+		},
+	}, mapping
 }
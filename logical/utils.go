@@ -280,6 +280,11 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			return expr1.name == expr2.name
 		}
 
+	case *FieldAccess:
+		if expr2, ok := expr2.(*FieldAccess); ok {
+			return expr1.field == expr2.field && EqualExpressions(expr1.expr, expr2.expr)
+		}
+
 	case *Tuple:
 		if expr2, ok := expr2.(*Tuple); ok {
 			if len(expr1.expressions) != len(expr2.expressions) {
@@ -309,6 +314,28 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			return true
 		}
 
+	case *Case:
+		if expr2, ok := expr2.(*Case); ok {
+			if (expr1.value == nil) != (expr2.value == nil) ||
+				(expr1.value != nil && !EqualExpressions(expr1.value, expr2.value)) {
+				return false
+			}
+			if (expr1.elseResult == nil) != (expr2.elseResult == nil) ||
+				(expr1.elseResult != nil && !EqualExpressions(expr1.elseResult, expr2.elseResult)) {
+				return false
+			}
+			if len(expr1.conditions) != len(expr2.conditions) {
+				return false
+			}
+			for i := range expr1.conditions {
+				if !EqualExpressions(expr1.conditions[i], expr2.conditions[i]) ||
+					!EqualExpressions(expr1.results[i], expr2.results[i]) {
+					return false
+				}
+			}
+			return true
+		}
+
 	case *Cast:
 		if expr2, ok := expr2.(*Cast); ok {
 			if !expr1.targetType.Equals(expr2.targetType) {
@@ -317,6 +344,24 @@ func EqualExpressions(expr1, expr2 Expression) bool {
 			return EqualExpressions(expr1.arg, expr2.arg)
 		}
 
+	case *ParseJSON:
+		if expr2, ok := expr2.(*ParseJSON); ok {
+			if !expr1.targetType.Equals(expr2.targetType) {
+				return false
+			}
+			return EqualExpressions(expr1.arg, expr2.arg)
+		}
+
+	case *Lambda:
+		if expr2, ok := expr2.(*Lambda); ok {
+			return expr1.parameter == expr2.parameter && EqualExpressions(expr1.body, expr2.body)
+		}
+
+	case *ListLambda:
+		if expr2, ok := expr2.(*ListLambda); ok {
+			return expr1.filter == expr2.filter && EqualExpressions(expr1.list, expr2.list) && EqualExpressions(expr1.lambda, expr2.lambda)
+		}
+
 	case *Coalesce:
 		if expr2, ok := expr2.(*Coalesce); ok {
 			if len(expr1.args) != len(expr2.args) {
@@ -0,0 +1,139 @@
+package logical
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+// WindowFieldNamePrefix is the prefix of the names of window function result fields.
+// Those fields aren't picked up by star expressions.
+const WindowFieldNamePrefix = "$window_"
+
+type Window struct {
+	source            Node
+	partitionBy       []Expression
+	orderByKeyExprs   []Expression
+	orderByDirections []OrderDirection
+	function          string
+	arguments         []Expression
+	fieldName         string
+}
+
+func NewWindow(source Node, partitionBy []Expression, orderByKeyExprs []Expression, orderByDirections []OrderDirection, function string, arguments []Expression, fieldName string) *Window {
+	return &Window{
+		source:            source,
+		partitionBy:       partitionBy,
+		orderByKeyExprs:   orderByKeyExprs,
+		orderByDirections: orderByDirections,
+		function:          function,
+		arguments:         arguments,
+		fieldName:         fieldName,
+	}
+}
+
+func (node *Window) Typecheck(ctx context.Context, env physical.Environment, logicalEnv Environment) (physical.Node, map[string]string) {
+	source, mapping := node.source.Typecheck(ctx, env, logicalEnv)
+	recordEnv := env.WithRecordSchema(source.Schema)
+	recordLogicalEnv := logicalEnv.WithRecordUniqueVariableNames(mapping)
+
+	partitionBy := make([]physical.Expression, len(node.partitionBy))
+	for i := range node.partitionBy {
+		partitionBy[i] = node.partitionBy[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+	orderBy := make([]physical.Expression, len(node.orderByKeyExprs))
+	for i := range node.orderByKeyExprs {
+		orderBy[i] = node.orderByKeyExprs[i].Typecheck(ctx, recordEnv, recordLogicalEnv)
+	}
+
+	function := physical.WindowFunction{
+		Name: node.function,
+	}
+	var outputType octosql.Type
+	switch node.function {
+	case "row_number", "rank", "dense_rank":
+		if len(node.arguments) != 0 {
+			panic(fmt.Errorf("%s takes no arguments, got %d", node.function, len(node.arguments)))
+		}
+		outputType = octosql.Int
+	case "lag", "lead":
+		if len(node.arguments) < 1 || len(node.arguments) > 3 {
+			panic(fmt.Errorf("%s takes 1 to 3 arguments, got %d", node.function, len(node.arguments)))
+		}
+		value := node.arguments[0].Typecheck(ctx, recordEnv, recordLogicalEnv)
+
+		function.Offset = 1
+		if len(node.arguments) > 1 {
+			offset := TypecheckExpression(ctx, recordEnv, recordLogicalEnv, octosql.Int, node.arguments[1])
+			if offset.ExpressionType != physical.ExpressionTypeConstant {
+				panic(fmt.Errorf("%s offset must be a constant integer, is: %s", node.function, offset.ExpressionType))
+			}
+			if offset.Constant.Value.Int < 0 {
+				panic(fmt.Errorf("%s offset must not be negative, is: %d", node.function, offset.Constant.Value.Int))
+			}
+			function.Offset = offset.Constant.Value.Int
+		}
+
+		defaultValue := physical.Expression{
+			Type:           octosql.Null,
+			ExpressionType: physical.ExpressionTypeConstant,
+			Constant: &physical.Constant{
+				Value: octosql.NewNull(),
+			},
+		}
+		if len(node.arguments) > 2 {
+			defaultValue = node.arguments[2].Typecheck(ctx, recordEnv, recordLogicalEnv)
+		}
+
+		function.Arguments = []physical.Expression{value, defaultValue}
+		outputType = octosql.TypeSum(value.Type, defaultValue.Type)
+	default:
+		if _, ok := env.Aggregates[node.function]; !ok {
+			panic(fmt.Errorf("unknown window function: %s", node.function))
+		}
+		if len(node.arguments) == 0 {
+			panic(fmt.Errorf("aggregate %s used as window function requires an argument", node.function))
+		}
+		var argumentExpr Expression = node.arguments[0]
+		if len(node.arguments) > 1 {
+			// Aggregates with multiple arguments receive them as a tuple.
+			argumentExpr = NewTuple(node.arguments)
+		}
+		aggregate, argument := typecheckAggregate(env, node.function, argumentExpr.Typecheck(ctx, recordEnv, recordLogicalEnv))
+		if aggregate.AggregateDescriptor.RequiresEventTime {
+			panic(fmt.Errorf("aggregate %s depends on the event time, so it can't be used as a window function", node.function))
+		}
+		function.Arguments = []physical.Expression{argument}
+		function.Aggregate = &aggregate
+		outputType = aggregate.OutputType
+	}
+
+	fields := make([]physical.SchemaField, len(source.Schema.Fields)+1)
+	copy(fields, source.Schema.Fields)
+	unique := logicalEnv.GetUnique(node.fieldName)
+	fields[len(fields)-1] = physical.SchemaField{
+		Name: unique,
+		Type: outputType,
+	}
+
+	outMapping := make(map[string]string, len(mapping)+1)
+	for k, v := range mapping {
+		outMapping[k] = v
+	}
+	outMapping[node.fieldName] = unique
+
+	// Records are retracted and sent again when their window function value changes, so event times aren't preserved.
+	return physical.Node{
+		Schema:   physical.NewSchema(fields, -1),
+		NodeType: physical.NodeTypeWindow,
+		Window: &physical.Window{
+			Source:               source,
+			PartitionBy:          partitionBy,
+			OrderBy:              orderBy,
+			DirectionMultipliers: DirectionsToMultipliers(node.orderByDirections),
+			Function:             function,
+		},
+	}, outMapping
+}
@@ -0,0 +1,195 @@
+package octosql
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxDecimalPrecision is the maximum precision of decimal types. Arithmetic result types are capped at it.
+const MaxDecimalPrecision = 38
+
+// DecimalNumber is an exact decimal number, the unscaled integer divided by 10 to the power of scale.
+// Decimals are immutable, all operations return new values.
+type DecimalNumber struct {
+	Unscaled *big.Int
+	Scale    int
+}
+
+func DecimalFromInt(value int) DecimalNumber {
+	return DecimalNumber{Unscaled: big.NewInt(int64(value))}
+}
+
+// DecimalFromFloat uses the shortest decimal representation of the float, so 0.1 becomes exactly 0.1.
+func DecimalFromFloat(value float64) (DecimalNumber, error) {
+	return ParseDecimal(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// ParseDecimal parses a number like -123.45.
+func ParseDecimal(str string) (DecimalNumber, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(str, "-"), "+")
+	integerPart, fractionalPart := digits, ""
+	if dot := strings.IndexByte(digits, '.'); dot != -1 {
+		integerPart, fractionalPart = digits[:dot], digits[dot+1:]
+	}
+	if integerPart == "" && fractionalPart == "" {
+		return DecimalNumber{}, fmt.Errorf("invalid decimal: '%s'", str)
+	}
+	for _, part := range []string{integerPart, fractionalPart} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return DecimalNumber{}, fmt.Errorf("invalid decimal: '%s'", str)
+			}
+		}
+	}
+
+	unscaled, _ := new(big.Int).SetString(integerPart+fractionalPart, 10)
+	if strings.HasPrefix(str, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return DecimalNumber{Unscaled: unscaled, Scale: len(fractionalPart)}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Rescale changes the scale of the decimal, rounding half away from zero if digits are dropped.
+func (d DecimalNumber) Rescale(scale int) DecimalNumber {
+	if scale >= d.Scale {
+		return DecimalNumber{Unscaled: new(big.Int).Mul(d.Unscaled, pow10(scale-d.Scale)), Scale: scale}
+	}
+	return DecimalNumber{Unscaled: divRound(d.Unscaled, pow10(d.Scale-scale)), Scale: scale}
+}
+
+// divRound divides, rounding half away from zero.
+func divRound(x, y *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(x, y, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if (x.Sign() < 0) != (y.Sign() < 0) {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// alignScales returns the unscaled values of both decimals at the larger of their scales.
+func alignScales(d, other DecimalNumber) (*big.Int, *big.Int, int) {
+	if d.Scale > other.Scale {
+		return d.Unscaled, other.Rescale(d.Scale).Unscaled, d.Scale
+	}
+	return d.Rescale(other.Scale).Unscaled, other.Unscaled, other.Scale
+}
+
+func (d DecimalNumber) Add(other DecimalNumber) DecimalNumber {
+	left, right, scale := alignScales(d, other)
+	return DecimalNumber{Unscaled: new(big.Int).Add(left, right), Scale: scale}
+}
+
+func (d DecimalNumber) Sub(other DecimalNumber) DecimalNumber {
+	left, right, scale := alignScales(d, other)
+	return DecimalNumber{Unscaled: new(big.Int).Sub(left, right), Scale: scale}
+}
+
+func (d DecimalNumber) Mul(other DecimalNumber) DecimalNumber {
+	return DecimalNumber{Unscaled: new(big.Int).Mul(d.Unscaled, other.Unscaled), Scale: d.Scale + other.Scale}
+}
+
+// Div divides the decimals, rounding the result to the given scale. The divisor must not be zero.
+func (d DecimalNumber) Div(other DecimalNumber, scale int) DecimalNumber {
+	// d / other = (d.Unscaled * 10^(scale + other.Scale - d.Scale)) / other.Unscaled, at the given scale.
+	shift := scale + other.Scale - d.Scale
+	numerator, denominator := new(big.Int).Set(d.Unscaled), new(big.Int).Set(other.Unscaled)
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+	return DecimalNumber{Unscaled: divRound(numerator, denominator), Scale: scale}
+}
+
+func (d DecimalNumber) Neg() DecimalNumber {
+	return DecimalNumber{Unscaled: new(big.Int).Neg(d.Unscaled), Scale: d.Scale}
+}
+
+func (d DecimalNumber) Sign() int {
+	return d.Unscaled.Sign()
+}
+
+// Compare compares the numeric values, regardless of scale.
+func (d DecimalNumber) Compare(other DecimalNumber) int {
+	left, right, _ := alignScales(d, other)
+	return left.Cmp(right)
+}
+
+// Precision is the number of digits required to represent the decimal at its scale.
+func (d DecimalNumber) Precision() int {
+	digits := len(new(big.Int).Abs(d.Unscaled).String())
+	if digits < d.Scale+1 {
+		digits = d.Scale + 1
+	}
+	return digits
+}
+
+func (d DecimalNumber) Float() float64 {
+	out, _ := strconv.ParseFloat(d.String(), 64)
+	return out
+}
+
+// Int truncates the decimal towards zero.
+func (d DecimalNumber) Int() int {
+	return int(new(big.Int).Quo(d.Unscaled, pow10(d.Scale)).Int64())
+}
+
+func (d DecimalNumber) String() string {
+	digits := new(big.Int).Abs(d.Unscaled).String()
+	if d.Scale > 0 {
+		if len(digits) <= d.Scale {
+			digits = strings.Repeat("0", d.Scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.Scale] + "." + digits[len(digits)-d.Scale:]
+	}
+	if d.Unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// NewDecimalType returns a decimal type with the given precision and scale, capping the precision at MaxDecimalPrecision.
+func NewDecimalType(precision, scale int) Type {
+	if scale > MaxDecimalPrecision {
+		scale = MaxDecimalPrecision
+	}
+	if precision > MaxDecimalPrecision {
+		precision = MaxDecimalPrecision
+	}
+	if precision < scale+1 && scale < MaxDecimalPrecision {
+		precision = scale + 1
+	}
+	t := Type{TypeID: TypeIDDecimal}
+	t.Decimal.Precision = precision
+	t.Decimal.Scale = scale
+	return t
+}
+
+// DecimalAlternative returns the decimal type among the alternatives of the type.
+func (t Type) DecimalAlternative() (Type, bool) {
+	for _, alternative := range t.possiblePrimitiveTypes() {
+		if alternative.TypeID == TypeIDDecimal {
+			return alternative, true
+		}
+	}
+	return Type{}, false
+}
+
+// ToType rounds the decimal to the scale of the decimal type. ok is false if the decimal doesn't fit its precision.
+func (d DecimalNumber) ToType(t Type) (out DecimalNumber, ok bool) {
+	if t.Decimal.Precision == 0 {
+		return d, true
+	}
+	out = d.Rescale(t.Decimal.Scale)
+	return out, out.Precision() <= t.Decimal.Precision
+}
@@ -0,0 +1,144 @@
+package octosql
+
+import (
+	"fmt"
+	"testing"
+)
+
+func mustParseDecimal(t *testing.T, str string) DecimalNumber {
+	d, err := ParseDecimal(str)
+	if err != nil {
+		t.Fatalf("ParseDecimal(%s) error = %s", str, err)
+	}
+	return d
+}
+
+func TestDecimalRescale(t *testing.T) {
+	tests := []struct {
+		value string
+		scale int
+		want  string
+	}{
+		{value: "1.24", scale: 1, want: "1.2"},
+		{value: "1.25", scale: 1, want: "1.3"},
+		{value: "1.26", scale: 1, want: "1.3"},
+		{value: "-1.24", scale: 1, want: "-1.2"},
+		{value: "-1.25", scale: 1, want: "-1.3"},
+		{value: "-1.26", scale: 1, want: "-1.3"},
+		{value: "0.5", scale: 0, want: "1"},
+		{value: "-0.5", scale: 0, want: "-1"},
+		{value: "-0.49", scale: 0, want: "0"},
+		{value: "2.5", scale: 3, want: "2.500"},
+		{value: "-0.05", scale: 4, want: "-0.0500"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := mustParseDecimal(t, tt.value).Rescale(tt.scale).String(); got != tt.want {
+				t.Errorf("%s.Rescale(%d) = %s, want %s", tt.value, tt.scale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalArithmeticScale(t *testing.T) {
+	tests := []struct {
+		op          string
+		left, right string
+		want        string
+		wantScale   int
+	}{
+		{op: "+", left: "1.5", right: "2.25", want: "3.75", wantScale: 2},
+		{op: "+", left: "1.50", right: "-1.5", want: "0.00", wantScale: 2},
+		{op: "-", left: "10", right: "0.001", want: "9.999", wantScale: 3},
+		{op: "*", left: "1.5", right: "2.25", want: "3.375", wantScale: 3},
+		{op: "*", left: "-0.10", right: "0.10", want: "-0.0100", wantScale: 4},
+		{op: "/", left: "1", right: "3", want: "0.33", wantScale: 2},
+		{op: "/", left: "2", right: "3", want: "0.67", wantScale: 2},
+		{op: "/", left: "-2", right: "3", want: "-0.67", wantScale: 2},
+		{op: "/", left: "-1.25", right: "0.5", want: "-2.50", wantScale: 2},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			left, right := mustParseDecimal(t, tt.left), mustParseDecimal(t, tt.right)
+			var got DecimalNumber
+			switch tt.op {
+			case "+":
+				got = left.Add(right)
+			case "-":
+				got = left.Sub(right)
+			case "*":
+				got = left.Mul(right)
+			case "/":
+				got = left.Div(right, tt.wantScale)
+			}
+			if got.String() != tt.want || got.Scale != tt.wantScale {
+				t.Errorf("%s %s %s = %s (scale %d), want %s (scale %d)", tt.left, tt.op, tt.right, got, got.Scale, tt.want, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	tests := []struct {
+		left, right string
+		want        int
+	}{
+		{left: "1.0", right: "1.000", want: 0},
+		{left: "-1.01", right: "-1.1", want: 1},
+		{left: "0.15", right: "0.150001", want: -1},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := mustParseDecimal(t, tt.left).Compare(mustParseDecimal(t, tt.right)); got != tt.want {
+				t.Errorf("%s.Compare(%s) = %d, want %d", tt.left, tt.right, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalToType(t *testing.T) {
+	tests := []struct {
+		value  string
+		t      Type
+		want   string
+		wantOk bool
+	}{
+		{value: "123.456", t: NewDecimalType(5, 2), want: "123.46", wantOk: true},
+		{value: "-123.455", t: NewDecimalType(5, 2), want: "-123.46", wantOk: true},
+		{value: "999.995", t: NewDecimalType(5, 2), wantOk: false},
+		{value: "1234", t: NewDecimalType(5, 2), wantOk: false},
+		{value: "0.001", t: NewDecimalType(3, 2), want: "0.00", wantOk: true},
+		{value: "12345678901234567890.5", t: Decimal, want: "12345678901234567890.5", wantOk: true},
+		{value: "1" + fmt.Sprintf("%038d", 0), t: NewDecimalType(100, 0), wantOk: false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			got, ok := mustParseDecimal(t, tt.value).ToType(tt.t)
+			if ok != tt.wantOk {
+				t.Fatalf("%s.ToType(%s) ok = %t, want %t", tt.value, tt.t, ok, tt.wantOk)
+			}
+			if ok && got.String() != tt.want {
+				t.Errorf("%s.ToType(%s) = %s, want %s", tt.value, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalPrecision(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "0", want: 1},
+		{value: "0.05", want: 3},
+		{value: "-123.45", want: 5},
+		{value: "1" + fmt.Sprintf("%038d", 0), want: 39},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if got := mustParseDecimal(t, tt.value).Precision(); got != tt.want {
+				t.Errorf("%s.Precision() = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
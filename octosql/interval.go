@@ -0,0 +1,181 @@
+package octosql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// CalendarInterval is an amount of time which may contain months and days, whose length depends on the date they're added to.
+// Adding months keeps the day of the month, unless it doesn't exist in the resulting month, in which case its last day is used.
+type CalendarInterval struct {
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+func (i CalendarInterval) Add(other CalendarInterval) CalendarInterval {
+	return CalendarInterval{
+		Months:   i.Months + other.Months,
+		Days:     i.Days + other.Days,
+		Duration: i.Duration + other.Duration,
+	}
+}
+
+func (i CalendarInterval) Negate() CalendarInterval {
+	return CalendarInterval{
+		Months:   -i.Months,
+		Days:     -i.Days,
+		Duration: -i.Duration,
+	}
+}
+
+// Multiply multiplies the interval by the factor.
+// Fractional months are carried over into days, assuming 30 day months, and fractional days into the duration.
+func (i CalendarInterval) Multiply(factor float64) CalendarInterval {
+	months := float64(i.Months) * factor
+	wholeMonths := math.Trunc(months)
+	days := float64(i.Days)*factor + (months-wholeMonths)*30
+	wholeDays := math.Trunc(days)
+	return CalendarInterval{
+		Months:   int(wholeMonths),
+		Days:     int(wholeDays),
+		Duration: time.Duration(float64(i.Duration)*factor + (days-wholeDays)*float64(24*time.Hour)),
+	}
+}
+
+// AddTo adds the interval to the time, in the time's location.
+func (i CalendarInterval) AddTo(t time.Time) time.Time {
+	if i.Months != 0 {
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+		firstOfMonth := time.Date(year, month+time.Month(i.Months), 1, hour, minute, second, t.Nanosecond(), t.Location())
+		if lastDay := firstOfMonth.AddDate(0, 1, -1).Day(); day > lastDay {
+			day = lastDay
+		}
+		t = firstOfMonth.AddDate(0, 0, day-1)
+	}
+	return t.AddDate(0, 0, i.Days).Add(i.Duration)
+}
+
+// FixedDuration returns the length of the interval assuming 24 hour days. It's only possible if the interval contains no months.
+func (i CalendarInterval) FixedDuration() (time.Duration, bool) {
+	if i.Months != 0 {
+		return 0, false
+	}
+	return time.Duration(i.Days)*24*time.Hour + i.Duration, true
+}
+
+// approximateDuration is the length of the interval assuming 30 day months and 24 hour days.
+func (i CalendarInterval) approximateDuration() time.Duration {
+	return time.Duration(i.Months)*30*24*time.Hour + time.Duration(i.Days)*24*time.Hour + i.Duration
+}
+
+// Compare orders intervals by their approximate length, and then by their components.
+func (i CalendarInterval) Compare(other CalendarInterval) int {
+	compare := func(a, b int64) int {
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	}
+	if out := compare(int64(i.approximateDuration()), int64(other.approximateDuration())); out != 0 {
+		return out
+	}
+	if out := compare(int64(i.Months), int64(other.Months)); out != 0 {
+		return out
+	}
+	if out := compare(int64(i.Days), int64(other.Days)); out != 0 {
+		return out
+	}
+	return compare(int64(i.Duration), int64(other.Duration))
+}
+
+func (i CalendarInterval) String() string {
+	var parts []string
+	if years := i.Months / 12; years != 0 {
+		parts = append(parts, pluralize(years, "year"))
+	}
+	if months := i.Months % 12; months != 0 {
+		parts = append(parts, pluralize(months, "month"))
+	}
+	if i.Days != 0 {
+		parts = append(parts, pluralize(i.Days, "day"))
+	}
+	if i.Duration != 0 || len(parts) == 0 {
+		parts = append(parts, i.Duration.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func pluralize(count int, unit string) string {
+	if count == 1 || count == -1 {
+		return fmt.Sprintf("%d %s", count, unit)
+	}
+	return fmt.Sprintf("%d %ss", count, unit)
+}
+
+// ParseInterval parses intervals like "1 year 2 months 3 days 4 hours".
+// Amounts may be fractional and negative. The String representation of intervals is accepted as well.
+func ParseInterval(str string) (CalendarInterval, error) {
+	var out CalendarInterval
+	parts := strings.Fields(str)
+	for i := 0; i < len(parts); i++ {
+		if strings.IndexFunc(parts[i], unicode.IsLetter) != -1 {
+			duration, err := time.ParseDuration(parts[i])
+			if err != nil {
+				return CalendarInterval{}, fmt.Errorf("invalid duration '%s' in interval '%s'", parts[i], str)
+			}
+			out.Duration += duration
+			continue
+		}
+		if i == len(parts)-1 {
+			return CalendarInterval{}, fmt.Errorf("missing unit after '%s' in interval '%s'", parts[i], str)
+		}
+		amount, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return CalendarInterval{}, fmt.Errorf("invalid amount '%s' in interval '%s'", parts[i], str)
+		}
+		i++
+		unit, err := calendarIntervalUnit(parts[i])
+		if err != nil {
+			return CalendarInterval{}, err
+		}
+		out = out.Add(unit.Multiply(amount))
+	}
+	return out, nil
+}
+
+func calendarIntervalUnit(unit string) (CalendarInterval, error) {
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "nanosecond":
+		return CalendarInterval{Duration: time.Nanosecond}, nil
+	case "microsecond":
+		return CalendarInterval{Duration: time.Microsecond}, nil
+	case "millisecond":
+		return CalendarInterval{Duration: time.Millisecond}, nil
+	case "second":
+		return CalendarInterval{Duration: time.Second}, nil
+	case "minute":
+		return CalendarInterval{Duration: time.Minute}, nil
+	case "hour":
+		return CalendarInterval{Duration: time.Hour}, nil
+	case "day":
+		return CalendarInterval{Days: 1}, nil
+	case "week":
+		return CalendarInterval{Days: 7}, nil
+	case "month":
+		return CalendarInterval{Months: 1}, nil
+	case "quarter":
+		return CalendarInterval{Months: 3}, nil
+	case "year":
+		return CalendarInterval{Months: 12}, nil
+	default:
+		return CalendarInterval{}, fmt.Errorf("invalid interval unit: %s", unit)
+	}
+}
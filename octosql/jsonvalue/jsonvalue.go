@@ -0,0 +1,129 @@
+package jsonvalue
+
+import (
+	"time"
+
+	"github.com/cube2222/octosql/datasources/inference"
+	"github.com/cube2222/octosql/octosql"
+)
+
+// GetOctoSQLValue converts a decoded JSON value to a value of the given type, ok is false if it doesn't match the type.
+func GetOctoSQLValue(t octosql.Type, value interface{}, timeFormat string) (out octosql.Value, ok bool) {
+	switch t.TypeID {
+	case octosql.TypeIDNull:
+		if value == nil {
+			return octosql.NewNull(), true
+		}
+	case octosql.TypeIDInt:
+		switch value := value.(type) {
+		case int:
+			return octosql.NewInt(value), true
+		case float64:
+			// Numbers are decoded as floats.
+			if value == float64(int(value)) {
+				return octosql.NewInt(int(value)), true
+			}
+		}
+	case octosql.TypeIDFloat:
+		if value, ok := value.(float64); ok {
+			return octosql.NewFloat(value), true
+		}
+	case octosql.TypeIDBoolean:
+		if value, ok := value.(bool); ok {
+			return octosql.NewBoolean(value), true
+		}
+	case octosql.TypeIDString:
+		if value, ok := value.(string); ok {
+			return octosql.NewString(value), true
+		}
+	case octosql.TypeIDTime:
+		switch value := value.(type) {
+		case string:
+			if parsed, err := inference.ParseTime(timeFormat, value); err == nil {
+				return octosql.NewTime(parsed), true
+			}
+		case float64:
+			if parsed, ok := inference.TimeFromNumber(timeFormat, value); ok {
+				return octosql.NewTime(parsed), true
+			}
+		}
+	case octosql.TypeIDDate:
+		if value, ok := value.(string); ok {
+			if parsed, err := inference.ParseDate(timeFormat, value); err == nil {
+				return octosql.NewDate(parsed), true
+			}
+		}
+	case octosql.TypeIDDuration:
+		if value, ok := value.(string); ok {
+			if parsed, err := time.ParseDuration(value); err == nil {
+				return octosql.NewDuration(parsed), true
+			}
+		}
+	case octosql.TypeIDInterval:
+		if value, ok := value.(string); ok {
+			if parsed, err := octosql.ParseInterval(value); err == nil {
+				return octosql.NewInterval(parsed), true
+			}
+		}
+	case octosql.TypeIDDecimal:
+		var decimal octosql.DecimalNumber
+		var err error
+		switch value := value.(type) {
+		case int:
+			decimal = octosql.DecimalFromInt(value)
+		case float64:
+			decimal, err = octosql.DecimalFromFloat(value)
+		case string:
+			decimal, err = octosql.ParseDecimal(value)
+		default:
+			return octosql.ZeroValue, false
+		}
+		if err == nil {
+			if decimal, ok := decimal.ToType(t); ok {
+				return octosql.NewDecimal(decimal), true
+			}
+		}
+	case octosql.TypeIDList:
+		if value, ok := value.([]interface{}); ok {
+			elements := make([]octosql.Value, len(value))
+			outOk := true
+			for i := range elements {
+				curElement, curOk := GetOctoSQLValue(*t.List.Element, value[i], timeFormat)
+				elements[i] = curElement
+				outOk = outOk && curOk
+			}
+			return octosql.NewList(elements), outOk
+		}
+	case octosql.TypeIDStruct:
+		if value, ok := value.(map[string]interface{}); ok {
+			values := make([]octosql.Value, len(t.Struct.Fields))
+			outOk := true
+			for i, field := range t.Struct.Fields {
+				curValue, curOk := GetOctoSQLValue(field.Type, value[field.Name], timeFormat)
+				values[i] = curValue
+				outOk = outOk && curOk
+			}
+			return octosql.NewStruct(values), outOk
+		}
+	case octosql.TypeIDTuple:
+		if value, ok := value.([]interface{}); ok {
+			elements := make([]octosql.Value, len(value))
+			outOk := true
+			for i := range elements {
+				curElement, curOk := GetOctoSQLValue(t.Tuple.Elements[i], value[i], timeFormat)
+				elements[i] = curElement
+				outOk = outOk && curOk
+			}
+			return octosql.NewList(elements), outOk
+		}
+	case octosql.TypeIDUnion:
+		for _, alternative := range t.Union.Alternatives {
+			v, ok := GetOctoSQLValue(alternative, value, timeFormat)
+			if ok {
+				return v, true
+			}
+		}
+	}
+
+	return octosql.ZeroValue, false
+}
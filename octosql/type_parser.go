@@ -0,0 +1,181 @@
+package octosql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseType parses a type in the format produced by Type.String, like "{a: Int; b: [String | NULL]} | NULL".
+func ParseType(str string) (Type, error) {
+	p := &typeParser{input: []rune(str)}
+	t, err := p.parseUnion()
+	if err != nil {
+		return Type{}, err
+	}
+	p.skipWhitespace()
+	if p.pos != len(p.input) {
+		return Type{}, fmt.Errorf("unexpected '%s' at position %d in type '%s'", string(p.input[p.pos:]), p.pos, str)
+	}
+	return t, nil
+}
+
+type typeParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *typeParser) skipWhitespace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *typeParser) consume(r rune) bool {
+	p.skipWhitespace()
+	if p.pos < len(p.input) && p.input[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *typeParser) parseIdentifier() string {
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.input) && (unicode.IsLetter(p.input[p.pos]) || unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '_') {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+func (p *typeParser) parseNumber() (int, error) {
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.input) && unicode.IsDigit(p.input[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, fmt.Errorf("expected number at position %d", p.pos)
+	}
+	return strconv.Atoi(string(p.input[start:p.pos]))
+}
+
+func (p *typeParser) parseUnion() (Type, error) {
+	out, err := p.parsePrimary()
+	if err != nil {
+		return Type{}, err
+	}
+	for p.consume('|') {
+		alternative, err := p.parsePrimary()
+		if err != nil {
+			return Type{}, err
+		}
+		out = TypeSum(out, alternative)
+	}
+	return out, nil
+}
+
+func (p *typeParser) parsePrimary() (Type, error) {
+	switch {
+	case p.consume('['):
+		element, err := p.parseUnion()
+		if err != nil {
+			return Type{}, err
+		}
+		if !p.consume(']') {
+			return Type{}, fmt.Errorf("expected ']' at position %d", p.pos)
+		}
+		return Type{
+			TypeID: TypeIDList,
+			List: struct {
+				Element *Type
+			}{
+				Element: &element,
+			},
+		}, nil
+
+	case p.consume('{'):
+		var fields []StructField
+		if p.consume('}') {
+			return Type{TypeID: TypeIDStruct}, nil
+		}
+		for {
+			name := p.parseIdentifier()
+			if name == "" {
+				return Type{}, fmt.Errorf("expected field name at position %d", p.pos)
+			}
+			if !p.consume(':') {
+				return Type{}, fmt.Errorf("expected ':' after field name %s", name)
+			}
+			fieldType, err := p.parseUnion()
+			if err != nil {
+				return Type{}, err
+			}
+			fields = append(fields, StructField{
+				Name: name,
+				Type: fieldType,
+			})
+			if p.consume('}') {
+				break
+			}
+			if !p.consume(';') {
+				return Type{}, fmt.Errorf("expected ';' or '}' at position %d", p.pos)
+			}
+		}
+		return Type{
+			TypeID: TypeIDStruct,
+			Struct: struct{ Fields []StructField }{Fields: fields},
+		}, nil
+	}
+
+	name := p.parseIdentifier()
+	switch strings.ToLower(name) {
+	case "null":
+		return Null, nil
+	case "int":
+		return Int, nil
+	case "float":
+		return Float, nil
+	case "boolean":
+		return Boolean, nil
+	case "string":
+		return String, nil
+	case "time":
+		return Time, nil
+	case "date":
+		return Date, nil
+	case "duration":
+		return Duration, nil
+	case "interval":
+		return Interval, nil
+	case "decimal":
+		if !p.consume('(') {
+			return Decimal, nil
+		}
+		precision, err := p.parseNumber()
+		if err != nil {
+			return Type{}, err
+		}
+		if !p.consume(',') {
+			return Type{}, fmt.Errorf("expected ',' at position %d", p.pos)
+		}
+		scale, err := p.parseNumber()
+		if err != nil {
+			return Type{}, err
+		}
+		if !p.consume(')') {
+			return Type{}, fmt.Errorf("expected ')' at position %d", p.pos)
+		}
+		if precision < 1 || precision > MaxDecimalPrecision || scale > precision {
+			return Type{}, fmt.Errorf("invalid decimal precision and scale: %d, %d", precision, scale)
+		}
+		return NewDecimalType(precision, scale), nil
+	case "any":
+		return Any, nil
+	case "":
+		return Type{}, fmt.Errorf("expected type at position %d", p.pos)
+	}
+	return Type{}, fmt.Errorf("unknown type: %s", name)
+}
@@ -20,6 +20,10 @@ const (
 	TypeIDTuple
 	TypeIDUnion
 	TypeIDAny // TODO: Remove this type?
+	// New types are added at the end, as type IDs are part of the plugin protocol.
+	TypeIDInterval
+	TypeIDDecimal
+	TypeIDDate
 )
 
 type Type struct {
@@ -31,7 +35,14 @@ type Type struct {
 	Str      struct{}
 	Time     struct{}
 	Duration struct{}
-	List     struct {
+	Interval struct{}
+	Date     struct{}
+	Decimal  struct {
+		// Precision is the total number of digits and Scale the number of digits after the decimal point.
+		// Zero precision means any decimal.
+		Precision, Scale int
+	}
+	List struct {
 		Element *Type
 	}
 	Struct struct {
@@ -140,6 +151,20 @@ func (t Type) Is(other Type) TypeRelation {
 		}
 		return TypeRelationIs
 	}
+	if t.TypeID == TypeIDDecimal && other.TypeID == TypeIDDecimal {
+		if other.Decimal.Precision == 0 {
+			return TypeRelationIs
+		}
+		if t.Decimal.Precision == 0 {
+			return TypeRelationMaybe
+		}
+		if t.Decimal.Scale <= other.Decimal.Scale &&
+			t.Decimal.Precision-t.Decimal.Scale <= other.Decimal.Precision-other.Decimal.Scale {
+			return TypeRelationIs
+		}
+		// The values may still be small enough.
+		return TypeRelationMaybe
+	}
 	if t.TypeID == other.TypeID {
 		return TypeRelationIs
 	}
@@ -162,6 +187,15 @@ func (t Type) String() string {
 		return "Time"
 	case TypeIDDuration:
 		return "Duration"
+	case TypeIDInterval:
+		return "Interval"
+	case TypeIDDate:
+		return "Date"
+	case TypeIDDecimal:
+		if t.Decimal.Precision == 0 {
+			return "Decimal"
+		}
+		return fmt.Sprintf("Decimal(%d, %d)", t.Decimal.Precision, t.Decimal.Scale)
 	case TypeIDList:
 		if t.List.Element == nil {
 			return "[]"
@@ -202,17 +236,31 @@ var (
 	String   = Type{TypeID: TypeIDString}
 	Time     = Type{TypeID: TypeIDTime}
 	Duration = Type{TypeID: TypeIDDuration}
+	Interval = Type{TypeID: TypeIDInterval}
+	Decimal  = Type{TypeID: TypeIDDecimal}
+	Date     = Type{TypeID: TypeIDDate}
 	Any      = Type{TypeID: TypeIDAny}
 )
 
 func TypeSum(t1, t2 Type) Type {
-	// TODO: For field access, field.* would be nice, to get all fields out of a structure.
 	if t1.Is(t2) == TypeRelationIs {
 		return t2
 	}
 	if t2.Is(t1) == TypeRelationIs {
 		return t1
 	}
+	if t1.TypeID == TypeIDDecimal && t2.TypeID == TypeIDDecimal {
+		// Sum of constrained decimals is a decimal which fits both.
+		scale := t1.Decimal.Scale
+		if t2.Decimal.Scale > scale {
+			scale = t2.Decimal.Scale
+		}
+		integerDigits := t1.Decimal.Precision - t1.Decimal.Scale
+		if other := t2.Decimal.Precision - t2.Decimal.Scale; other > integerDigits {
+			integerDigits = other
+		}
+		return NewDecimalType(integerDigits+scale, scale)
+	}
 	// TODO: Lists should probably be the same. No, we can actually easily check lists at runtime by checking their actual element types. Empty list fits into anything.
 	if t1.TypeID == TypeIDStruct && t2.TypeID == TypeIDStruct {
 		// TODO: Nullable struct + Nullable struct should also work. Overall, it should try to match if there are multiple struct alternatives.
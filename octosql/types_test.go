@@ -34,7 +34,7 @@ func TestTypeIntersection(t *testing.T) {
 		{
 			t1:   TypeSum(TypeSum(Boolean, Time), String),
 			t2:   TypeSum(TypeSum(Time, Int), String),
-			want: some(TypeSum(String, Time)),
+			want: some(TypeSum(Time, String)),
 		},
 		{
 			t1:   TypeSum(Boolean, Time),
@@ -50,3 +50,82 @@ func TestTypeIntersection(t *testing.T) {
 		})
 	}
 }
+
+func TestParseType(t *testing.T) {
+	list := func(t Type) Type {
+		return Type{TypeID: TypeIDList, List: struct{ Element *Type }{Element: &t}}
+	}
+
+	tests := []struct {
+		str     string
+		want    Type
+		wantErr bool
+	}{
+		{
+			str:  "Int",
+			want: Int,
+		},
+		{
+			str:  "string | null",
+			want: TypeSum(String, Null),
+		},
+		{
+			str:  "[Int | NULL]",
+			want: list(TypeSum(Int, Null)),
+		},
+		{
+			str: "{a: Int; b: [String]} | NULL",
+			want: TypeSum(Type{
+				TypeID: TypeIDStruct,
+				Struct: struct{ Fields []StructField }{Fields: []StructField{
+					{Name: "a", Type: Int},
+					{Name: "b", Type: list(String)},
+				}},
+			}, Null),
+		},
+		{
+			str:  "Interval | NULL",
+			want: TypeSum(Interval, Null),
+		},
+		{
+			str:  "Decimal(10, 2) | NULL",
+			want: TypeSum(NewDecimalType(10, 2), Null),
+		},
+		{
+			str:  "Decimal",
+			want: Decimal,
+		},
+		{
+			str:  "Date | NULL",
+			want: TypeSum(Date, Null),
+		},
+		{
+			str:     "Decimal(2, 3)",
+			wantErr: true,
+		},
+		{
+			str:     "Integer",
+			wantErr: true,
+		},
+		{
+			str:     "[Int",
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			got, err := ParseType(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseType(%s) error = %v, wantErr %v", tt.str, err, tt.wantErr)
+			}
+			if err == nil && !got.Equals(tt.want) {
+				t.Errorf("ParseType(%s) = %s, want %s", tt.str, got, tt.want)
+			}
+			if err == nil {
+				if reparsed, err := ParseType(got.String()); err != nil || !reparsed.Equals(got) {
+					t.Errorf("ParseType(%s) doesn't round trip: %s, %v", got, reparsed, err)
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,9 @@ import (
 
 var ZeroValue = Value{}
 
+// DateLayout is the layout used to format dates.
+const DateLayout = "2006-01-02"
+
 // Value represents a single row value. The zero value of it is conveniently NULL.
 type Value struct {
 	TypeID   TypeID
@@ -17,6 +20,8 @@ type Value struct {
 	Str      string
 	Time     time.Time
 	Duration time.Duration
+	Interval CalendarInterval
+	Decimal  DecimalNumber
 	List     []Value
 	Struct   []Value
 	Tuple    []Value
@@ -63,6 +68,15 @@ func NewTime(value time.Time) Value {
 	}
 }
 
+// NewDate returns the date of the time in its time zone. Dates are stored as midnight UTC.
+func NewDate(value time.Time) Value {
+	year, month, day := value.Date()
+	return Value{
+		TypeID: TypeIDDate,
+		Time:   time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+	}
+}
+
 func NewDuration(value time.Duration) Value {
 	return Value{
 		TypeID:   TypeIDDuration,
@@ -70,6 +84,20 @@ func NewDuration(value time.Duration) Value {
 	}
 }
 
+func NewInterval(value CalendarInterval) Value {
+	return Value{
+		TypeID:   TypeIDInterval,
+		Interval: value,
+	}
+}
+
+func NewDecimal(value DecimalNumber) Value {
+	return Value{
+		TypeID:  TypeIDDecimal,
+		Decimal: value,
+	}
+}
+
 func NewList(value []Value) Value {
 	return Value{
 		TypeID: TypeIDList,
@@ -151,7 +179,7 @@ func (value Value) Compare(other Value) int {
 			}
 		}
 
-	case TypeIDTime:
+	case TypeIDTime, TypeIDDate:
 		if value.Time.Before(other.Time) {
 			return -1
 		} else if value.Time.After(other.Time) {
@@ -169,6 +197,12 @@ func (value Value) Compare(other Value) int {
 			return 0
 		}
 
+	case TypeIDInterval:
+		return value.Interval.Compare(other.Interval)
+
+	case TypeIDDecimal:
+		return value.Decimal.Compare(other.Decimal)
+
 	case TypeIDList:
 		maxLen := len(value.List)
 		if len(other.List) > maxLen {
@@ -245,6 +279,9 @@ func (value Value) Equal(other Value) bool {
 
 func (value Value) Type() Type {
 	switch value.TypeID {
+	case TypeIDDecimal:
+		return NewDecimalType(value.Decimal.Precision(), value.Decimal.Scale)
+
 	case TypeIDList:
 		var element *Type
 		for i := range value.List {
@@ -314,9 +351,18 @@ func (value Value) append(builder *strings.Builder) {
 	case TypeIDTime:
 		builder.WriteString(value.Time.Format(time.RFC3339))
 
+	case TypeIDDate:
+		builder.WriteString(value.Time.Format(DateLayout))
+
 	case TypeIDDuration:
 		builder.WriteString(fmt.Sprint(value.Duration))
 
+	case TypeIDInterval:
+		builder.WriteString(value.Interval.String())
+
+	case TypeIDDecimal:
+		builder.WriteString(value.Decimal.String())
+
 	case TypeIDList:
 		builder.WriteString("[")
 		for i, v := range value.List {
@@ -372,8 +418,14 @@ func (value Value) ToRawGoValue() interface{} {
 		return value.Str
 	case TypeIDTime:
 		return value.Time
+	case TypeIDDate:
+		return value.Time.Format(DateLayout)
 	case TypeIDDuration:
 		return value.Duration
+	case TypeIDInterval:
+		return value.Interval
+	case TypeIDDecimal:
+		return value.Decimal
 	default:
 		panic("invalid octosql.Value to get Raw Go value for")
 	}
@@ -15,23 +15,30 @@ func PushDownFilterPredicatesIntoLookupJoinBranch(node Node) (Node, bool) {
 			if node.Filter.Source.NodeType != NodeTypeLookupJoin {
 				return node
 			}
-			changed = true
 
 			sourceSchema := node.Filter.Source.LookupJoin.Source.Schema
 			joinedSchema := node.Filter.Source.LookupJoin.Joined.Schema
 
 			filterPredicates := node.Filter.Predicate.SplitByAnd()
-			var pushedDownSource, pushedDownJoined []Expression
+			var stayedAbove, pushedDownSource, pushedDownJoined []Expression
 
 			for i := range filterPredicates {
 				variablesUsed := filterPredicates[i].VariablesUsed()
 				if !usesVariablesFromSchema(joinedSchema, variablesUsed) {
 					pushedDownSource = append(pushedDownSource, filterPredicates[i])
+				} else if node.Filter.Source.LookupJoin.IsLeftJoin {
+					// Filtering the joined branch of a left join would turn records into null-padded ones.
+					stayedAbove = append(stayedAbove, filterPredicates[i])
 				} else {
 					pushedDownJoined = append(pushedDownJoined, filterPredicates[i])
 				}
 			}
 
+			if len(stayedAbove) == len(filterPredicates) {
+				return node
+			}
+			changed = true
+
 			joinSourceSource := node.Filter.Source.LookupJoin.Source
 			if len(pushedDownSource) > 0 {
 				joinSourceSource = Node{
@@ -74,10 +81,27 @@ func PushDownFilterPredicatesIntoLookupJoinBranch(node Node) (Node, bool) {
 				Schema:   node.Filter.Source.Schema,
 				NodeType: NodeTypeLookupJoin,
 				LookupJoin: &LookupJoin{
-					Source: joinSourceSource,
-					Joined: joinSourceJoined,
+					Source:     joinSourceSource,
+					Joined:     joinSourceJoined,
+					IsLeftJoin: node.Filter.Source.LookupJoin.IsLeftJoin,
 				},
 			}
+			if len(stayedAbove) > 0 {
+				out = Node{
+					Schema:   out.Schema,
+					NodeType: NodeTypeFilter,
+					Filter: &Filter{
+						Predicate: Expression{
+							Type:           octosql.Boolean,
+							ExpressionType: ExpressionTypeAnd,
+							And: &And{
+								Arguments: stayedAbove,
+							},
+						},
+						Source: out,
+					},
+				}
+			}
 
 			return out
 		},
@@ -28,6 +28,16 @@ func PushDownFilterPredicatesIntoStreamJoinBranch(node Node) (Node, bool) {
 				// then it gets pushed down into both.
 				usesLeftBranch := usesVariablesFromSchema(leftSchema, variablesUsed)
 				usesRightBranch := usesVariablesFromSchema(rightSchema, variablesUsed)
+				if node.Filter.Source.StreamJoin.IsLeftJoin || node.Filter.Source.StreamJoin.IsSemiJoin || node.Filter.Source.StreamJoin.IsAntiJoin {
+					// Filtering the right branch of a left join would turn records into null-padded ones.
+					// Semi and anti joins only output left fields, but their right branch decides which records get output.
+					if !usesRightBranch {
+						pushedDownLeft = append(pushedDownLeft, filterPredicates[i])
+					} else {
+						stayedAbove = append(stayedAbove, filterPredicates[i])
+					}
+					continue
+				}
 				if !usesLeftBranch {
 					pushedDownRight = append(pushedDownRight, filterPredicates[i])
 				}
@@ -83,10 +93,13 @@ func PushDownFilterPredicatesIntoStreamJoinBranch(node Node) (Node, bool) {
 				Schema:   node.Filter.Source.Schema,
 				NodeType: NodeTypeStreamJoin,
 				StreamJoin: &StreamJoin{
-					LeftKey:  node.Filter.Source.StreamJoin.LeftKey,
-					RightKey: node.Filter.Source.StreamJoin.RightKey,
-					Left:     joinSourceLeft,
-					Right:    joinSourceRight,
+					LeftKey:    node.Filter.Source.StreamJoin.LeftKey,
+					RightKey:   node.Filter.Source.StreamJoin.RightKey,
+					Left:       joinSourceLeft,
+					Right:      joinSourceRight,
+					IsLeftJoin: node.Filter.Source.StreamJoin.IsLeftJoin,
+					IsSemiJoin: node.Filter.Source.StreamJoin.IsSemiJoin,
+					IsAntiJoin: node.Filter.Source.StreamJoin.IsAntiJoin,
 				},
 			}
 			if len(stayedAbove) > 0 {
@@ -15,6 +15,14 @@ func PushDownFilterPredicatesIntoStreamJoinKey(node Node) (Node, bool) {
 			if node.Filter.Source.NodeType != NodeTypeStreamJoin {
 				return node
 			}
+			if node.Filter.Source.StreamJoin.IsLeftJoin {
+				// A filter above a left join can't become part of its key, as it would turn records into null-padded ones.
+				return node
+			}
+			if node.Filter.Source.StreamJoin.IsSemiJoin || node.Filter.Source.StreamJoin.IsAntiJoin {
+				// A filter above a semi or anti join can't use any right fields.
+				return node
+			}
 			leftSchema := node.Filter.Source.StreamJoin.Left.Schema
 			rightSchema := node.Filter.Source.StreamJoin.Right.Schema
 
@@ -67,11 +67,28 @@ func isUsed(field string, node Node) bool {
 						used = true
 					}
 				}
+			case ExpressionTypeExists:
+				for i := range expr.Exists.Source.Schema.Fields {
+					if expr.Exists.Source.Schema.Fields[i].Name == field {
+						used = true
+					}
+				}
 			}
 
 			return expr
 		},
 		NodeTransformer: func(node Node) Node {
+			if node.NodeType == NodeTypeUnionAll {
+				// Union inputs are matched by position, so all their fields are used.
+				for _, input := range node.UnionAll.Inputs {
+					for i := range input.Schema.Fields {
+						if input.Schema.Fields[i].Name == field {
+							used = true
+						}
+					}
+				}
+				return node
+			}
 			if node.NodeType != NodeTypeTableValuedFunction {
 				return node
 			}
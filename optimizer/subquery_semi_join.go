@@ -0,0 +1,282 @@
+package optimizer
+
+import (
+	"github.com/cube2222/octosql/octosql"
+	. "github.com/cube2222/octosql/physical"
+)
+
+// RewriteSubqueriesIntoSemiJoins turns EXISTS, NOT EXISTS, IN and NOT IN subquery filter predicates into semi and anti stream joins.
+// Correlated subqueries are supported if they only reference the outer record in equality predicates in their WHERE clause.
+// Those become the join key, so the subquery doesn't have to be rerun for each record.
+func RewriteSubqueriesIntoSemiJoins(node Node) (Node, bool) {
+	changed := false
+	t := Transformers{
+		NodeTransformer: func(node Node) Node {
+			if node.NodeType != NodeTypeFilter {
+				return node
+			}
+
+			filterPredicates := node.Filter.Predicate.SplitByAnd()
+			source := node.Filter.Source
+			var stayedAbove []Expression
+
+			for i := range filterPredicates {
+				join, ok := subqueryPredicateToSemiJoin(source, filterPredicates[i])
+				if !ok {
+					stayedAbove = append(stayedAbove, filterPredicates[i])
+					continue
+				}
+				source = join
+			}
+
+			if len(stayedAbove) == len(filterPredicates) {
+				return node
+			}
+			changed = true
+
+			if len(stayedAbove) == 0 {
+				return source
+			}
+			return Node{
+				Schema:   node.Schema,
+				NodeType: NodeTypeFilter,
+				Filter: &Filter{
+					Predicate: Expression{
+						Type:           octosql.Boolean,
+						ExpressionType: ExpressionTypeAnd,
+						And: &And{
+							Arguments: stayedAbove,
+						},
+					},
+					Source: source,
+				},
+			}
+		},
+	}
+	output := t.TransformNode(node)
+
+	if changed {
+		return output, true
+	} else {
+		return node, false
+	}
+}
+
+func subqueryPredicateToSemiJoin(left Node, predicate Expression) (Node, bool) {
+	var subquery Node
+	// value is the left hand side of IN, nil for EXISTS.
+	var value *Expression
+	anti := false
+
+	switch {
+	case predicate.ExpressionType == ExpressionTypeExists:
+		subquery = predicate.Exists.Source
+
+	case predicate.ExpressionType == ExpressionTypeFunctionCall &&
+		predicate.FunctionCall.Name == "not" &&
+		predicate.FunctionCall.Arguments[0].ExpressionType == ExpressionTypeExists:
+		subquery = predicate.FunctionCall.Arguments[0].Exists.Source
+		anti = true
+
+	case predicate.ExpressionType == ExpressionTypeFunctionCall &&
+		(predicate.FunctionCall.Name == "in" || predicate.FunctionCall.Name == "not in") &&
+		predicate.FunctionCall.Arguments[1].ExpressionType == ExpressionTypeQueryExpression:
+		subquery = predicate.FunctionCall.Arguments[1].QueryExpression.Source
+		value = &predicate.FunctionCall.Arguments[0]
+		anti = predicate.FunctionCall.Name == "not in"
+		if len(subquery.Schema.Fields) != 1 {
+			return Node{}, false
+		}
+		if anti && (octosql.Null.Is(value.Type) != octosql.TypeRelationIsnt ||
+			octosql.Null.Is(subquery.Schema.Fields[0].Type) != octosql.TypeRelationIsnt) {
+			// NOT IN evaluates to NULL if there are nulls involved, while an anti join would send the record.
+			return Node{}, false
+		}
+		if containsSubquery(*value) {
+			return Node{}, false
+		}
+
+	default:
+		return Node{}, false
+	}
+
+	// The right branch is the subquery without its select list and correlated predicates.
+	right := subquery
+	var leftKey, rightKey []Expression
+	if value != nil {
+		leftKey = append(leftKey, *value)
+		if right.NodeType == NodeTypeMap {
+			rightKey = append(rightKey, right.Map.Expressions[0])
+			right = right.Map.Source
+		} else {
+			rightKey = append(rightKey, Expression{
+				Type:           right.Schema.Fields[0].Type,
+				ExpressionType: ExpressionTypeVariable,
+				Variable: &Variable{
+					Name:     right.Schema.Fields[0].Name,
+					IsLevel0: true,
+				},
+			})
+		}
+	} else if right.NodeType == NodeTypeMap {
+		right = right.Map.Source
+	}
+
+	if right.NodeType == NodeTypeFilter {
+		var uncorrelated []Expression
+		for _, part := range right.Filter.Predicate.SplitByAnd() {
+			if !referencesOuterFields(left.Schema, part) {
+				uncorrelated = append(uncorrelated, part)
+				continue
+			}
+			if part.ExpressionType != ExpressionTypeFunctionCall || part.FunctionCall.Name != "=" || containsSubquery(part) {
+				return Node{}, false
+			}
+			firstPart := part.FunctionCall.Arguments[0]
+			secondPart := part.FunctionCall.Arguments[1]
+			if isOuterExpression(left.Schema, firstPart) && !referencesOuterFields(left.Schema, secondPart) {
+				leftKey = append(leftKey, outerExpressionToLevel0(left.Schema, firstPart))
+				rightKey = append(rightKey, secondPart)
+			} else if isOuterExpression(left.Schema, secondPart) && !referencesOuterFields(left.Schema, firstPart) {
+				leftKey = append(leftKey, outerExpressionToLevel0(left.Schema, secondPart))
+				rightKey = append(rightKey, firstPart)
+			} else {
+				return Node{}, false
+			}
+		}
+
+		if len(uncorrelated) == 0 {
+			right = right.Filter.Source
+		} else {
+			right = Node{
+				Schema:   right.Schema,
+				NodeType: NodeTypeFilter,
+				Filter: &Filter{
+					Predicate: Expression{
+						Type:           octosql.Boolean,
+						ExpressionType: ExpressionTypeAnd,
+						And: &And{
+							Arguments: uncorrelated,
+						},
+					},
+					Source: right.Filter.Source,
+				},
+			}
+		}
+	}
+
+	for i := range rightKey {
+		if referencesOuterFields(left.Schema, rightKey[i]) {
+			return Node{}, false
+		}
+		if !joinKeyTypesMatch(leftKey[i].Type, rightKey[i].Type) {
+			return Node{}, false
+		}
+	}
+	if nodeReferencesOuterFields(left.Schema, right) {
+		return Node{}, false
+	}
+
+	return Node{
+		Schema:   left.Schema,
+		NodeType: NodeTypeStreamJoin,
+		StreamJoin: &StreamJoin{
+			Left:       left,
+			Right:      right,
+			LeftKey:    leftKey,
+			RightKey:   rightKey,
+			IsSemiJoin: !anti,
+			IsAntiJoin: anti,
+		},
+	}, true
+}
+
+// isOuterFieldReference checks if the variable in a subquery references a field of the outer record.
+func isOuterFieldReference(outerSchema Schema, variable *Variable) bool {
+	if variable.IsLevel0 {
+		return false
+	}
+	for _, field := range outerSchema.Fields {
+		if field.Name == variable.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func referencesOuterFields(outerSchema Schema, expr Expression) bool {
+	references := false
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && isOuterFieldReference(outerSchema, expr.Variable) {
+				references = true
+			}
+			return expr
+		},
+	}
+	t.TransformExpr(expr)
+	return references
+}
+
+func nodeReferencesOuterFields(outerSchema Schema, node Node) bool {
+	references := false
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && isOuterFieldReference(outerSchema, expr.Variable) {
+				references = true
+			}
+			return expr
+		},
+	}
+	t.TransformNode(node)
+	return references
+}
+
+// isOuterExpression checks if the expression only depends on the outer record.
+func isOuterExpression(outerSchema Schema, expr Expression) bool {
+	usesLevel0 := false
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && expr.Variable.IsLevel0 {
+				usesLevel0 = true
+			}
+			return expr
+		},
+	}
+	t.TransformExpr(expr)
+	return !usesLevel0 && referencesOuterFields(outerSchema, expr)
+}
+
+func outerExpressionToLevel0(outerSchema Schema, expr Expression) Expression {
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable && isOuterFieldReference(outerSchema, expr.Variable) {
+				expr.Variable.IsLevel0 = true
+			}
+			return expr
+		},
+	}
+	return t.TransformExpr(expr)
+}
+
+func containsSubquery(expr Expression) bool {
+	contains := false
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeQueryExpression || expr.ExpressionType == ExpressionTypeExists {
+				contains = true
+			}
+			return expr
+		},
+	}
+	t.TransformExpr(expr)
+	return contains
+}
+
+// joinKeyTypesMatch checks if the values of both types can be matched by the join.
+// Join keys are compared including their type, so i.e. an Int and Float key would never match.
+func joinKeyTypesMatch(left, right octosql.Type) bool {
+	left = octosql.TypeSum(left, octosql.Null)
+	right = octosql.TypeSum(right, octosql.Null)
+	return left.Is(right) == octosql.TypeRelationIs && right.Is(left) == octosql.TypeRelationIs
+}
@@ -15,36 +15,31 @@ import (
 	"github.com/cube2222/octosql/parser/sqlparser"
 )
 
-// func ParseUnion(statement *sqlparser.Union) (logical.Node, error) {
-// 	var err error
-// 	var root logical.Node
-//
-// 	if statement.OrderBy != nil {
-// 		return nil, errors.Errorf("order by is currently unsupported, got %+v", statement)
-// 	}
-//
-// 	firstNode, _, err := ParseNode(statement.Left)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse first select expression")
-// 	}
-//
-// 	secondNode, _, err := ParseNode(statement.Right)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "couldn't parse second select expression")
-// 	}
-// 	switch statement.Type {
-// 	case sqlparser.UnionAllStr:
-// 		root = logical.NewUnionAll(firstNode, secondNode)
-//
-// 	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
-// 		root = logical.NewUnionDistinct(firstNode, secondNode)
-//
-// 	default:
-// 		return nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
-// 	}
-//
-// 	return root, nil
-// }
+func ParseUnion(statement *sqlparser.Union, topmost bool) (logical.Node, *OutputOptions, error) {
+	var root logical.Node
+
+	firstNode, _, err := ParseNode(statement.Left, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse first select expression")
+	}
+
+	secondNode, _, err := ParseNode(statement.Right, false)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "couldn't parse second select expression")
+	}
+	switch statement.Type {
+	case sqlparser.UnionAllStr:
+		root = logical.NewUnionAll(firstNode, secondNode)
+
+	case sqlparser.UnionDistinctStr, sqlparser.UnionStr:
+		root = logical.NewUnionDistinct(firstNode, secondNode)
+
+	default:
+		return nil, nil, errors.Errorf("unsupported union %+v of type %v", statement, statement.Type)
+	}
+
+	return parseOrderByAndLimit(root, statement.OrderBy, statement.Limit, topmost)
+}
 
 type OutputOptions struct {
 	Limit              int
@@ -55,10 +50,6 @@ type OutputOptions struct {
 func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *OutputOptions, error) {
 	var err error
 	var root logical.Node
-	var outputOptions *OutputOptions
-	if topmost {
-		outputOptions = &OutputOptions{}
-	}
 
 	root, err = ParseTableExpression(statement.From[len(statement.From)-1])
 	if err != nil {
@@ -83,7 +74,7 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		root = logical.NewFilter(filterFormula, root)
 	}
 
-	isGroupBy := false
+	isGroupBy := statement.Having != nil
 	for i := range statement.SelectExprs {
 		if aliasedExpr, ok := statement.SelectExprs[i].(*sqlparser.AliasedExpr); ok {
 			if isAggregateExpression(aliasedExpr.Expr) {
@@ -149,12 +140,15 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		}
 		nameCounter := map[string]int{}
 		getUniqueName := func(name string) string {
-			count, ok := nameCounter[name]
-			if ok {
+			for {
+				count, ok := nameCounter[name]
+				if !ok {
+					nameCounter[name] = 1
+					return name
+				}
+				nameCounter[name] = count + 1
 				name = fmt.Sprintf("%s_%d", name, count)
 			}
-			nameCounter[name] = count + 1
-			return name
 		}
 		for i, ok := range isAggregate {
 			if ok {
@@ -184,9 +178,71 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 			}
 		}
 
+		var havingPredicate logical.Expression
+		if statement.Having != nil {
+			// Aggregates and group key expressions in the having clause get replaced by references to the group by fields.
+			// Aggregates not present in the select list get computed too and are dropped by the map.
+			having := statement.Having.Expr
+			replacements := make(map[sqlparser.Expr]string)
+			if err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				expr, ok := node.(sqlparser.Expr)
+				if !ok {
+					return true, nil
+				}
+				agg, aggExpr, err := ParseAggregate(expr)
+				if err == nil {
+					for i := range nonKeyAggregates {
+						if nonKeyAggregates[i] == agg && logical.EqualExpressions(aggregateExprs[i], aggExpr) {
+							replacements[expr] = aggregateFieldNames[i]
+							return false, nil
+						}
+					}
+					var name string
+					if namer, ok := aggExpr.(logical.FieldNamer); ok {
+						name = getUniqueName(fmt.Sprintf("%s_%s", agg, namer.FieldName()))
+					} else {
+						name = getUniqueName(agg)
+					}
+					nonKeyAggregates = append(nonKeyAggregates, agg)
+					aggregateExprs = append(aggregateExprs, aggExpr)
+					aggregateFieldNames = append(aggregateFieldNames, name)
+					replacements[expr] = name
+					return false, nil
+				} else if errors.Cause(err) != ErrNotAggregate {
+					return false, err
+				}
+				if parsed, err := ParseExpression(expr); err == nil {
+					for i := range key {
+						if logical.EqualExpressions(parsed, key[i]) {
+							replacements[expr] = keyFieldNames[i]
+							return false, nil
+						}
+					}
+				}
+				return true, nil
+			}, having); err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse having expression")
+			}
+			for from, name := range replacements {
+				having = sqlparser.ReplaceExpr(having, from, newColName(name))
+			}
+			havingPredicate, err = ParseExpression(having)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "couldn't parse having expression")
+			}
+		}
+
 		root = logical.NewGroupBy(root, key, keyFieldNames, aggregateExprs, nonKeyAggregates, aggregateFieldNames, triggers)
+		if havingPredicate != nil {
+			root = logical.NewFilter(havingPredicate, root)
+		}
 		root = logical.NewMap(outputExprs, make([]string, len(outputExprs)), make([]string, len(outputExprs)), make([]bool, len(outputExprs)), root)
 	} else {
+		root, err = parseWindowFunctions(root, statement.SelectExprs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse window functions")
+		}
+
 		expressions := make([]logical.Expression, len(statement.SelectExprs))
 		starQualifiers := make([]string, len(statement.SelectExprs))
 		isStar := make([]bool, len(statement.SelectExprs))
@@ -195,6 +251,12 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		for i := range statement.SelectExprs {
 			if starExpr, ok := statement.SelectExprs[i].(*sqlparser.StarExpr); ok {
 				starQualifiers[i] = starExpr.TableName.Name.String()
+				if !starExpr.TableName.Qualifier.IsEmpty() {
+					starQualifiers[i] = fmt.Sprintf("%s.%s", starExpr.TableName.Qualifier.String(), starQualifiers[i])
+				}
+				for _, field := range starExpr.Fields {
+					starQualifiers[i] = fmt.Sprintf("%s.%s", starQualifiers[i], field.String())
+				}
 				isStar[i] = true
 
 				continue
@@ -218,48 +280,182 @@ func ParseSelect(statement *sqlparser.Select, topmost bool) (logical.Node, *Outp
 		}
 	}
 
-	if statement.OrderBy != nil {
-		orderByExpressions, orderByDirections, err := parseOrderByExpressions(statement.OrderBy)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
+	if len(statement.Distinct) > 0 {
+		root = logical.NewDistinct(root)
+	}
+
+	return parseOrderByAndLimit(root, statement.OrderBy, statement.Limit, topmost)
+}
+
+// parseWindowFunctions adds a window node for each window function call in the select expressions.
+// The calls get replaced by references to the fields added by the window nodes.
+func parseWindowFunctions(root logical.Node, selectExprs sqlparser.SelectExprs) (logical.Node, error) {
+	windowIndex := 0
+	for i := range selectExprs {
+		aliasedExpr, ok := selectExprs[i].(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
 		}
 
-		if !topmost {
-			// TODO: Optimization which pushes order by into output printer. For live batch output.
-			root = logical.NewOrderBy(orderByExpressions, orderByDirections, root)
-		} else {
-			outputOptions.OrderByExpressions = orderByExpressions
-			outputOptions.OrderByDirections = orderByDirections
+		var windowFunctions []*sqlparser.FuncExpr
+		if err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			switch node := node.(type) {
+			case *sqlparser.FuncExpr:
+				if node.Over != nil {
+					windowFunctions = append(windowFunctions, node)
+					return false, nil
+				}
+			case *sqlparser.Subquery:
+				return false, nil
+			}
+			return true, nil
+		}, aliasedExpr.Expr); err != nil {
+			return nil, err
+		}
+
+		for _, windowFunction := range windowFunctions {
+			functionName := strings.ToLower(windowFunction.Name.String())
+			if windowFunction.Distinct {
+				functionName = fmt.Sprintf("%v_distinct", functionName)
+			}
+
+			arguments := make([]logical.Expression, len(windowFunction.Exprs))
+			for j := range windowFunction.Exprs {
+				switch arg := windowFunction.Exprs[j].(type) {
+				case *sqlparser.AliasedExpr:
+					var err error
+					arguments[j], err = ParseExpression(arg.Expr)
+					if err != nil {
+						return nil, errors.Wrapf(err, "couldn't parse %s argument with index %d", functionName, j)
+					}
+				case *sqlparser.StarExpr:
+					arguments[j] = logical.NewConstant(octosql.NewBoolean(true))
+				default:
+					return nil, errors.Errorf("invalid %s argument expression type: %v", functionName, reflect.TypeOf(arg))
+				}
+			}
+			if len(windowFunction.OrderBy) > 0 {
+				ordering, err := parseAggregateOrdering(windowFunction.OrderBy)
+				if err != nil {
+					return nil, err
+				}
+				arguments = append(arguments, ordering...)
+			}
+
+			partitionBy := make([]logical.Expression, len(windowFunction.Over.PartitionBy))
+			for j := range windowFunction.Over.PartitionBy {
+				var err error
+				partitionBy[j], err = ParseExpression(windowFunction.Over.PartitionBy[j])
+				if err != nil {
+					return nil, errors.Wrapf(err, "couldn't parse partition by expression with index %d", j)
+				}
+			}
+			orderByExpressions, orderByDirections, err := parseOrderByExpressions(windowFunction.Over.OrderBy)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse window order by")
+			}
+
+			fieldName := fmt.Sprintf("%s%d", logical.WindowFieldNamePrefix, windowIndex)
+			windowIndex++
+			root = logical.NewWindow(root, partitionBy, orderByExpressions, orderByDirections, functionName, arguments, fieldName)
+
+			reference := &sqlparser.ColName{Name: sqlparser.NewColIdent(fieldName)}
+			if aliasedExpr.Expr == windowFunction && aliasedExpr.As.IsEmpty() {
+				name := functionName
+				if len(arguments) > 0 {
+					if namer, ok := arguments[0].(logical.FieldNamer); ok {
+						name = fmt.Sprintf("%s_%s", functionName, namer.FieldName())
+					}
+				}
+				aliasedExpr.As = sqlparser.NewColIdent(name)
+			}
+			aliasedExpr.Expr = sqlparser.ReplaceExpr(aliasedExpr.Expr, windowFunction, reference)
 		}
 	}
 
-	if len(statement.Distinct) > 0 {
-		root = logical.NewDistinct(root)
+	return root, nil
+}
+
+func parseOrderByAndLimit(root logical.Node, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, topmost bool) (logical.Node, *OutputOptions, error) {
+	var outputOptions *OutputOptions
+	if topmost {
+		outputOptions = &OutputOptions{}
 	}
 
-	if statement.Limit != nil {
-		if !topmost {
-			return nil, nil, errors.Errorf("LIMIT in non-topmost expression not currently supported.")
+	var orderByExpressions []logical.Expression
+	var orderByDirections []logical.OrderDirection
+	if orderBy != nil {
+		var err error
+		orderByExpressions, orderByDirections, err = parseOrderByExpressions(orderBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "couldn't parse keys of order by")
 		}
+	}
 
-		l, ok := statement.Limit.Rowcount.(*sqlparser.SQLVal)
-		if !ok {
-			return nil, nil, errors.Errorf("LIMIT parameter must be constant, is: %+v", statement.Limit.Rowcount)
-		}
-		if l.Type != sqlparser.IntVal {
-			return nil, nil, errors.Errorf("LIMIT parameter must be Int constant, is: %+v", l.Type)
-		}
-		i, err := strconv.ParseInt(string(l.Val), 10, 64)
+	var limitValue, offsetValue int
+	if limit != nil {
+		var err error
+		limitValue, offsetValue, err = parseLimit(limit)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "LIMIT parameter must be Int constant, couldn't parse")
+			return nil, nil, err
+		}
+	}
+
+	if topmost {
+		outputOptions.OrderByExpressions = orderByExpressions
+		outputOptions.OrderByDirections = orderByDirections
+		if offsetValue == 0 {
+			// TODO: Optimization which pushes order by into output printer. For live batch output.
+			outputOptions.Limit = limitValue
+			return root, outputOptions, nil
 		}
+		// The output printers don't support offsets, so we need a limit node. The output still gets ordered by the printer.
+	}
 
-		outputOptions.Limit = int(i)
+	if orderBy != nil {
+		root = logical.NewOrderBy(orderByExpressions, orderByDirections, root)
+	}
+	if limit != nil {
+		root = logical.NewLimit(limitValue, offsetValue, root)
 	}
 
 	return root, outputOptions, nil
 }
 
+func parseLimit(limit *sqlparser.Limit) (int, int, error) {
+	l, err := parseLimitParameter("LIMIT", limit.Rowcount)
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit.Offset == nil {
+		return l, 0, nil
+	}
+	offset, err := parseLimitParameter("OFFSET", limit.Offset)
+	if err != nil {
+		return 0, 0, err
+	}
+	return l, offset, nil
+}
+
+func parseLimitParameter(clause string, expr sqlparser.Expr) (int, error) {
+	l, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return 0, errors.Errorf("%s parameter must be constant, is: %+v", clause, expr)
+	}
+	if l.Type != sqlparser.IntVal {
+		return 0, errors.Errorf("%s parameter must be Int constant, is: %+v", clause, l.Type)
+	}
+	i, err := strconv.ParseInt(string(l.Val), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "%s parameter must be Int constant, couldn't parse", clause)
+	}
+	if i < 0 {
+		return 0, errors.Errorf("%s parameter must not be negative, is: %d", clause, i)
+	}
+
+	return int(i), nil
+}
+
 func ParseWith(statement *sqlparser.With, topmost bool) (logical.Node, *OutputOptions, error) {
 	source, outputOptions, err := ParseNode(statement.Select, topmost)
 	if err != nil {
@@ -285,9 +481,8 @@ func ParseNode(statement sqlparser.SelectStatement, topmost bool) (logical.Node,
 	case *sqlparser.Select:
 		return ParseSelect(statement, topmost)
 
-	// case *sqlparser.Union:
-	// 	plan, err := ParseUnion(statement)
-	// 	return plan, &logical.OutputOptions{}, err
+	case *sqlparser.Union:
+		return ParseUnion(statement, topmost)
 
 	case *sqlparser.ParenSelect:
 		return ParseNode(statement.Select, topmost)
@@ -328,6 +523,9 @@ func ParseAliasedTableExpression(expr *sqlparser.AliasedTableExpr) (logical.Node
 		} else {
 			alias = strings.TrimSuffix(name, ".json")
 			alias = strings.TrimSuffix(alias, ".csv")
+			alias = strings.TrimSuffix(alias, ".tsv")
+			alias = strings.TrimSuffix(alias, ".psv")
+			alias = strings.TrimSuffix(alias, ".parquet")
 			if index := strings.Index(alias, "."); index != -1 {
 				alias = alias[index+1:]
 			}
@@ -372,11 +570,22 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		return nil, errors.Errorf("invalid join expression: %v", expr.Join)
 	}
 
+	var predicate logical.Expression
+	if expr.Condition.On != nil {
+		predicate, err = ParseExpression(expr.Condition.On)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse ON predicate in join")
+		}
+	}
+
 	var node logical.Node
 	if expr.Strategy == sqlparser.LookupJoinStrategy {
 		switch expr.Join {
 		case sqlparser.LeftJoinStr, sqlparser.RightJoinStr:
-			panic("implement me")
+			if predicate != nil {
+				joined = logical.NewFilter(predicate, joined)
+			}
+			return logical.NewLeftLateralJoin(source, joined), nil
 		case sqlparser.JoinStr:
 			node = logical.NewLateralJoin(source, joined)
 		default:
@@ -385,7 +594,7 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 	} else {
 		switch expr.Join {
 		case sqlparser.LeftJoinStr, sqlparser.RightJoinStr:
-			panic("implement me")
+			return logical.NewLeftJoin(source, joined, predicate), nil
 		case sqlparser.JoinStr:
 			node = logical.NewJoin(source, joined)
 		default:
@@ -393,11 +602,7 @@ func ParseJoinTableExpression(expr *sqlparser.JoinTableExpr) (logical.Node, erro
 		}
 	}
 
-	if expr.Condition.On != nil {
-		predicate, err := ParseExpression(expr.Condition.On)
-		if err != nil {
-			return nil, errors.Wrap(err, "couldn't parse ON predicate in join")
-		}
+	if predicate != nil {
 		node = logical.NewFilter(predicate, node)
 	}
 
@@ -454,6 +659,9 @@ var ErrNotAggregate = errors.New("expression is not aggregate")
 func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return "", nil, errors.Wrapf(ErrNotAggregate, "window function: %v", expr.Name)
+		}
 		curAggregate := strings.ToLower(expr.Name.String())
 		if expr.Distinct {
 			curAggregate = fmt.Sprintf("%v_distinct", curAggregate)
@@ -463,31 +671,62 @@ func ParseAggregate(expr sqlparser.Expr) (string, logical.Expression, error) {
 			return "", nil, errors.Wrapf(ErrNotAggregate, "aggregate not found: %v", expr.Name)
 		}
 
-		var parsedArg logical.Expression
-		switch arg := expr.Exprs[0].(type) {
-		case *sqlparser.AliasedExpr:
-			var err error
-			parsedArg, err = ParseExpression(arg.Expr)
-			if err != nil {
-				return "", nil, errors.Wrap(err, "couldn't parse aggregate argument")
-			}
+		if len(expr.Exprs) == 0 {
+			return "", nil, errors.Errorf("aggregate %s requires an argument", curAggregate)
+		}
+		parsedArgs := make([]logical.Expression, len(expr.Exprs))
+		for i := range expr.Exprs {
+			switch arg := expr.Exprs[i].(type) {
+			case *sqlparser.AliasedExpr:
+				var err error
+				parsedArgs[i], err = ParseExpression(arg.Expr)
+				if err != nil {
+					return "", nil, errors.Wrap(err, "couldn't parse aggregate argument")
+				}
 
-		case *sqlparser.StarExpr:
-			parsedArg = logical.NewConstant(octosql.NewBoolean(true))
+			case *sqlparser.StarExpr:
+				parsedArgs[i] = logical.NewConstant(octosql.NewBoolean(true))
 
-		default:
-			return "", nil, errors.Errorf(
-				"invalid aggregate argument expression type: %v",
-				reflect.TypeOf(expr.Exprs[0]),
-			)
+			default:
+				return "", nil, errors.Errorf(
+					"invalid aggregate argument expression type: %v",
+					reflect.TypeOf(expr.Exprs[i]),
+				)
+			}
+		}
+		if len(expr.OrderBy) > 0 {
+			ordering, err := parseAggregateOrdering(expr.OrderBy)
+			if err != nil {
+				return "", nil, err
+			}
+			parsedArgs = append(parsedArgs, ordering...)
+		}
+		if len(parsedArgs) > 1 {
+			// Aggregates with multiple arguments receive them as a tuple.
+			return curAggregate, logical.NewTuple(parsedArgs), nil
 		}
 
-		return curAggregate, parsedArg, nil
+		return curAggregate, parsedArgs[0], nil
 	}
 
 	return "", nil, errors.Wrapf(ErrNotAggregate, "invalid group by select expression type")
 }
 
+// parseAggregateOrdering parses the ORDER BY of an aggregate call, like string_agg(x, ',' ORDER BY y DESC).
+// The ordering is passed to the aggregate as two additional arguments: a tuple of the key and a tuple of the direction multipliers.
+func parseAggregateOrdering(orderBy sqlparser.OrderBy) ([]logical.Expression, error) {
+	orderByExpressions, orderByDirections, err := parseOrderByExpressions(orderBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse aggregate order by")
+	}
+	multipliers := logical.DirectionsToMultipliers(orderByDirections)
+	directions := make([]logical.Expression, len(multipliers))
+	for i := range multipliers {
+		directions[i] = logical.NewConstant(octosql.NewInt(multipliers[i]))
+	}
+	return []logical.Expression{logical.NewTuple(orderByExpressions), logical.NewTuple(directions)}, nil
+}
+
 func ParseTrigger(trigger sqlparser.Trigger) (logical.Trigger, error) {
 	switch trigger := trigger.(type) {
 	case *sqlparser.CountingTrigger:
@@ -531,6 +770,14 @@ func ParseAliasedExpression(expr *sqlparser.AliasedExpr) (logical.Expression, st
 }
 
 func ParseFunctionArgument(expr *sqlparser.AliasedExpr) (logical.Expression, error) {
+	if lambda, ok := expr.Expr.(*sqlparser.LambdaExpr); ok {
+		body, err := ParseExpression(lambda.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse lambda body")
+		}
+		return logical.NewLambda(lambda.Parameter.String(), body), nil
+	}
+
 	subExpr, err := ParseExpression(expr.Expr)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't parse argument")
@@ -564,6 +811,12 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 
 	case *sqlparser.FuncExpr:
 		functionName := strings.ToLower(expr.Name.String())
+		if expr.Over != nil {
+			return nil, errors.Errorf("window function %s is only allowed in the select list", functionName)
+		}
+		if len(expr.OrderBy) > 0 {
+			return nil, errors.Errorf("ORDER BY is only allowed in aggregate calls, not in %s", functionName)
+		}
 
 		arguments := make([]logical.Expression, 0)
 		var logicArg logical.Expression
@@ -578,6 +831,12 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 				if err != nil {
 					return nil, errors.Wrap(err, "couldn't parse an aliased expression argument")
 				}
+			case *sqlparser.StarExpr:
+				qualifier := arg.TableName.Name.String()
+				if !arg.TableName.Qualifier.IsEmpty() {
+					qualifier = fmt.Sprintf("%s.%s", arg.TableName.Qualifier.String(), qualifier)
+				}
+				logicArg = logical.NewStarExpression(qualifier)
 			default:
 				return nil, errors.Errorf("Unsupported argument %v of type %v", arg, reflect.TypeOf(arg))
 			}
@@ -588,15 +847,35 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		if functionName == "coalesce" {
 			return logical.NewCoalesce(arguments), nil
 		}
+		if functionName == "transform" || functionName == "filter" {
+			if len(arguments) != 2 {
+				return nil, errors.Errorf("%s expects a list and a lambda, i.e. %s(list, x -> x + 1)", functionName, functionName)
+			}
+			lambda, ok := arguments[1].(*logical.Lambda)
+			if !ok {
+				return nil, errors.Errorf("the second argument of %s must be a lambda, i.e. x -> x + 1", functionName)
+			}
+			return logical.NewListLambda(arguments[0], lambda, functionName == "filter"), nil
+		}
+		if functionName == "parse_json" {
+			return nil, errors.Errorf("parse_json requires a target type, provide it using a cast, i.e. parse_json(x)::{name: string}")
+		}
 
 		return logical.NewFunctionExpression(functionName, arguments), nil
 
 	case *sqlparser.ColName:
-		name := expr.Name.String()
-		if !expr.Qualifier.Name.IsEmpty() {
-			name = fmt.Sprintf("%s.%s", expr.Qualifier.Name.String(), name)
+		return logical.NewVariable(colNameToVariableName(expr)), nil
+
+	case *sqlparser.FieldAccessExpr:
+		if name, ok := dottedName(expr); ok {
+			// The variable and its accessed fields get told apart during typechecking.
+			return logical.NewVariable(name), nil
+		}
+		structExpr, err := ParseExpression(expr.Expr)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse structure expression")
 		}
-		return logical.NewVariable(name), nil
+		return logical.NewFieldAccess(structExpr, expr.Field.String()), nil
 
 	case *sqlparser.Subquery:
 		selectExpr, ok := expr.Select.(*sqlparser.Select)
@@ -610,6 +889,18 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 		return logical.NewQueryExpression(subquery), nil
 
+	case *sqlparser.ExistsExpr:
+		selectExpr, ok := expr.Subquery.Select.(*sqlparser.Select)
+		if !ok {
+			return nil, errors.Errorf("expected select statement in exists subquery, got %v %v",
+				expr.Subquery.Select, reflect.TypeOf(expr.Subquery.Select))
+		}
+		subquery, _, err := ParseNode(selectExpr, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't parse exists subquery")
+		}
+		return logical.NewExists(subquery), nil
+
 	case *sqlparser.SQLVal:
 		var value octosql.Value
 		var err error
@@ -654,39 +945,27 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		return logical.NewTuple(expressions), nil
 
 	case *sqlparser.IntervalExpr:
-		c, ok := expr.Expr.(*sqlparser.SQLVal)
-		if !ok {
-			return nil, errors.Errorf("interval expression parameter must be constant, is: %+v", expr.Expr)
+		unit, err := intervalUnit(expr.Unit)
+		if err != nil {
+			return nil, err
 		}
-		if c.Type != sqlparser.IntVal {
-			return nil, errors.Errorf("interval expression parameter must be Int constant, is: %+v", c)
+
+		if c, ok := expr.Expr.(*sqlparser.SQLVal); ok && (c.Type == sqlparser.IntVal || c.Type == sqlparser.FloatVal) {
+			amount, err := strconv.ParseFloat(string(c.Val), 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse interval expression amount")
+			}
+			if unit.TypeID == octosql.TypeIDDuration {
+				return logical.NewConstant(octosql.NewDuration(time.Duration(float64(unit.Duration) * amount))), nil
+			}
+			return logical.NewConstant(octosql.NewInterval(unit.Interval.Multiply(amount))), nil
 		}
-		i, err := strconv.ParseInt(string(c.Val), 10, 64)
+
+		amount, err := ParseExpression(expr.Expr)
 		if err != nil {
-			return nil, errors.Wrap(err, "interval expression parameter must be Int constant, couldn't parse")
-		}
-
-		var unit time.Duration
-		switch strings.TrimSuffix(strings.ToLower(expr.Unit), "s") {
-		case "nanosecond":
-			unit = time.Nanosecond
-		case "microsecond":
-			unit = time.Microsecond
-		case "millisecond":
-			unit = time.Millisecond
-		case "second":
-			unit = time.Second
-		case "minute":
-			unit = time.Minute
-		case "hour":
-			unit = time.Hour
-		case "day":
-			unit = time.Hour * 24
-		default:
-			return nil, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day", expr.Unit)
+			return nil, errors.Wrap(err, "couldn't parse interval expression amount")
 		}
-
-		return logical.NewConstant(octosql.NewDuration(time.Duration(i) * unit)), nil
+		return logical.NewFunctionExpression("*", []logical.Expression{amount, logical.NewConstant(unit)}), nil
 
 	case *sqlparser.AndExpr:
 		return ParseInfixOperator(expr.Left, expr.Right, "AND")
@@ -720,6 +999,10 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 
 		return logical.NewFunctionExpression(funcName, []logical.Expression{arg}), nil
 	case *sqlparser.ConvertExpr:
+		if function, ok := expr.Expr.(*sqlparser.FuncExpr); ok && strings.ToLower(function.Name.String()) == "parse_json" {
+			return parseJSONExpression(function, expr.Type)
+		}
+
 		arg, err := ParseExpression(expr.Expr)
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't parse expression being cast")
@@ -730,11 +1013,67 @@ func ParseExpression(expr sqlparser.Expr) (logical.Expression, error) {
 		}
 
 		return logical.NewCast(arg, targetType), nil
+	case *sqlparser.CaseExpr:
+		var value logical.Expression
+		if expr.Expr != nil {
+			var err error
+			value, err = ParseExpression(expr.Expr)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse case value expression")
+			}
+		}
+		conditions := make([]logical.Expression, len(expr.Whens))
+		results := make([]logical.Expression, len(expr.Whens))
+		for i := range expr.Whens {
+			var err error
+			conditions[i], err = ParseExpression(expr.Whens[i].Cond)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse case condition with index %d", i)
+			}
+			results[i], err = ParseExpression(expr.Whens[i].Val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't parse case result with index %d", i)
+			}
+		}
+		var elseResult logical.Expression
+		if expr.Else != nil {
+			var err error
+			elseResult, err = ParseExpression(expr.Else)
+			if err != nil {
+				return nil, errors.Wrap(err, "couldn't parse case else expression")
+			}
+		}
+
+		return logical.NewCase(value, conditions, results, elseResult), nil
 	default:
 		return nil, errors.Errorf("unsupported expression %+v of type %v", expr, reflect.TypeOf(expr))
 	}
 }
 
+// parseJSONExpression parses parse_json(x)::type, where the cast provides the type the JSON is decoded into.
+func parseJSONExpression(function *sqlparser.FuncExpr, convertType sqlparser.ConvertType) (logical.Expression, error) {
+	if function.Over != nil {
+		return nil, errors.Errorf("parse_json can't be used as a window function")
+	}
+	if len(function.Exprs) != 1 {
+		return nil, errors.Errorf("parse_json expects exactly one argument, got %d", len(function.Exprs))
+	}
+	aliasedArg, ok := function.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, errors.Errorf("unsupported parse_json argument %v of type %v", function.Exprs[0], reflect.TypeOf(function.Exprs[0]))
+	}
+	arg, err := ParseExpression(aliasedArg.Expr)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse parse_json argument")
+	}
+	targetType, err := ParseType(convertType)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't parse parse_json target type")
+	}
+
+	return logical.NewParseJSON(arg, targetType), nil
+}
+
 func ParseType(t sqlparser.ConvertType) (octosql.Type, error) {
 	switch t := t.(type) {
 	case *sqlparser.ConvertTypeList:
@@ -778,19 +1117,109 @@ func ParseType(t sqlparser.ConvertType) (octosql.Type, error) {
 			return octosql.String, nil
 		case "time":
 			return octosql.Time, nil
+		case "date":
+			return octosql.Date, nil
 		case "duration":
 			return octosql.Duration, nil
+		case "interval":
+			return octosql.Interval, nil
+		case "decimal", "numeric":
+			return octosql.Decimal, nil
 		default:
 			return octosql.Type{}, errors.Errorf("unknown type: %s", tName)
 		}
+	case *sqlparser.ConvertTypeDecimal:
+		precision, err := strconv.Atoi(string(t.Precision.Val))
+		if err != nil {
+			return octosql.Type{}, errors.Wrap(err, "couldn't parse decimal precision")
+		}
+		scale, err := strconv.Atoi(string(t.Scale.Val))
+		if err != nil {
+			return octosql.Type{}, errors.Wrap(err, "couldn't parse decimal scale")
+		}
+		if precision < 1 || precision > octosql.MaxDecimalPrecision || scale > precision {
+			return octosql.Type{}, errors.Errorf("invalid decimal precision and scale: %d, %d", precision, scale)
+		}
+		return octosql.NewDecimalType(precision, scale), nil
 	default:
 		return octosql.Type{}, errors.Errorf("unsupported type %+v of type %v", t, reflect.TypeOf(t))
 	}
 }
 
+// intervalUnit returns a single unit of an interval expression.
+// Units up to hours have a fixed length, so they're Durations. Days and larger units are calendar Intervals.
+func intervalUnit(unit string) (octosql.Value, error) {
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "nanosecond":
+		return octosql.NewDuration(time.Nanosecond), nil
+	case "microsecond":
+		return octosql.NewDuration(time.Microsecond), nil
+	case "millisecond":
+		return octosql.NewDuration(time.Millisecond), nil
+	case "second":
+		return octosql.NewDuration(time.Second), nil
+	case "minute":
+		return octosql.NewDuration(time.Minute), nil
+	case "hour":
+		return octosql.NewDuration(time.Hour), nil
+	case "day":
+		return octosql.NewInterval(octosql.CalendarInterval{Days: 1}), nil
+	case "week":
+		return octosql.NewInterval(octosql.CalendarInterval{Days: 7}), nil
+	case "month":
+		return octosql.NewInterval(octosql.CalendarInterval{Months: 1}), nil
+	case "quarter":
+		return octosql.NewInterval(octosql.CalendarInterval{Months: 3}), nil
+	case "year":
+		return octosql.NewInterval(octosql.CalendarInterval{Months: 12}), nil
+	default:
+		return octosql.Value{}, errors.Errorf("invalid interval expression unit: %s, must be one of: nanosecond, microsecond, millisecond, second, minute, hour, day, week, month, quarter, year", unit)
+	}
+}
+
+func colNameToVariableName(colName *sqlparser.ColName) string {
+	name := colName.Name.String()
+	if !colName.Qualifier.Name.IsEmpty() {
+		name = fmt.Sprintf("%s.%s", colName.Qualifier.Name.String(), name)
+	}
+	if !colName.Qualifier.Qualifier.IsEmpty() {
+		name = fmt.Sprintf("%s.%s", colName.Qualifier.Qualifier.String(), name)
+	}
+	return name
+}
+
+// dottedName returns the full dotted name of a column name with any number of field accesses on it.
+func dottedName(expr sqlparser.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		return colNameToVariableName(expr), true
+	case *sqlparser.FieldAccessExpr:
+		name, ok := dottedName(expr.Expr)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("%s.%s", name, expr.Field.String()), true
+	}
+	return "", false
+}
+
+// newColName creates a column reference which parses to a variable with the given name.
+func newColName(name string) *sqlparser.ColName {
+	if i := strings.Index(name, "."); i != -1 {
+		return &sqlparser.ColName{
+			Name:      sqlparser.NewColIdent(name[i+1:]),
+			Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(name[:i])},
+		}
+	}
+	return &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}
+}
+
 func isAggregateExpression(expr sqlparser.Expr) bool {
 	switch expr := expr.(type) {
 	case *sqlparser.FuncExpr:
+		if expr.Over != nil {
+			return false
+		}
 		functionName := strings.ToLower(expr.Name.String())
 
 		if _, ok := aggregates.Aggregates[functionName]; ok {
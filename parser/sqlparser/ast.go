@@ -1759,6 +1759,8 @@ func (Nextval) iSelectExpr()      {}
 // StarExpr defines a '*' or 'table.*' expression.
 type StarExpr struct {
 	TableName TableName
+	// Fields are the nested structure fields after the table name, as in 'table.struct.field.*'.
+	Fields []ColIdent
 }
 
 // Format formats the node.
@@ -1766,6 +1768,9 @@ func (node *StarExpr) Format(buf *TrackedBuffer) {
 	if !node.TableName.IsEmpty() {
 		buf.Myprintf("%v.", node.TableName)
 	}
+	for _, field := range node.Fields {
+		buf.Myprintf("%v.", field)
+	}
 	buf.Myprintf("*")
 }
 
@@ -1773,10 +1778,15 @@ func (node *StarExpr) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
-	return Walk(
-		visit,
-		node.TableName,
-	)
+	if err := Walk(visit, node.TableName); err != nil {
+		return err
+	}
+	for _, field := range node.Fields {
+		if err := Walk(visit, field); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // AliasedExpr defines an aliased SELECT expression.
@@ -2340,6 +2350,8 @@ func (ValTuple) iExpr()           {}
 func (*Subquery) iExpr()          {}
 func (ListArg) iExpr()            {}
 func (*BinaryExpr) iExpr()        {}
+func (*FieldAccessExpr) iExpr()   {}
+func (*LambdaExpr) iExpr()        {}
 func (*UnaryExpr) iExpr()         {}
 func (*IntervalExpr) iExpr()      {}
 func (*CollateExpr) iExpr()       {}
@@ -2983,6 +2995,58 @@ func (node *BinaryExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Left, &node.Right)
 }
 
+// FieldAccessExpr represents accessing a field of a struct value expression.
+type FieldAccessExpr struct {
+	Expr  Expr
+	Field ColIdent
+}
+
+// Format formats the node.
+func (node *FieldAccessExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v.%v", node.Expr, node.Field)
+}
+
+func (node *FieldAccessExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+		node.Field,
+	)
+}
+
+func (node *FieldAccessExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Expr)
+}
+
+// LambdaExpr represents a single parameter lambda used as a function argument, like x -> x + 1.
+type LambdaExpr struct {
+	Parameter ColIdent
+	Body      Expr
+}
+
+// Format formats the node.
+func (node *LambdaExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v -> %v", node.Parameter, node.Body)
+}
+
+func (node *LambdaExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Parameter,
+		node.Body,
+	)
+}
+
+func (node *LambdaExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Body)
+}
+
 // UnaryExpr represents a unary value expression.
 type UnaryExpr struct {
 	Operator string
@@ -3139,6 +3203,9 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	// OrderBy is the ordering of aggregated items, like in string_agg(x, ',' ORDER BY y).
+	OrderBy OrderBy
+	Over    *Over
 }
 
 // Format formats the node.
@@ -3153,7 +3220,10 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.Myprintf("%s(%s%v%v)", node.Name.String(), distinct, node.Exprs, node.OrderBy)
+	if node.Over != nil {
+		buf.Myprintf(" %v", node.Over)
+	}
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3165,6 +3235,8 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.OrderBy,
+		node.Over,
 	)
 }
 
@@ -3181,6 +3253,32 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 	return false
 }
 
+// Over represents the OVER clause of a window function call.
+type Over struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+}
+
+// Format formats the node.
+func (node *Over) Format(buf *TrackedBuffer) {
+	buf.Myprintf("over (")
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+	}
+	buf.Myprintf("%v)", node.OrderBy)
+}
+
+func (node *Over) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
+	)
+}
+
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
 	"avg":          true,
@@ -3376,6 +3474,7 @@ type ConvertType interface {
 func (*ConvertTypeSimple) iConvertType() {}
 func (*ConvertTypeList) iConvertType()   {}
 func (*ConvertTypeObject) iConvertType() {}
+func (*ConvertTypeDecimal) iConvertType() {}
 
 type ConvertTypeSimple struct {
 	Name string
@@ -3409,6 +3508,20 @@ func (node *ConvertTypeList) walkSubtree(visit Visit) error {
 	)
 }
 
+// ConvertTypeDecimal is a decimal type with a precision and scale, like DECIMAL(10, 2).
+type ConvertTypeDecimal struct {
+	Precision, Scale *SQLVal
+}
+
+// Format formats the node.
+func (node *ConvertTypeDecimal) Format(buf *TrackedBuffer) {
+	buf.Myprintf("decimal(%v, %v)", node.Precision, node.Scale)
+}
+
+func (node *ConvertTypeDecimal) walkSubtree(visit Visit) error {
+	return nil
+}
+
 type ConvertTypeObject struct {
 	Fields []*ConvertTypeObjectField
 }
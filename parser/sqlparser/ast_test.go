@@ -114,6 +114,46 @@ func TestSelect(t *testing.T) {
 	}
 }
 
+func TestStarExpr(t *testing.T) {
+	validSQL := []struct {
+		input     string
+		qualifier string
+		name      string
+		fields    int
+	}{{
+		input: "select t.* from t",
+		name:  "t",
+	}, {
+		input:     "select t.s.* from t",
+		qualifier: "t",
+		name:      "s",
+	}, {
+		input:     "select p.payload.user.* from t as p",
+		qualifier: "p",
+		name:      "payload",
+		fields:    1,
+	}, {
+		input:     "select p.a.b.c.d.* from t as p",
+		qualifier: "p",
+		name:      "a",
+		fields:    3,
+	}}
+	for _, tcase := range validSQL {
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		star := tree.(*Select).SelectExprs[0].(*StarExpr)
+		if star.TableName.Qualifier.String() != tcase.qualifier || star.TableName.Name.String() != tcase.name || len(star.Fields) != tcase.fields {
+			t.Errorf("Parse(%s): %+v", tcase.input, star)
+		}
+		if out := String(tree); out != tcase.input {
+			t.Errorf("Parse(%s): %s, want %s", tcase.input, out, tcase.input)
+		}
+	}
+}
+
 func TestRemoveHints(t *testing.T) {
 	for _, query := range []string{
 		"select * from t use index (i)",
@@ -116,3 +116,60 @@ func TestIsPrecedence(t *testing.T) {
 		}
 	}
 }
+
+func readableSelect(node SelectStatement) string {
+	switch node := node.(type) {
+	case *Union:
+		return fmt.Sprintf("(%s %s %s)", readableSelect(node.Left), node.Type, readableSelect(node.Right))
+	case *ParenSelect:
+		return readableSelect(node.Select)
+	case *Select:
+		return String(node.SelectExprs)
+	default:
+		return String(node)
+	}
+}
+
+func TestUnionPrecedence(t *testing.T) {
+	validSQL := []struct {
+		input  string
+		output string
+	}{{
+		input:  "select a from t union all select b from u",
+		output: "(a union all b)",
+	}, {
+		input:  "select a from t union select b from u union all select c from v",
+		output: "((a union b) union all c)",
+	}, {
+		input:  "(select a from t) union all (select b from u) union all (select c from v)",
+		output: "((a union all b) union all c)",
+	}, {
+		input:  "select a from t union all (select b from u union select c from v)",
+		output: "(a union all (b union c))",
+	}}
+	for _, tcase := range validSQL {
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		union := readableSelect(tree.(SelectStatement))
+		if union != tcase.output {
+			t.Errorf("Parse: \n%s, want: \n%s", union, tcase.output)
+		}
+	}
+}
+
+func TestUnionOrderByAndLimit(t *testing.T) {
+	tree, err := Parse("select a from t union all select b from u order by a limit 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	union := tree.(*Union)
+	if len(union.OrderBy) != 1 || union.Limit == nil {
+		t.Errorf("order by and limit should apply to the whole union, got: %s", String(union))
+	}
+	if _, err := Parse("select a from t limit 1 union all select b from u"); err == nil {
+		t.Errorf("limit in the middle of a union should be a syntax error")
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by goyacc -v sql.out -o sql.go sql.y. DO NOT EDIT.
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
 
 //line sql.y:24
 package sqlparser
@@ -31,6 +31,28 @@ func decNesting(yylex interface{}) {
 	yylex.(*Tokenizer).nesting--
 }
 
+// dottedTableName converts a column name with any number of field accesses, like db.tbl or tbl.struct.field, to a table name
+// followed by the remaining fields.
+// Identifiers in expressions are always parsed as column names, as tables and structure fields are told apart during typechecking.
+func dottedTableName(expr Expr) (TableName, []ColIdent, bool) {
+	switch expr := expr.(type) {
+	case *ColName:
+		if expr.Qualifier.IsEmpty() {
+			return TableName{Name: NewTableIdent(expr.Name.String())}, nil, true
+		}
+	case *FieldAccessExpr:
+		tableName, fields, ok := dottedTableName(expr.Expr)
+		if !ok {
+			break
+		}
+		if tableName.Qualifier.IsEmpty() {
+			return TableName{Qualifier: tableName.Name, Name: NewTableIdent(expr.Field.String())}, nil, true
+		}
+		return tableName, append(fields, expr.Field), true
+	}
+	return TableName{}, nil, false
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -38,7 +60,7 @@ func skipToEnd(yylex interface{}) {
 	yylex.(*Tokenizer).SkipToEnd = true
 }
 
-//line sql.y:59
+//line sql.y:81
 type yySymType struct {
 	yys                              int
 	tableValuedFunctionArguments     TableValuedFunctionArguments
@@ -114,6 +136,7 @@ type yySymType struct {
 	vindexParam                      VindexParam
 	vindexParams                     []VindexParam
 	showFilter                       *ShowFilter
+	over                             *Over
 	optLike                          *OptLike
 }
 
@@ -137,245 +160,246 @@ const WATERMARK = 57362
 const DELAY = 57363
 const COUNTING = 57364
 const AFTER = 57365
-const ALL = 57366
-const DISTINCT = 57367
-const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const KEY = 57374
-const DEFAULT = 57375
-const SET = 57376
-const LOCK = 57377
-const UNLOCK = 57378
-const KEYS = 57379
-const VALUES = 57380
-const LAST_INSERT_ID = 57381
-const NEXT = 57382
-const VALUE = 57383
-const SHARE = 57384
-const MODE = 57385
-const SQL_NO_CACHE = 57386
-const SQL_CACHE = 57387
-const JOIN = 57388
-const STRAIGHT_JOIN = 57389
-const LOOKUP = 57390
-const LEFT = 57391
-const RIGHT = 57392
-const INNER = 57393
-const OUTER = 57394
-const CROSS = 57395
-const NATURAL = 57396
-const USE = 57397
-const FORCE = 57398
-const ON = 57399
-const USING = 57400
-const ID = 57401
-const HEX = 57402
-const STRING = 57403
-const INTEGRAL = 57404
-const FLOAT = 57405
-const HEXNUM = 57406
-const VALUE_ARG = 57407
-const LIST_ARG = 57408
-const COMMENT = 57409
-const COMMENT_KEYWORD = 57410
-const BIT_LITERAL = 57411
-const NULL = 57412
-const TRUE = 57413
-const FALSE = 57414
-const OFF = 57415
-const OR = 57416
-const AND = 57417
-const NOT = 57418
-const BETWEEN = 57419
-const CASE = 57420
-const WHEN = 57421
-const THEN = 57422
-const ELSE = 57423
-const END = 57424
-const OF = 57425
-const LE = 57426
-const GE = 57427
-const NE = 57428
-const NULL_SAFE_EQUAL = 57429
-const IS = 57430
-const LIKE = 57431
-const REGEXP = 57432
-const IN = 57433
-const RIGHTARROW = 57434
-const SHIFT_LEFT = 57435
-const SHIFT_RIGHT = 57436
-const DIV = 57437
-const MOD = 57438
-const NOT_LIKE_REGEXP = 57439
-const LIKE_REGEXP_CASE_INSENSITIVE = 57440
-const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57441
-const UNARY = 57442
-const COLLATE = 57443
-const BINARY = 57444
-const UNDERSCORE_BINARY = 57445
-const UNDERSCORE_UTF8MB4 = 57446
-const INTERVAL = 57447
-const JSON_EXTRACT_OP = 57448
-const JSON_UNQUOTE_EXTRACT_OP = 57449
-const CREATE = 57450
-const ALTER = 57451
-const DROP = 57452
-const RENAME = 57453
-const ANALYZE = 57454
-const ADD = 57455
-const FLUSH = 57456
-const SCHEMA = 57457
-const TABLE = 57458
-const DESCRIPTOR = 57459
-const INDEX = 57460
-const VIEW = 57461
-const TO = 57462
-const IGNORE = 57463
-const IF = 57464
-const UNIQUE = 57465
-const PRIMARY = 57466
-const COLUMN = 57467
-const SPATIAL = 57468
-const FULLTEXT = 57469
-const KEY_BLOCK_SIZE = 57470
-const ACTION = 57471
-const CASCADE = 57472
-const CONSTRAINT = 57473
-const FOREIGN = 57474
-const NO = 57475
-const REFERENCES = 57476
-const RESTRICT = 57477
-const SHOW = 57478
-const DESCRIBE = 57479
-const EXPLAIN = 57480
-const DATE = 57481
-const ESCAPE = 57482
-const REPAIR = 57483
-const OPTIMIZE = 57484
-const TRUNCATE = 57485
-const MAXVALUE = 57486
-const PARTITION = 57487
-const REORGANIZE = 57488
-const LESS = 57489
-const THAN = 57490
-const PROCEDURE = 57491
-const TRIGGER = 57492
-const VINDEX = 57493
-const VINDEXES = 57494
-const STATUS = 57495
-const VARIABLES = 57496
-const WARNINGS = 57497
-const BEGIN = 57498
-const START = 57499
-const TRANSACTION = 57500
-const COMMIT = 57501
-const ROLLBACK = 57502
-const BIT = 57503
-const TINYINT = 57504
-const SMALLINT = 57505
-const MEDIUMINT = 57506
-const INT = 57507
-const INTEGER = 57508
-const BIGINT = 57509
-const INTNUM = 57510
-const REAL = 57511
-const DOUBLE = 57512
-const FLOAT_TYPE = 57513
-const DECIMAL = 57514
-const NUMERIC = 57515
-const TIME = 57516
-const TIMESTAMP = 57517
-const DATETIME = 57518
-const YEAR = 57519
-const CHAR = 57520
-const VARCHAR = 57521
-const BOOL = 57522
-const CHARACTER = 57523
-const VARBINARY = 57524
-const NCHAR = 57525
-const TEXT = 57526
-const TINYTEXT = 57527
-const MEDIUMTEXT = 57528
-const LONGTEXT = 57529
-const BLOB = 57530
-const TINYBLOB = 57531
-const MEDIUMBLOB = 57532
-const LONGBLOB = 57533
-const JSON = 57534
-const ENUM = 57535
-const GEOMETRY = 57536
-const POINT = 57537
-const LINESTRING = 57538
-const POLYGON = 57539
-const GEOMETRYCOLLECTION = 57540
-const MULTIPOINT = 57541
-const MULTILINESTRING = 57542
-const MULTIPOLYGON = 57543
-const NULLX = 57544
-const AUTO_INCREMENT = 57545
-const APPROXNUM = 57546
-const SIGNED = 57547
-const UNSIGNED = 57548
-const ZEROFILL = 57549
-const COLLATION = 57550
-const DATABASES = 57551
-const SCHEMAS = 57552
-const TABLES = 57553
-const VITESS_KEYSPACES = 57554
-const VITESS_SHARDS = 57555
-const VITESS_TABLETS = 57556
-const VSCHEMA = 57557
-const VSCHEMA_TABLES = 57558
-const VITESS_TARGET = 57559
-const FULL = 57560
-const PROCESSLIST = 57561
-const COLUMNS = 57562
-const FIELDS = 57563
-const ENGINES = 57564
-const PLUGINS = 57565
-const NAMES = 57566
-const CHARSET = 57567
-const GLOBAL = 57568
-const SESSION = 57569
-const ISOLATION = 57570
-const LEVEL = 57571
-const READ = 57572
-const WRITE = 57573
-const ONLY = 57574
-const REPEATABLE = 57575
-const COMMITTED = 57576
-const UNCOMMITTED = 57577
-const SERIALIZABLE = 57578
-const CURRENT_TIMESTAMP = 57579
-const DATABASE = 57580
-const CURRENT_DATE = 57581
-const CURRENT_TIME = 57582
-const LOCALTIME = 57583
-const LOCALTIMESTAMP = 57584
-const UTC_DATE = 57585
-const UTC_TIME = 57586
-const UTC_TIMESTAMP = 57587
-const REPLACE = 57588
-const CONVERT = 57589
-const CAST = 57590
-const SUBSTR = 57591
-const SUBSTRING = 57592
-const GROUP_CONCAT = 57593
-const SEPARATOR = 57594
-const TIMESTAMPADD = 57595
-const TIMESTAMPDIFF = 57596
-const MATCH = 57597
-const AGAINST = 57598
-const BOOLEAN = 57599
-const LANGUAGE = 57600
-const WITH = 57601
-const QUERY = 57602
-const EXPANSION = 57603
-const UNUSED = 57604
+const OVER = 57366
+const ALL = 57367
+const DISTINCT = 57368
+const AS = 57369
+const EXISTS = 57370
+const ASC = 57371
+const DESC = 57372
+const INTO = 57373
+const DUPLICATE = 57374
+const KEY = 57375
+const DEFAULT = 57376
+const SET = 57377
+const LOCK = 57378
+const UNLOCK = 57379
+const KEYS = 57380
+const VALUES = 57381
+const LAST_INSERT_ID = 57382
+const NEXT = 57383
+const VALUE = 57384
+const SHARE = 57385
+const MODE = 57386
+const SQL_NO_CACHE = 57387
+const SQL_CACHE = 57388
+const JOIN = 57389
+const STRAIGHT_JOIN = 57390
+const LOOKUP = 57391
+const LEFT = 57392
+const RIGHT = 57393
+const INNER = 57394
+const OUTER = 57395
+const CROSS = 57396
+const NATURAL = 57397
+const USE = 57398
+const FORCE = 57399
+const ON = 57400
+const USING = 57401
+const ID = 57402
+const HEX = 57403
+const STRING = 57404
+const INTEGRAL = 57405
+const FLOAT = 57406
+const HEXNUM = 57407
+const VALUE_ARG = 57408
+const LIST_ARG = 57409
+const COMMENT = 57410
+const COMMENT_KEYWORD = 57411
+const BIT_LITERAL = 57412
+const NULL = 57413
+const TRUE = 57414
+const FALSE = 57415
+const OFF = 57416
+const OR = 57417
+const AND = 57418
+const NOT = 57419
+const BETWEEN = 57420
+const CASE = 57421
+const WHEN = 57422
+const THEN = 57423
+const ELSE = 57424
+const END = 57425
+const OF = 57426
+const LE = 57427
+const GE = 57428
+const NE = 57429
+const NULL_SAFE_EQUAL = 57430
+const IS = 57431
+const LIKE = 57432
+const REGEXP = 57433
+const IN = 57434
+const RIGHTARROW = 57435
+const SHIFT_LEFT = 57436
+const SHIFT_RIGHT = 57437
+const DIV = 57438
+const MOD = 57439
+const NOT_LIKE_REGEXP = 57440
+const LIKE_REGEXP_CASE_INSENSITIVE = 57441
+const NOT_LIKE_REGEXP_CASE_INSENSITIVE = 57442
+const UNARY = 57443
+const COLLATE = 57444
+const BINARY = 57445
+const UNDERSCORE_BINARY = 57446
+const UNDERSCORE_UTF8MB4 = 57447
+const INTERVAL = 57448
+const JSON_EXTRACT_OP = 57449
+const JSON_UNQUOTE_EXTRACT_OP = 57450
+const CREATE = 57451
+const ALTER = 57452
+const DROP = 57453
+const RENAME = 57454
+const ANALYZE = 57455
+const ADD = 57456
+const FLUSH = 57457
+const SCHEMA = 57458
+const TABLE = 57459
+const DESCRIPTOR = 57460
+const INDEX = 57461
+const VIEW = 57462
+const TO = 57463
+const IGNORE = 57464
+const IF = 57465
+const UNIQUE = 57466
+const PRIMARY = 57467
+const COLUMN = 57468
+const SPATIAL = 57469
+const FULLTEXT = 57470
+const KEY_BLOCK_SIZE = 57471
+const ACTION = 57472
+const CASCADE = 57473
+const CONSTRAINT = 57474
+const FOREIGN = 57475
+const NO = 57476
+const REFERENCES = 57477
+const RESTRICT = 57478
+const SHOW = 57479
+const DESCRIBE = 57480
+const EXPLAIN = 57481
+const DATE = 57482
+const ESCAPE = 57483
+const REPAIR = 57484
+const OPTIMIZE = 57485
+const TRUNCATE = 57486
+const MAXVALUE = 57487
+const PARTITION = 57488
+const REORGANIZE = 57489
+const LESS = 57490
+const THAN = 57491
+const PROCEDURE = 57492
+const TRIGGER = 57493
+const VINDEX = 57494
+const VINDEXES = 57495
+const STATUS = 57496
+const VARIABLES = 57497
+const WARNINGS = 57498
+const BEGIN = 57499
+const START = 57500
+const TRANSACTION = 57501
+const COMMIT = 57502
+const ROLLBACK = 57503
+const BIT = 57504
+const TINYINT = 57505
+const SMALLINT = 57506
+const MEDIUMINT = 57507
+const INT = 57508
+const INTEGER = 57509
+const BIGINT = 57510
+const INTNUM = 57511
+const REAL = 57512
+const DOUBLE = 57513
+const FLOAT_TYPE = 57514
+const DECIMAL = 57515
+const NUMERIC = 57516
+const TIME = 57517
+const TIMESTAMP = 57518
+const DATETIME = 57519
+const YEAR = 57520
+const CHAR = 57521
+const VARCHAR = 57522
+const BOOL = 57523
+const CHARACTER = 57524
+const VARBINARY = 57525
+const NCHAR = 57526
+const TEXT = 57527
+const TINYTEXT = 57528
+const MEDIUMTEXT = 57529
+const LONGTEXT = 57530
+const BLOB = 57531
+const TINYBLOB = 57532
+const MEDIUMBLOB = 57533
+const LONGBLOB = 57534
+const JSON = 57535
+const ENUM = 57536
+const GEOMETRY = 57537
+const POINT = 57538
+const LINESTRING = 57539
+const POLYGON = 57540
+const GEOMETRYCOLLECTION = 57541
+const MULTIPOINT = 57542
+const MULTILINESTRING = 57543
+const MULTIPOLYGON = 57544
+const NULLX = 57545
+const AUTO_INCREMENT = 57546
+const APPROXNUM = 57547
+const SIGNED = 57548
+const UNSIGNED = 57549
+const ZEROFILL = 57550
+const COLLATION = 57551
+const DATABASES = 57552
+const SCHEMAS = 57553
+const TABLES = 57554
+const VITESS_KEYSPACES = 57555
+const VITESS_SHARDS = 57556
+const VITESS_TABLETS = 57557
+const VSCHEMA = 57558
+const VSCHEMA_TABLES = 57559
+const VITESS_TARGET = 57560
+const FULL = 57561
+const PROCESSLIST = 57562
+const COLUMNS = 57563
+const FIELDS = 57564
+const ENGINES = 57565
+const PLUGINS = 57566
+const NAMES = 57567
+const CHARSET = 57568
+const GLOBAL = 57569
+const SESSION = 57570
+const ISOLATION = 57571
+const LEVEL = 57572
+const READ = 57573
+const WRITE = 57574
+const ONLY = 57575
+const REPEATABLE = 57576
+const COMMITTED = 57577
+const UNCOMMITTED = 57578
+const SERIALIZABLE = 57579
+const CURRENT_TIMESTAMP = 57580
+const DATABASE = 57581
+const CURRENT_DATE = 57582
+const CURRENT_TIME = 57583
+const LOCALTIME = 57584
+const LOCALTIMESTAMP = 57585
+const UTC_DATE = 57586
+const UTC_TIME = 57587
+const UTC_TIMESTAMP = 57588
+const REPLACE = 57589
+const CONVERT = 57590
+const CAST = 57591
+const SUBSTR = 57592
+const SUBSTRING = 57593
+const GROUP_CONCAT = 57594
+const SEPARATOR = 57595
+const TIMESTAMPADD = 57596
+const TIMESTAMPDIFF = 57597
+const MATCH = 57598
+const AGAINST = 57599
+const BOOLEAN = 57600
+const LANGUAGE = 57601
+const WITH = 57602
+const QUERY = 57603
+const EXPANSION = 57604
+const UNUSED = 57605
 
 var yyToknames = [...]string{
 	"$end",
@@ -401,6 +425,7 @@ var yyToknames = [...]string{
 	"DELAY",
 	"COUNTING",
 	"AFTER",
+	"OVER",
 	"ALL",
 	"DISTINCT",
 	"AS",
@@ -672,1002 +697,717 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 38,
-	170, 303,
-	171, 303,
-	-2, 293,
-	-1, 282,
-	122, 666,
-	-2, 662,
-	-1, 283,
-	122, 667,
-	-2, 663,
-	-1, 351,
-	88, 847,
-	-2, 68,
-	-1, 352,
-	88, 802,
-	-2, 69,
-	-1, 357,
-	88, 778,
-	-2, 628,
-	-1, 359,
-	88, 823,
-	-2, 630,
-	-1, 634,
-	46, 387,
-	51, 387,
-	53, 387,
-	-2, 349,
-	-1, 638,
-	1, 355,
-	7, 355,
-	12, 355,
-	13, 355,
-	14, 355,
-	15, 355,
-	17, 355,
-	19, 355,
-	34, 355,
-	35, 355,
-	46, 355,
-	47, 355,
-	48, 355,
-	49, 355,
-	50, 355,
-	51, 355,
-	53, 355,
-	54, 355,
-	57, 355,
-	58, 355,
-	60, 355,
-	61, 355,
-	167, 355,
-	280, 355,
-	-2, 382,
-	-1, 642,
-	58, 49,
-	60, 49,
-	-2, 53,
-	-1, 787,
-	122, 669,
-	-2, 665,
-	-1, 1030,
+	-1, 22,
 	5, 35,
-	-2, 456,
-	-1, 1066,
-	46, 387,
-	51, 387,
-	53, 387,
-	-2, 350,
-	-1, 1301,
-	5, 35,
-	-2, 603,
-	-1, 1443,
-	5, 35,
-	-2, 606,
+	-2, 586,
+	-1, 38,
+	171, 305,
+	172, 305,
+	-2, 295,
+	-1, 264,
+	5, 37,
+	-2, 586,
+	-1, 347,
+	89, 856,
+	-2, 70,
+	-1, 348,
+	89, 811,
+	-2, 71,
+	-1, 353,
+	89, 787,
+	-2, 636,
+	-1, 355,
+	89, 832,
+	-2, 638,
+	-1, 631,
+	47, 389,
+	50, 389,
+	51, 389,
+	52, 389,
+	54, 389,
+	-2, 351,
+	-1, 635,
+	1, 357,
+	5, 357,
+	7, 357,
+	12, 357,
+	13, 357,
+	14, 357,
+	15, 357,
+	17, 357,
+	19, 357,
+	35, 357,
+	36, 357,
+	47, 357,
+	48, 357,
+	49, 357,
+	50, 357,
+	51, 357,
+	52, 357,
+	54, 357,
+	55, 357,
+	58, 357,
+	59, 357,
+	61, 357,
+	62, 357,
+	168, 357,
+	281, 357,
+	-2, 384,
+	-1, 639,
+	59, 51,
+	61, 51,
+	-2, 55,
+	-1, 1023,
+	5, 36,
+	-2, 458,
+	-1, 1060,
+	123, 674,
+	-2, 670,
+	-1, 1061,
+	123, 675,
+	-2, 671,
+	-1, 1062,
+	47, 389,
+	50, 389,
+	51, 389,
+	52, 389,
+	54, 389,
+	-2, 352,
+	-1, 1302,
+	5, 36,
+	-2, 611,
+	-1, 1313,
+	123, 677,
+	-2, 673,
+	-1, 1465,
+	5, 36,
+	-2, 614,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 14482
+const yyLast = 13769
 
-var yyAct = [...]int{
-	283, 1493, 1483, 1455, 1269, 1429, 1159, 1063, 1342, 594,
-	1374, 1329, 1086, 1205, 908, 300, 1243, 62, 883, 258,
-	1206, 1084, 1222, 634, 66, 878, 1202, 1021, 287, 1064,
-	904, 987, 1113, 208, 1212, 249, 917, 66, 832, 313,
-	66, 58, 907, 937, 635, 816, 1015, 1092, 751, 1139,
-	488, 738, 850, 1130, 921, 655, 870, 789, 523, 289,
-	880, 517, 820, 951, 947, 350, 345, 931, 458, 863,
-	829, 285, 532, 270, 540, 641, 342, 347, 644, 356,
-	608, 250, 251, 252, 253, 654, 1184, 256, 1183, 1181,
-	1180, 57, 1486, 1461, 25, 570, 1481, 609, 570, 593,
-	3, 1441, 1477, 570, 1270, 548, 1460, 555, 1194, 1293,
-	463, 1440, 220, 1237, 571, 572, 573, 574, 575, 576,
-	577, 25, 549, 554, 547, 570, 557, 556, 566, 567,
-	559, 560, 561, 562, 563, 564, 565, 558, 550, 552,
-	551, 553, 61, 568, 570, 558, 568, 55, 656, 1058,
-	657, 568, 257, 1059, 1402, 898, 557, 556, 566, 567,
-	559, 560, 561, 562, 563, 564, 565, 558, 25, 1238,
-	1239, 255, 831, 568, 55, 557, 556, 566, 567, 559,
-	560, 561, 562, 563, 564, 565, 558, 1101, 511, 210,
-	1100, 212, 568, 1102, 66, 208, 899, 900, 507, 66,
-	1358, 66, 254, 1121, 930, 1332, 508, 505, 506, 516,
-	188, 66, 938, 248, 66, 218, 214, 570, 215, 216,
-	66, 55, 476, 66, 1479, 208, 1162, 208, 208, 274,
-	208, 208, 22, 208, 1161, 208, 727, 190, 191, 192,
-	193, 194, 464, 1435, 208, 353, 725, 510, 557, 556,
-	566, 567, 559, 560, 561, 562, 563, 564, 565, 558,
-	209, 500, 501, 66, 1473, 568, 1430, 1158, 864, 344,
-	1497, 726, 1422, 922, 460, 1501, 462, 208, 1296, 477,
-	1375, 1383, 1087, 1089, 465, 211, 469, 570, 490, 475,
-	513, 514, 212, 1377, 486, 482, 1163, 325, 484, 331,
-	332, 329, 330, 328, 327, 326, 528, 569, 731, 718,
-	569, 924, 1232, 333, 334, 569, 1231, 924, 557, 556,
-	566, 567, 559, 560, 561, 562, 563, 564, 565, 558,
-	1295, 1439, 1403, 1230, 461, 568, 728, 569, 217, 570,
-	66, 66, 66, 468, 580, 1155, 222, 1114, 213, 208,
-	1409, 1157, 1039, 314, 52, 208, 569, 1018, 905, 492,
-	981, 525, 494, 980, 23, 1088, 529, 1376, 582, 583,
-	557, 556, 566, 567, 559, 560, 561, 562, 563, 564,
-	565, 558, 1304, 1495, 1036, 1169, 1496, 568, 1494, 1384,
-	1382, 23, 491, 493, 570, 1097, 1049, 1009, 760, 353,
-	638, 633, 650, 473, 544, 526, 52, 611, 613, 615,
-	617, 619, 621, 622, 923, 632, 483, 642, 643, 265,
-	923, 894, 648, 1255, 612, 614, 652, 618, 620, 569,
-	623, 561, 562, 563, 564, 565, 558, 855, 23, 479,
-	480, 481, 568, 1229, 796, 757, 466, 467, 752, 538,
-	537, 459, 339, 340, 1156, 537, 1154, 989, 66, 794,
-	795, 793, 539, 208, 582, 583, 197, 539, 66, 66,
-	208, 470, 539, 471, 66, 1420, 472, 66, 530, 1256,
-	66, 489, 924, 1392, 66, 457, 208, 1216, 658, 1475,
-	208, 208, 208, 66, 208, 208, 582, 583, 1196, 569,
-	1467, 208, 208, 198, 851, 759, 1046, 851, 720, 763,
-	764, 1119, 459, 538, 537, 1449, 55, 779, 781, 782,
-	1198, 495, 496, 780, 497, 498, 792, 499, 927, 502,
-	1502, 539, 208, 666, 928, 753, 66, 1034, 512, 1033,
-	266, 988, 208, 722, 723, 1425, 534, 758, 766, 729,
-	1035, 569, 344, 732, 1447, 735, 538, 537, 538, 537,
-	1338, 1337, 1421, 740, 538, 537, 1468, 817, 745, 818,
-	1503, 822, 1134, 1133, 539, 1122, 539, 1103, 487, 1104,
-	487, 487, 539, 487, 487, 923, 487, 790, 487, 1353,
-	920, 918, 208, 919, 1335, 786, 785, 487, 916, 922,
-	1166, 538, 537, 1131, 768, 1418, 569, 791, 1006, 1007,
-	1008, 775, 1380, 1478, 516, 52, 783, 527, 1272, 539,
-	52, 1451, 516, 1389, 787, 208, 208, 765, 1380, 1433,
-	841, 844, 66, 1380, 516, 579, 852, 1114, 581, 1109,
-	66, 825, 66, 1380, 1410, 66, 66, 1380, 1379, 66,
-	66, 66, 208, 737, 826, 827, 1327, 1326, 1388, 885,
-	1306, 516, 1303, 516, 1252, 208, 592, 736, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 848, 607, 610,
-	610, 610, 616, 610, 610, 616, 610, 624, 625, 626,
-	627, 628, 629, 721, 639, 515, 836, 719, 860, 638,
-	1262, 1261, 353, 716, 638, 889, 485, 865, 638, 891,
-	1258, 1259, 887, 1258, 1257, 909, 892, 895, 646, 66,
-	208, 890, 208, 939, 940, 941, 208, 208, 66, 66,
-	740, 66, 66, 478, 912, 66, 208, 1028, 516, 896,
-	867, 516, 933, 934, 935, 936, 834, 516, 665, 664,
-	1093, 66, 1203, 66, 66, 1215, 66, 717, 944, 945,
-	946, 1093, 925, 59, 724, 647, 646, 649, 1172, 1466,
-	837, 838, 1215, 834, 843, 846, 847, 949, 950, 953,
-	741, 888, 1299, 645, 742, 743, 744, 1391, 746, 747,
-	867, 1260, 866, 1228, 956, 748, 749, 1182, 867, 859,
-	1105, 861, 862, 978, 979, 897, 982, 983, 1028, 1215,
-	984, 786, 996, 647, 1052, 645, 487, 1028, 867, 1051,
-	997, 570, 1028, 487, 822, 1024, 986, 645, 999, 651,
-	761, 992, 730, 790, 262, 55, 1462, 267, 1344, 487,
-	787, 1458, 1457, 487, 487, 487, 932, 487, 487, 1314,
-	1248, 1011, 1108, 791, 487, 487, 559, 560, 561, 562,
-	563, 564, 565, 558, 1223, 1224, 1488, 952, 66, 568,
-	66, 66, 66, 948, 943, 942, 1456, 774, 1160, 66,
-	955, 52, 66, 208, 1484, 1250, 1019, 66, 312, 66,
-	55, 1066, 1221, 1203, 1072, 1065, 303, 302, 305, 306,
-	307, 308, 1045, 1135, 1091, 304, 309, 755, 208, 734,
-	1068, 1226, 1076, 1225, 1071, 1069, 1073, 1070, 1077, 873,
-	1074, 206, 1106, 1219, 1218, 1471, 1075, 638, 1459, 638,
-	638, 638, 271, 272, 1079, 52, 1168, 1095, 993, 1096,
-	1078, 638, 1464, 875, 876, 1004, 1005, 1003, 638, 1094,
-	596, 1126, 663, 1098, 1115, 1118, 208, 208, 909, 874,
-	872, 875, 876, 1060, 533, 1427, 877, 767, 1125, 1426,
-	1127, 1128, 1129, 516, 1111, 1112, 518, 1123, 1124, 531,
-	1356, 836, 1116, 1110, 1297, 208, 1340, 958, 733, 879,
-	268, 269, 519, 881, 882, 263, 1138, 1132, 639, 533,
-	1469, 66, 639, 1027, 570, 1002, 259, 873, 1396, 260,
-	208, 59, 1151, 1001, 957, 1395, 959, 1346, 1093, 509,
-	1040, 1043, 276, 1490, 1489, 1480, 1037, 750, 535, 1490,
-	985, 833, 835, 569, 822, 1165, 822, 566, 567, 559,
-	560, 561, 562, 563, 564, 565, 558, 874, 872, 875,
-	876, 1406, 568, 1333, 877, 756, 187, 1223, 1224, 1176,
-	1174, 189, 208, 208, 56, 1, 1204, 1186, 66, 1482,
-	1271, 1341, 964, 487, 1428, 487, 1170, 868, 1373, 1242,
-	1188, 1175, 915, 355, 906, 1195, 196, 1065, 456, 487,
-	195, 208, 1419, 914, 913, 1381, 1187, 1331, 1189, 1207,
-	1199, 996, 926, 1120, 929, 1249, 208, 1117, 208, 208,
-	1424, 1214, 1217, 355, 671, 355, 355, 669, 355, 355,
-	670, 355, 1241, 355, 668, 1233, 673, 638, 672, 787,
-	667, 1022, 355, 1020, 1234, 233, 66, 348, 659, 873,
-	1010, 954, 1236, 536, 1246, 1247, 1245, 199, 1240, 1153,
-	1152, 960, 503, 66, 1253, 1254, 909, 504, 909, 208,
-	235, 1209, 208, 208, 66, 542, 578, 1000, 1099, 354,
-	208, 1210, 1454, 66, 1434, 1264, 762, 522, 1394, 874,
-	872, 875, 876, 1024, 822, 822, 877, 1265, 1345, 1267,
-	1044, 605, 849, 288, 778, 301, 298, 1276, 299, 769,
-	1057, 546, 286, 998, 278, 637, 630, 871, 869, 1067,
-	1281, 1263, 343, 1220, 1310, 1317, 569, 1061, 1062, 1277,
-	1174, 639, 1082, 639, 639, 639, 1083, 636, 1266, 208,
-	1171, 1298, 638, 1278, 1292, 881, 1401, 355, 1090, 1275,
-	1311, 208, 639, 660, 1065, 1308, 1282, 1283, 773, 208,
-	1316, 1137, 27, 1315, 1325, 1106, 1307, 186, 273, 19,
-	18, 17, 20, 1025, 208, 16, 15, 1026, 14, 474,
-	31, 208, 21, 13, 1030, 1031, 1032, 12, 11, 1164,
-	10, 1038, 520, 524, 1041, 1042, 9, 8, 7, 6,
-	1048, 909, 5, 4, 1050, 60, 261, 1053, 1054, 1055,
-	1056, 1334, 545, 1336, 208, 208, 264, 208, 24, 2,
-	487, 0, 1328, 0, 0, 208, 66, 0, 1081, 0,
-	1357, 1343, 208, 208, 208, 66, 1365, 1364, 208, 0,
-	0, 0, 0, 1369, 1370, 1371, 1146, 595, 487, 1207,
-	0, 0, 885, 1372, 1378, 208, 606, 0, 0, 1385,
-	0, 355, 0, 0, 0, 0, 1393, 0, 355, 0,
-	0, 0, 0, 0, 0, 1386, 1144, 1387, 66, 0,
-	0, 0, 1407, 0, 355, 0, 0, 0, 355, 355,
-	355, 208, 355, 355, 1417, 1416, 0, 0, 0, 355,
-	355, 1411, 208, 208, 0, 0, 0, 1412, 1207, 0,
-	1431, 0, 1359, 0, 0, 280, 1437, 1432, 0, 0,
-	208, 0, 0, 1208, 1442, 52, 0, 0, 1290, 0,
-	770, 639, 0, 66, 0, 0, 0, 638, 0, 0,
-	542, 208, 0, 355, 0, 1065, 0, 0, 0, 1145,
-	1453, 0, 1343, 909, 1150, 1147, 1140, 1148, 1143, 0,
-	0, 0, 1141, 1142, 0, 0, 0, 1463, 1465, 0,
-	1408, 0, 0, 208, 0, 1185, 1149, 0, 570, 0,
-	1474, 0, 0, 0, 1472, 0, 0, 0, 0, 0,
-	828, 1289, 0, 0, 0, 1487, 0, 0, 0, 0,
-	0, 0, 1498, 0, 0, 0, 853, 0, 1448, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 0, 0, 857, 858, 0, 568, 0, 0, 0,
-	1227, 0, 0, 0, 0, 0, 639, 0, 0, 0,
-	0, 570, 754, 0, 0, 0, 0, 0, 0, 0,
-	355, 0, 0, 0, 0, 0, 0, 0, 1291, 0,
-	0, 0, 0, 355, 0, 0, 570, 0, 1339, 0,
-	776, 777, 557, 556, 566, 567, 559, 560, 561, 562,
-	563, 564, 565, 558, 0, 0, 0, 0, 1288, 568,
-	0, 0, 0, 0, 1321, 1322, 1323, 557, 556, 566,
-	567, 559, 560, 561, 562, 563, 564, 565, 558, 0,
-	0, 0, 0, 570, 568, 0, 0, 0, 355, 0,
-	355, 0, 1177, 1279, 976, 977, 0, 487, 0, 595,
-	0, 0, 839, 840, 355, 1284, 1285, 1286, 570, 0,
-	0, 0, 0, 0, 557, 556, 566, 567, 559, 560,
-	561, 562, 563, 564, 565, 558, 1300, 1301, 1302, 355,
-	1305, 568, 0, 1208, 0, 0, 1360, 0, 0, 557,
-	556, 566, 567, 559, 560, 561, 562, 563, 564, 565,
-	558, 1324, 0, 1367, 1368, 1287, 568, 0, 0, 0,
-	569, 903, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1390, 0, 584, 585, 586, 587,
-	588, 589, 590, 591, 0, 0, 0, 0, 0, 0,
-	0, 0, 1208, 0, 52, 0, 0, 0, 0, 0,
-	0, 639, 0, 0, 0, 570, 0, 0, 0, 0,
-	1352, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 569, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 853, 557, 556, 566, 567,
-	559, 560, 561, 562, 563, 564, 565, 558, 569, 0,
-	0, 1085, 0, 568, 0, 0, 0, 0, 0, 0,
-	0, 994, 995, 0, 524, 1397, 1398, 1399, 1400, 570,
-	0, 0, 1404, 1405, 0, 0, 355, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1413, 1414, 1415,
-	0, 0, 0, 0, 0, 569, 0, 0, 0, 0,
-	557, 556, 566, 567, 559, 560, 561, 562, 563, 564,
-	565, 558, 0, 1485, 0, 0, 0, 568, 0, 1438,
-	569, 0, 0, 0, 1136, 355, 1443, 0, 0, 1445,
-	1446, 0, 0, 0, 0, 0, 0, 1029, 0, 0,
-	0, 25, 26, 53, 28, 29, 1450, 0, 0, 0,
-	0, 0, 0, 355, 1047, 0, 0, 1016, 0, 0,
-	0, 0, 0, 0, 44, 521, 0, 970, 0, 30,
-	49, 50, 0, 0, 0, 0, 0, 0, 355, 0,
-	0, 0, 0, 0, 0, 969, 0, 0, 0, 63,
-	39, 0, 0, 0, 55, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 247, 0, 0, 0, 1499,
-	1500, 0, 0, 0, 974, 0, 0, 569, 355, 0,
-	0, 0, 0, 968, 0, 0, 0, 853, 0, 0,
-	1211, 1213, 788, 0, 0, 797, 798, 799, 800, 801,
-	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
-	812, 813, 814, 815, 0, 819, 0, 0, 0, 1213,
-	32, 33, 35, 34, 37, 570, 51, 0, 0, 0,
-	0, 0, 0, 0, 355, 0, 355, 1244, 965, 962,
-	963, 569, 961, 0, 0, 0, 0, 0, 38, 45,
-	46, 0, 1167, 47, 48, 36, 856, 556, 566, 567,
-	559, 560, 561, 562, 563, 564, 565, 558, 40, 41,
-	0, 42, 43, 568, 972, 975, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1268, 0, 0,
-	1273, 1274, 0, 0, 0, 0, 0, 0, 355, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1197,
-	967, 0, 0, 0, 0, 0, 277, 0, 0, 346,
-	0, 0, 0, 0, 221, 0, 221, 0, 0, 0,
-	0, 0, 966, 0, 0, 0, 221, 0, 0, 221,
-	0, 0, 0, 0, 853, 221, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 1235, 1085, 54, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	570, 23, 230, 0, 0, 0, 971, 1330, 0, 1017,
-	0, 0, 0, 0, 0, 0, 0, 0, 63, 0,
-	0, 973, 355, 0, 0, 0, 0, 243, 0, 355,
-	0, 557, 556, 566, 567, 559, 560, 561, 562, 563,
-	564, 565, 558, 0, 0, 0, 0, 0, 568, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1361, 1362, 0, 1363, 0, 569, 0, 0,
-	1012, 1013, 1014, 1330, 0, 0, 0, 0, 0, 0,
-	1330, 1330, 1330, 223, 0, 0, 1244, 0, 0, 0,
-	225, 1294, 0, 0, 0, 221, 221, 221, 234, 0,
-	229, 595, 0, 1330, 0, 0, 0, 0, 0, 1309,
-	0, 0, 0, 0, 1312, 0, 1313, 0, 0, 0,
-	0, 0, 1318, 0, 0, 0, 0, 853, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 242, 0, 1423,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 853, 0, 0, 1444, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1452,
-	236, 226, 227, 0, 237, 238, 239, 241, 0, 240,
-	246, 0, 0, 0, 228, 231, 0, 224, 245, 244,
-	0, 0, 569, 221, 0, 0, 0, 0, 0, 0,
-	0, 1330, 0, 221, 221, 0, 0, 0, 0, 221,
-	0, 0, 221, 0, 0, 221, 0, 0, 0, 739,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 688, 1436, 595,
-	0, 221, 1178, 1179, 0, 0, 0, 0, 0, 0,
-	739, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1190, 1191, 0, 1192, 1193, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1200, 1201, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
-	0, 1470, 0, 277, 277, 0, 0, 277, 277, 277,
-	0, 0, 1476, 854, 0, 0, 0, 0, 0, 0,
-	0, 676, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 277, 277, 277, 277, 0, 221, 0, 0,
-	0, 0, 0, 0, 1251, 221, 0, 63, 0, 0,
-	221, 221, 0, 0, 221, 893, 739, 0, 0, 689,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 702, 705, 706, 707, 708, 709, 710, 0, 711,
-	712, 713, 714, 715, 690, 691, 692, 693, 674, 675,
-	703, 0, 677, 1280, 678, 679, 680, 681, 682, 683,
-	684, 685, 686, 687, 694, 695, 696, 697, 698, 699,
-	700, 701, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 221, 0, 221, 221, 0, 0,
-	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 990, 991,
-	0, 221, 0, 0, 0, 0, 739, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 704, 0, 277,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1347, 1348, 1349, 1350, 1351, 0, 0,
-	0, 1354, 1355, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 854, 221, 0, 221, 221, 221, 0, 0,
-	0, 0, 0, 0, 1080, 0, 0, 221, 0, 0,
-	0, 0, 63, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 1491, 0, 0, 0, 0, 277, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 739, 0, 0, 0, 0,
-	0, 0, 0, 0, 854, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 0, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 821, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 854, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 1366, 0, 0, 0, 0, 0, 0, 0, 0,
-	63, 0, 0, 68, 75, 110, 0, 138, 95, 168,
-	0, 823, 0, 824, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 854, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 854, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 443, 431, 221, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 207, 0, 910, 911, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 1107,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 207, 0,
-	910, 911, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	55, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 207, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 1173, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 894, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 282, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 784, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
+var yyAct = [...]int16{
+	310, 52, 1516, 1506, 1478, 1449, 1336, 1153, 1265, 1055,
+	900, 591, 1388, 1349, 1080, 1166, 1200, 631, 309, 1057,
+	62, 875, 58, 296, 1239, 1201, 519, 1078, 1315, 352,
+	258, 929, 923, 735, 590, 3, 1197, 896, 1107, 979,
+	870, 899, 909, 1015, 1009, 816, 1207, 1056, 782, 632,
+	1124, 1064, 785, 52, 520, 789, 748, 1133, 846, 206,
+	820, 652, 514, 521, 943, 455, 913, 346, 530, 872,
+	538, 270, 651, 858, 338, 343, 939, 341, 641, 1180,
+	1086, 1179, 1177, 1176, 57, 1509, 1484, 257, 1504, 1463,
+	606, 25, 1500, 1266, 569, 569, 1483, 1190, 1294, 460,
+	1233, 605, 1234, 1235, 547, 890, 554, 61, 569, 891,
+	892, 255, 508, 570, 571, 572, 573, 574, 575, 576,
+	1462, 548, 553, 546, 285, 556, 555, 565, 566, 558,
+	559, 560, 561, 562, 563, 564, 557, 549, 551, 550,
+	552, 569, 567, 567, 653, 55, 654, 757, 757, 504,
+	557, 254, 855, 210, 249, 212, 567, 505, 502, 503,
+	1115, 757, 218, 214, 922, 215, 216, 1339, 461, 930,
+	1421, 507, 556, 555, 565, 566, 558, 559, 560, 561,
+	562, 563, 564, 557, 248, 25, 473, 266, 22, 567,
+	849, 724, 1095, 1442, 757, 1094, 1156, 1155, 1096, 722,
+	250, 251, 252, 253, 1455, 221, 256, 321, 1502, 327,
+	328, 325, 326, 324, 323, 322, 1496, 277, 1370, 497,
+	498, 351, 1450, 329, 330, 484, 723, 484, 484, 63,
+	484, 484, 1355, 484, 1520, 484, 1152, 859, 914, 55,
+	1524, 474, 462, 274, 484, 247, 1397, 962, 25, 211,
+	212, 351, 569, 351, 351, 1389, 351, 351, 1157, 351,
+	728, 351, 52, 715, 525, 209, 961, 52, 1391, 1228,
+	351, 916, 1227, 1081, 1083, 1226, 458, 1050, 543, 725,
+	465, 1051, 579, 222, 213, 217, 1428, 526, 1377, 560,
+	561, 562, 563, 564, 557, 966, 523, 1305, 1212, 589,
+	567, 527, 55, 540, 960, 757, 568, 568, 1091, 1032,
+	647, 593, 594, 595, 596, 597, 598, 599, 600, 601,
+	568, 604, 607, 607, 607, 613, 607, 607, 613, 607,
+	621, 622, 623, 624, 625, 626, 973, 636, 188, 972,
+	1461, 480, 1390, 55, 1251, 897, 886, 1518, 1422, 569,
+	1519, 1225, 1517, 568, 1398, 1396, 1082, 485, 55, 957,
+	954, 955, 23, 953, 749, 190, 191, 192, 193, 194,
+	524, 351, 463, 464, 915, 265, 487, 657, 981, 754,
+	556, 555, 565, 566, 558, 559, 560, 561, 562, 563,
+	564, 557, 1149, 513, 1440, 964, 967, 567, 1151, 342,
+	1252, 569, 757, 476, 477, 478, 630, 542, 537, 510,
+	511, 456, 640, 609, 611, 1406, 615, 617, 645, 620,
+	649, 1029, 542, 588, 608, 610, 612, 614, 616, 618,
+	619, 959, 556, 555, 565, 566, 558, 559, 560, 561,
+	562, 563, 564, 557, 1211, 454, 916, 489, 512, 567,
+	491, 655, 750, 958, 757, 1498, 23, 335, 336, 470,
+	484, 635, 796, 980, 568, 535, 1490, 484, 63, 1192,
+	55, 197, 536, 535, 276, 847, 1108, 794, 795, 793,
+	488, 490, 537, 484, 717, 756, 351, 484, 484, 484,
+	537, 484, 484, 351, 776, 778, 779, 963, 484, 484,
+	777, 1150, 1027, 1148, 1026, 760, 761, 1113, 198, 351,
+	737, 1525, 965, 351, 351, 351, 1445, 351, 351, 23,
+	543, 536, 535, 532, 351, 351, 52, 467, 755, 468,
+	1470, 919, 469, 1491, 542, 1297, 1028, 920, 1358, 537,
+	536, 535, 1438, 55, 569, 536, 535, 1194, 729, 915,
+	1357, 1526, 349, 792, 536, 535, 767, 763, 537, 1345,
+	762, 568, 1012, 537, 1344, 790, 540, 1182, 1181, 486,
+	277, 351, 537, 1140, 1128, 556, 555, 565, 566, 558,
+	559, 560, 561, 562, 563, 564, 557, 536, 535, 847,
+	52, 1039, 567, 817, 593, 818, 1472, 757, 1127, 1441,
+	765, 1116, 1097, 1138, 1098, 537, 528, 997, 998, 999,
+	780, 1365, 1342, 568, 828, 1160, 1125, 1394, 1501, 867,
+	1268, 277, 277, 1474, 513, 837, 840, 277, 832, 1394,
+	1467, 848, 1394, 1453, 1394, 513, 513, 873, 874, 1394,
+	1393, 1415, 636, 758, 513, 1414, 636, 1108, 851, 852,
+	277, 277, 277, 277, 518, 1334, 1333, 1307, 513, 877,
+	868, 866, 1304, 513, 1258, 1257, 1403, 869, 1254, 1255,
+	844, 791, 1103, 854, 513, 351, 1139, 737, 1254, 1253,
+	1402, 1144, 1141, 1134, 1142, 1137, 736, 827, 351, 1135,
+	1136, 1002, 513, 862, 513, 1248, 784, 916, 830, 513,
+	59, 734, 349, 1143, 925, 926, 927, 928, 931, 932,
+	933, 881, 733, 879, 718, 883, 887, 484, 764, 484,
+	936, 937, 938, 888, 884, 716, 713, 456, 904, 662,
+	661, 1087, 482, 484, 517, 522, 475, 1198, 917, 861,
+	1210, 643, 643, 351, 1087, 351, 1002, 833, 834, 968,
+	969, 839, 842, 843, 1165, 577, 568, 1003, 880, 351,
+	642, 635, 1489, 1210, 830, 862, 635, 1300, 1405, 862,
+	635, 1284, 1283, 1256, 1224, 1178, 853, 945, 856, 857,
+	862, 829, 831, 1099, 351, 592, 941, 942, 1004, 644,
+	644, 646, 642, 1210, 603, 569, 889, 277, 1002, 1044,
+	915, 1043, 642, 1002, 1002, 912, 910, 648, 911, 758,
+	277, 727, 790, 908, 914, 262, 867, 55, 267, 1481,
+	1480, 1485, 988, 1379, 1351, 924, 990, 555, 565, 566,
+	558, 559, 560, 561, 562, 563, 564, 557, 543, 1316,
+	1317, 543, 1244, 567, 63, 278, 1102, 1020, 757, 1005,
+	1019, 944, 885, 736, 940, 1479, 935, 868, 866, 1053,
+	1054, 934, 1154, 636, 869, 636, 636, 1316, 1317, 947,
+	771, 277, 55, 1511, 873, 1507, 1246, 1084, 1222, 1198,
+	1062, 636, 1129, 867, 1013, 752, 731, 1065, 1058, 1219,
+	1068, 1069, 1066, 1052, 1067, 1220, 1320, 1217, 1319, 1085,
+	1216, 1079, 787, 1218, 1038, 1215, 271, 272, 832, 1072,
+	1100, 1494, 1068, 1069, 1070, 1071, 1482, 1162, 791, 531,
+	985, 1073, 1487, 996, 868, 866, 351, 995, 994, 1120,
+	660, 869, 1112, 1447, 529, 1446, 1001, 515, 1368, 1110,
+	1104, 580, 581, 582, 583, 584, 585, 586, 587, 484,
+	1088, 1092, 1109, 989, 516, 982, 983, 1117, 1118, 1298,
+	1347, 950, 1089, 730, 1090, 871, 263, 1119, 531, 1121,
+	1122, 1123, 1167, 1000, 1130, 351, 1492, 484, 1105, 1106,
+	993, 751, 268, 269, 259, 59, 1126, 635, 992, 635,
+	635, 1412, 1410, 1170, 260, 1409, 1353, 1036, 635, 1513,
+	1087, 506, 1033, 351, 1030, 635, 349, 568, 1513, 1512,
+	773, 774, 1145, 747, 533, 1425, 1340, 781, 1021, 901,
+	753, 1503, 187, 1023, 1024, 1025, 189, 56, 1, 277,
+	1031, 277, 1505, 1034, 1035, 1159, 1267, 1348, 956, 1041,
+	1448, 1042, 863, 1387, 1045, 1046, 1238, 1047, 1048, 907,
+	898, 1172, 1203, 1171, 52, 1169, 196, 569, 453, 1191,
+	1199, 195, 1439, 1075, 636, 636, 906, 1059, 592, 905,
+	1202, 835, 836, 1184, 1395, 1338, 1074, 1058, 918, 1114,
+	1206, 1208, 1214, 921, 63, 1245, 1111, 1183, 1204, 1185,
+	565, 566, 558, 559, 560, 561, 562, 563, 564, 557,
+	1444, 668, 666, 667, 665, 567, 670, 1132, 669, 1230,
+	757, 1208, 1237, 1213, 664, 787, 1016, 1014, 233, 344,
+	656, 946, 534, 199, 1147, 1146, 351, 952, 351, 1240,
+	895, 1229, 500, 501, 235, 578, 1209, 991, 1093, 350,
+	1205, 1236, 1477, 1454, 1242, 1243, 1241, 759, 1408, 1354,
+	1352, 1037, 602, 845, 284, 1249, 1250, 775, 297, 294,
+	295, 766, 282, 1049, 545, 283, 636, 279, 1275, 1232,
+	634, 627, 865, 864, 1063, 339, 1221, 1314, 1324, 1264,
+	1076, 1077, 1269, 1270, 1276, 633, 1164, 1293, 635, 635,
+	1420, 1292, 1168, 770, 27, 186, 273, 19, 278, 1272,
+	788, 18, 17, 797, 798, 799, 800, 801, 802, 803,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	814, 815, 1280, 819, 20, 1277, 16, 1328, 1329, 1330,
+	986, 987, 1308, 522, 15, 1274, 1299, 1058, 14, 351,
+	1281, 1282, 1313, 1318, 471, 1273, 1312, 1100, 31, 278,
+	278, 1079, 1323, 278, 278, 278, 1059, 901, 1309, 208,
+	484, 1332, 850, 351, 1322, 21, 13, 1223, 12, 568,
+	11, 1337, 10, 1335, 9, 8, 569, 7, 278, 278,
+	278, 278, 6, 66, 5, 1341, 351, 1343, 1260, 4,
+	635, 60, 261, 351, 264, 24, 66, 2, 0, 66,
+	1261, 1203, 1263, 0, 1372, 1022, 638, 556, 555, 565,
+	566, 558, 559, 560, 561, 562, 563, 564, 557, 1202,
+	0, 0, 1040, 0, 567, 0, 1369, 1381, 1382, 757,
+	0, 1373, 1374, 0, 1375, 1383, 1384, 1385, 1371, 1376,
+	0, 0, 0, 220, 0, 0, 0, 0, 1404, 0,
+	0, 0, 877, 1386, 483, 1337, 1337, 1337, 1407, 1392,
+	0, 1240, 1278, 0, 1399, 0, 0, 0, 0, 0,
+	0, 1203, 1413, 52, 1285, 1286, 1287, 1411, 1337, 1400,
+	0, 1401, 636, 0, 0, 0, 1430, 1426, 0, 1202,
+	0, 0, 0, 0, 1301, 1302, 1303, 0, 1306, 1431,
+	1437, 1436, 1432, 0, 351, 0, 1337, 1427, 0, 1058,
+	0, 0, 0, 0, 0, 0, 1059, 1452, 736, 1451,
+	1331, 1443, 1457, 0, 1459, 278, 0, 0, 0, 0,
+	0, 0, 351, 351, 0, 1464, 0, 0, 278, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1006,
+	1007, 1008, 1058, 66, 0, 1466, 1161, 901, 66, 901,
+	66, 1476, 0, 0, 0, 0, 0, 0, 1356, 0,
+	66, 0, 0, 66, 0, 0, 0, 1475, 0, 66,
+	1486, 0, 66, 0, 1364, 1488, 0, 0, 568, 0,
+	0, 0, 1495, 0, 1497, 0, 0, 0, 0, 278,
+	340, 0, 0, 1508, 0, 457, 635, 459, 0, 1510,
+	0, 0, 1337, 0, 1193, 0, 1521, 466, 0, 0,
+	472, 0, 66, 0, 0, 0, 479, 0, 1380, 481,
+	0, 0, 0, 0, 0, 0, 0, 63, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1416, 1417, 1418, 1419, 0, 0, 0, 1423, 1424, 0,
+	0, 0, 1231, 0, 0, 0, 0, 0, 0, 0,
+	1311, 0, 0, 0, 1433, 1434, 1435, 0, 0, 0,
+	0, 492, 493, 0, 494, 495, 0, 496, 1059, 499,
+	0, 0, 0, 0, 901, 66, 66, 66, 509, 0,
+	0, 0, 1458, 0, 0, 0, 0, 0, 0, 0,
+	0, 1460, 0, 0, 569, 0, 0, 0, 1465, 0,
+	0, 0, 1468, 1469, 1350, 0, 0, 0, 0, 0,
+	0, 1059, 0, 0, 0, 0, 0, 0, 0, 1473,
+	0, 0, 629, 0, 639, 522, 0, 0, 0, 558,
+	559, 560, 561, 562, 563, 564, 557, 278, 0, 278,
+	0, 0, 567, 0, 0, 1174, 1175, 757, 0, 1295,
+	0, 0, 0, 0, 0, 0, 0, 0, 592, 0,
+	0, 0, 0, 0, 1186, 1187, 1310, 1188, 1189, 0,
+	0, 0, 0, 0, 0, 0, 0, 1321, 1195, 1196,
+	1325, 0, 0, 0, 0, 1522, 1523, 0, 0, 0,
+	0, 0, 0, 0, 66, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 66, 66, 0, 0, 0, 0,
+	66, 0, 0, 66, 0, 1429, 66, 0, 0, 0,
+	66, 0, 0, 0, 0, 0, 0, 0, 0, 66,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1296, 663, 0, 1350, 901, 0, 0, 1247, 0, 569,
+	0, 719, 720, 1291, 0, 0, 0, 726, 0, 0,
+	340, 0, 0, 732, 0, 0, 0, 0, 0, 0,
+	0, 1378, 0, 66, 0, 0, 742, 0, 0, 0,
+	556, 555, 565, 566, 558, 559, 560, 561, 562, 563,
+	564, 557, 0, 0, 714, 0, 0, 567, 0, 0,
+	0, 721, 757, 0, 569, 0, 568, 0, 1279, 822,
+	0, 0, 0, 0, 0, 0, 0, 738, 0, 0,
+	772, 739, 740, 741, 0, 743, 744, 0, 0, 0,
+	0, 0, 745, 746, 0, 556, 555, 565, 566, 558,
+	559, 560, 561, 562, 563, 564, 557, 0, 0, 0,
+	0, 0, 567, 0, 0, 0, 0, 757, 0, 0,
+	0, 0, 0, 0, 1456, 592, 0, 592, 66, 0,
+	0, 0, 0, 0, 0, 0, 66, 0, 66, 0,
+	0, 66, 66, 0, 0, 66, 66, 66, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 860, 0, 0, 0, 0,
+	0, 0, 0, 1359, 1360, 1361, 1362, 1363, 0, 882,
+	0, 1366, 1367, 0, 0, 0, 1493, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1499, 0, 0,
+	0, 0, 0, 0, 0, 66, 0, 0, 0, 0,
+	0, 568, 0, 0, 66, 66, 0, 66, 66, 0,
+	0, 66, 0, 0, 0, 0, 0, 685, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 66, 0, 66,
+	66, 0, 66, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 948, 0, 0, 0, 0, 0, 0, 0,
+	0, 970, 971, 0, 974, 975, 568, 0, 976, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 978, 0, 0, 0, 0, 984,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	569, 949, 0, 951, 0, 0, 0, 0, 0, 0,
+	547, 673, 554, 822, 1018, 0, 0, 977, 0, 570,
+	571, 572, 573, 574, 575, 576, 0, 548, 553, 546,
+	0, 556, 555, 565, 566, 558, 559, 560, 561, 562,
+	563, 564, 557, 549, 551, 550, 552, 0, 567, 686,
+	0, 1061, 66, 544, 66, 66, 0, 0, 0, 0,
+	66, 0, 0, 66, 0, 0, 0, 0, 66, 0,
+	66, 699, 702, 703, 704, 705, 706, 707, 0, 708,
+	709, 710, 711, 712, 687, 688, 689, 690, 671, 672,
+	700, 0, 674, 1514, 675, 676, 677, 678, 679, 680,
+	681, 682, 683, 684, 691, 692, 693, 694, 695, 696,
+	697, 698, 0, 0, 0, 1290, 0, 0, 0, 0,
+	0, 25, 26, 53, 28, 29, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 44, 0, 0, 0, 0,
+	30, 49, 50, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 569, 701, 0, 0,
+	0, 39, 0, 0, 0, 55, 0, 0, 0, 0,
+	0, 66, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 556, 555, 565,
+	566, 558, 559, 560, 561, 562, 563, 564, 557, 0,
+	0, 0, 568, 0, 567, 0, 822, 0, 822, 757,
+	0, 0, 0, 0, 0, 0, 0, 0, 1163, 0,
+	0, 0, 0, 1131, 0, 0, 0, 0, 0, 0,
+	1061, 32, 33, 35, 34, 37, 0, 51, 0, 0,
+	0, 0, 0, 66, 66, 0, 0, 0, 0, 0,
+	0, 1158, 0, 0, 0, 0, 0, 0, 0, 38,
+	45, 46, 0, 0, 47, 48, 36, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
+	41, 0, 42, 43, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 0, 66,
+	0, 0, 0, 109, 0, 111, 0, 0, 150, 120,
+	0, 0, 0, 0, 0, 0, 66, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 66, 821, 0,
+	0, 0, 0, 0, 0, 66, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 1259, 0, 1018, 822,
+	822, 0, 93, 128, 0, 0, 0, 0, 568, 54,
+	0, 0, 0, 1262, 0, 0, 0, 0, 0, 0,
+	0, 0, 23, 0, 1271, 0, 0, 0, 0, 0,
+	1061, 0, 1061, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
 	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	71, 0, 140, 90, 96, 825, 826, 159, 160, 87,
 	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
 	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 66, 147, 156, 175, 176, 177, 178, 179,
+	180, 66, 0, 81, 97, 92, 133, 124, 79, 103,
 	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
+	0, 0, 569, 0, 1346, 0, 0, 0, 0, 0,
+	0, 1173, 0, 0, 0, 0, 0, 0, 0, 68,
+	75, 110, 0, 138, 95, 168, 0, 823, 0, 824,
+	0, 66, 1061, 556, 555, 565, 566, 558, 559, 560,
+	561, 562, 563, 564, 557, 0, 0, 0, 0, 0,
+	567, 0, 0, 0, 0, 757, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1061, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 66, 0, 0, 440, 428, 0, 398, 443,
+	377, 390, 451, 391, 392, 421, 363, 406, 129, 388,
+	182, 89, 85, 67, 420, 0, 380, 358, 385, 359,
+	378, 400, 91, 403, 376, 430, 409, 442, 109, 449,
+	111, 414, 0, 150, 120, 0, 0, 402, 432, 1471,
+	404, 426, 397, 422, 368, 413, 444, 389, 418, 445,
+	0, 0, 0, 207, 0, 902, 903, 0, 0, 0,
+	0, 0, 82, 0, 416, 439, 387, 417, 419, 357,
+	415, 0, 361, 364, 450, 434, 383, 93, 128, 1101,
+	0, 0, 0, 0, 0, 0, 401, 405, 423, 395,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 381,
+	0, 412, 0, 0, 0, 0, 0, 0, 365, 362,
+	0, 0, 399, 0, 568, 0, 367, 0, 382, 424,
+	0, 356, 98, 427, 433, 0, 396, 172, 437, 394,
+	393, 441, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 431, 379, 386, 86, 384, 143,
+	131, 165, 411, 132, 142, 112, 158, 137, 438, 173,
 	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
 	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
 	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
 	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
 	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
+	0, 360, 0, 151, 167, 185, 80, 375, 147, 156,
 	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
 	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 282, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 358,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 359, 357, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 65, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
-	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 443, 431, 0, 402,
-	446, 381, 394, 454, 395, 396, 424, 367, 410, 129,
-	392, 182, 89, 85, 67, 0, 384, 362, 389, 363,
-	382, 404, 91, 407, 380, 433, 413, 445, 109, 452,
-	111, 418, 0, 150, 120, 0, 0, 406, 435, 0,
-	408, 429, 401, 425, 372, 417, 447, 393, 422, 448,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 420, 442, 391, 421, 423, 361,
-	419, 0, 365, 368, 453, 437, 387, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 405, 409, 426, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 385,
-	0, 416, 0, 0, 0, 0, 0, 0, 369, 366,
-	0, 0, 403, 0, 0, 0, 371, 0, 386, 427,
-	0, 360, 98, 430, 436, 0, 400, 172, 440, 398,
-	397, 444, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 434, 383, 390, 86, 388, 143,
-	131, 165, 415, 132, 142, 112, 158, 137, 441, 173,
-	174, 155, 171, 181, 70, 154, 653, 83, 146, 72,
-	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 358,
-	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
-	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 364, 0, 151, 167, 185, 80, 379, 147, 156,
-	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 359, 357, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 375, 378, 373, 374, 411,
-	412, 449, 450, 451, 428, 370, 0, 376, 377, 0,
-	432, 438, 439, 414, 68, 75, 110, 455, 138, 95,
-	168, 443, 431, 0, 402, 446, 381, 394, 454, 395,
-	396, 424, 367, 410, 129, 392, 182, 89, 85, 67,
-	0, 384, 362, 389, 363, 382, 404, 91, 407, 380,
-	433, 413, 445, 109, 452, 111, 418, 0, 150, 120,
-	0, 0, 406, 435, 0, 408, 429, 401, 425, 372,
-	417, 447, 393, 422, 448, 0, 0, 0, 207, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 420,
-	442, 391, 421, 423, 361, 419, 0, 365, 368, 453,
-	437, 387, 93, 128, 0, 0, 0, 0, 0, 0,
-	0, 405, 409, 426, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 385, 0, 416, 0, 0, 0,
-	0, 0, 0, 369, 366, 0, 0, 403, 0, 0,
-	0, 371, 0, 386, 427, 0, 360, 98, 430, 436,
-	0, 400, 172, 440, 398, 397, 444, 136, 0, 153,
-	100, 108, 69, 76, 0, 99, 126, 141, 145, 434,
-	383, 390, 86, 388, 143, 131, 165, 415, 132, 142,
-	112, 158, 137, 441, 173, 174, 155, 171, 181, 70,
-	154, 349, 83, 146, 72, 162, 152, 118, 104, 105,
-	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
-	184, 77, 170, 74, 358, 169, 125, 157, 163, 119,
-	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
-	135, 102, 122, 121, 123, 0, 364, 0, 151, 167,
-	185, 80, 379, 147, 156, 175, 176, 177, 178, 179,
-	180, 0, 0, 81, 97, 92, 133, 359, 357, 352,
-	351, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	375, 378, 373, 374, 411, 412, 449, 450, 451, 428,
-	370, 0, 376, 377, 0, 432, 438, 439, 414, 68,
-	75, 110, 455, 138, 95, 168, 129, 0, 182, 89,
-	85, 67, 0, 0, 0, 284, 0, 0, 0, 91,
-	0, 281, 0, 0, 0, 109, 324, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 315, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
-	282, 303, 302, 305, 306, 307, 308, 0, 0, 82,
-	304, 309, 310, 311, 0, 0, 0, 279, 296, 0,
-	323, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 293, 294, 0, 0, 0, 0, 337, 0,
-	295, 0, 0, 0, 0, 0, 290, 291, 292, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 1319, 1320, 0, 172, 0, 0, 335, 0, 136,
-	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
-	145, 0, 0, 0, 86, 0, 143, 131, 165, 0,
-	132, 142, 112, 158, 137, 0, 173, 174, 155, 171,
-	181, 70, 154, 164, 83, 146, 72, 162, 152, 118,
-	104, 105, 71, 0, 140, 90, 96, 88, 127, 159,
-	160, 87, 184, 77, 170, 74, 78, 169, 125, 157,
-	163, 119, 116, 73, 161, 117, 115, 107, 94, 101,
-	134, 114, 135, 102, 122, 121, 123, 0, 0, 0,
-	151, 167, 185, 80, 0, 147, 156, 175, 176, 177,
-	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
-	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 325, 336, 331, 332, 329, 330, 328, 327,
-	326, 338, 317, 318, 319, 320, 322, 0, 333, 334,
-	321, 68, 75, 110, 0, 138, 95, 168, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 284, 0, 0,
-	0, 91, 0, 281, 0, 0, 0, 109, 324, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 315,
-	316, 0, 0, 0, 0, 0, 0, 901, 0, 55,
-	0, 0, 282, 303, 302, 305, 306, 307, 308, 0,
-	0, 82, 304, 309, 310, 311, 902, 0, 0, 279,
-	296, 0, 323, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 293, 294, 0, 0, 0, 0,
-	337, 0, 295, 0, 0, 0, 0, 0, 290, 291,
-	292, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 335,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 325, 336, 331, 332, 329, 330,
-	328, 327, 326, 338, 317, 318, 319, 320, 322, 25,
-	333, 334, 321, 68, 75, 110, 0, 138, 95, 168,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	284, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 302, 305, 306,
-	307, 308, 0, 0, 82, 304, 309, 310, 311, 0,
-	0, 0, 279, 296, 0, 323, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 293, 294, 0,
-	0, 0, 0, 337, 0, 295, 0, 0, 0, 0,
-	0, 290, 291, 292, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 335, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
+	130, 144, 84, 166, 149, 371, 374, 369, 370, 407,
+	408, 446, 447, 448, 425, 366, 0, 372, 373, 0,
+	429, 435, 436, 410, 68, 75, 110, 452, 138, 95,
+	168, 440, 428, 0, 398, 443, 377, 390, 451, 391,
+	392, 421, 363, 406, 129, 388, 182, 89, 85, 67,
+	420, 0, 380, 358, 385, 359, 378, 400, 91, 403,
+	376, 430, 409, 442, 109, 449, 111, 414, 0, 150,
+	120, 0, 0, 402, 432, 0, 404, 426, 397, 422,
+	368, 413, 444, 389, 418, 445, 0, 0, 0, 207,
+	0, 902, 903, 0, 0, 0, 0, 0, 82, 0,
+	416, 439, 387, 417, 419, 357, 415, 0, 361, 364,
+	450, 434, 383, 93, 128, 0, 0, 0, 0, 0,
+	0, 0, 401, 405, 423, 395, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 381, 0, 412, 0, 0,
+	0, 0, 0, 0, 365, 362, 0, 0, 399, 0,
+	0, 0, 367, 0, 382, 424, 0, 356, 98, 427,
+	433, 0, 396, 172, 437, 394, 393, 441, 136, 0,
+	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
+	431, 379, 386, 86, 384, 143, 131, 165, 411, 132,
+	142, 112, 158, 137, 438, 173, 174, 155, 171, 181,
+	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
+	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
+	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
+	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
+	114, 135, 102, 122, 121, 123, 0, 360, 0, 151,
+	167, 185, 80, 375, 147, 156, 175, 176, 177, 178,
+	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
+	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
+	149, 371, 374, 369, 370, 407, 408, 446, 447, 448,
+	425, 366, 0, 372, 373, 0, 429, 435, 436, 410,
+	68, 75, 110, 452, 138, 95, 168, 440, 428, 0,
+	398, 443, 377, 390, 451, 391, 392, 421, 363, 406,
+	129, 388, 182, 89, 85, 67, 420, 0, 380, 358,
+	385, 359, 378, 400, 91, 403, 376, 430, 409, 442,
+	109, 449, 111, 414, 0, 150, 120, 0, 0, 402,
+	432, 0, 404, 426, 397, 422, 368, 413, 444, 389,
+	418, 445, 55, 0, 0, 207, 0, 0, 0, 0,
+	0, 0, 0, 0, 82, 0, 416, 439, 387, 417,
+	419, 357, 415, 0, 361, 364, 450, 434, 383, 93,
+	128, 0, 0, 0, 0, 0, 0, 0, 401, 405,
+	423, 395, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 381, 0, 412, 0, 0, 0, 0, 0, 0,
+	365, 362, 0, 0, 399, 0, 0, 0, 367, 0,
+	382, 424, 0, 356, 98, 427, 433, 0, 396, 172,
+	437, 394, 393, 441, 136, 0, 153, 100, 108, 69,
+	76, 0, 99, 126, 141, 145, 431, 379, 386, 86,
+	384, 143, 131, 165, 411, 132, 142, 112, 158, 137,
+	438, 173, 174, 155, 171, 181, 70, 154, 164, 83,
 	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
 	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
 	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
 	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
+	121, 123, 0, 360, 0, 151, 167, 185, 80, 375,
 	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
 	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 325, 336, 331,
-	332, 329, 330, 328, 327, 326, 338, 317, 318, 319,
-	320, 322, 0, 333, 334, 321, 68, 75, 110, 23,
-	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
-	830, 0, 284, 0, 0, 0, 91, 0, 281, 0,
-	0, 0, 109, 324, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 315, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 55, 0, 0, 282, 303, 302,
-	305, 306, 307, 308, 0, 0, 82, 304, 309, 310,
-	311, 0, 0, 0, 279, 296, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
-	294, 275, 0, 0, 0, 337, 0, 295, 0, 0,
-	0, 0, 0, 290, 291, 292, 297, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
+	139, 183, 130, 144, 84, 166, 149, 371, 374, 369,
+	370, 407, 408, 446, 447, 448, 425, 366, 0, 372,
+	373, 0, 429, 435, 436, 410, 68, 75, 110, 452,
+	138, 95, 168, 440, 428, 0, 398, 443, 377, 390,
+	451, 391, 392, 421, 363, 406, 129, 388, 182, 89,
+	85, 67, 420, 0, 380, 358, 385, 359, 378, 400,
+	91, 403, 376, 430, 409, 442, 109, 449, 111, 414,
+	0, 150, 120, 0, 0, 402, 432, 0, 404, 426,
+	397, 422, 368, 413, 444, 389, 418, 445, 0, 0,
+	0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+	82, 0, 416, 439, 387, 417, 419, 357, 415, 0,
+	361, 364, 450, 434, 383, 93, 128, 0, 0, 0,
+	0, 0, 0, 0, 401, 405, 423, 395, 0, 0,
+	0, 0, 0, 0, 0, 886, 0, 381, 0, 412,
+	0, 0, 0, 0, 0, 0, 365, 362, 0, 0,
+	399, 0, 0, 0, 367, 0, 382, 424, 0, 356,
+	98, 427, 433, 0, 396, 172, 437, 394, 393, 441,
+	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
+	141, 145, 431, 379, 386, 86, 384, 143, 131, 165,
+	411, 132, 142, 112, 158, 137, 438, 173, 174, 155,
+	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
+	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
+	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
+	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
+	101, 134, 114, 135, 102, 122, 121, 123, 0, 360,
+	0, 151, 167, 185, 80, 375, 147, 156, 175, 176,
+	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
+	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
+	84, 166, 149, 371, 374, 369, 370, 407, 408, 446,
+	447, 448, 425, 366, 0, 372, 373, 0, 429, 435,
+	436, 410, 68, 75, 110, 452, 138, 95, 168, 440,
+	428, 0, 398, 443, 377, 390, 451, 391, 392, 421,
+	363, 406, 129, 388, 182, 89, 85, 67, 420, 0,
+	380, 358, 385, 359, 378, 400, 91, 403, 376, 430,
+	409, 442, 109, 449, 111, 414, 0, 150, 120, 0,
+	0, 402, 432, 0, 404, 426, 397, 422, 368, 413,
+	444, 389, 418, 445, 0, 0, 0, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 82, 0, 416, 439,
+	387, 417, 419, 357, 415, 0, 361, 364, 450, 434,
+	383, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	401, 405, 423, 395, 0, 0, 0, 0, 0, 0,
+	0, 786, 0, 381, 0, 412, 0, 0, 0, 0,
+	0, 0, 365, 362, 0, 0, 399, 0, 0, 0,
+	367, 0, 382, 424, 0, 356, 98, 427, 433, 0,
+	396, 172, 437, 394, 393, 441, 136, 0, 153, 100,
+	108, 69, 76, 0, 99, 126, 141, 145, 431, 379,
+	386, 86, 384, 143, 131, 165, 411, 132, 142, 112,
+	158, 137, 438, 173, 174, 155, 171, 181, 70, 154,
 	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
 	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
 	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
 	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
+	102, 122, 121, 123, 0, 360, 0, 151, 167, 185,
+	80, 375, 147, 156, 175, 176, 177, 178, 179, 180,
 	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 284, 0, 0, 0, 91, 0,
-	281, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 516, 282,
-	303, 302, 305, 306, 307, 308, 0, 0, 82, 304,
-	309, 310, 311, 0, 0, 0, 279, 296, 0, 323,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 293, 294, 0, 0, 0, 0, 337, 0, 295,
-	0, 0, 0, 0, 0, 290, 291, 292, 297, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 335, 0, 136, 0,
+	106, 113, 139, 183, 130, 144, 84, 166, 149, 371,
+	374, 369, 370, 407, 408, 446, 447, 448, 425, 366,
+	0, 372, 373, 0, 429, 435, 436, 410, 68, 75,
+	110, 452, 138, 95, 168, 440, 428, 0, 398, 443,
+	377, 390, 451, 391, 392, 421, 363, 406, 129, 388,
+	182, 89, 85, 67, 420, 0, 380, 358, 385, 359,
+	378, 400, 91, 403, 376, 430, 409, 442, 109, 449,
+	111, 414, 0, 150, 120, 0, 0, 402, 432, 0,
+	404, 426, 397, 422, 368, 413, 444, 389, 418, 445,
+	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 416, 439, 387, 417, 419, 357,
+	415, 0, 361, 364, 450, 434, 383, 93, 128, 0,
+	0, 0, 0, 0, 0, 0, 401, 405, 423, 395,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 381,
+	0, 412, 0, 0, 0, 0, 0, 0, 365, 362,
+	0, 0, 399, 0, 0, 0, 367, 0, 382, 424,
+	0, 356, 98, 427, 433, 0, 396, 172, 437, 394,
+	393, 441, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 431, 379, 386, 86, 384, 143,
+	131, 165, 411, 132, 142, 112, 158, 137, 438, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 360, 0, 151, 167, 185, 80, 375, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 371, 374, 369, 370, 407,
+	408, 446, 447, 448, 425, 366, 0, 372, 373, 0,
+	429, 435, 436, 410, 68, 75, 110, 452, 138, 95,
+	168, 440, 428, 0, 398, 443, 377, 390, 451, 391,
+	392, 421, 363, 406, 129, 388, 182, 89, 85, 67,
+	420, 0, 380, 358, 385, 359, 378, 400, 91, 403,
+	376, 430, 409, 442, 109, 449, 111, 414, 0, 150,
+	120, 0, 0, 402, 432, 0, 404, 426, 397, 422,
+	368, 413, 444, 389, 418, 445, 0, 0, 0, 1060,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	416, 439, 387, 417, 419, 357, 415, 0, 361, 364,
+	450, 434, 383, 93, 128, 0, 0, 0, 0, 0,
+	0, 0, 401, 405, 423, 395, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 381, 0, 412, 0, 0,
+	0, 0, 0, 0, 365, 362, 0, 0, 399, 0,
+	0, 0, 367, 0, 382, 424, 0, 356, 98, 427,
+	433, 0, 396, 172, 437, 394, 393, 441, 136, 0,
 	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
+	431, 379, 386, 86, 384, 143, 131, 165, 411, 132,
+	142, 112, 158, 137, 438, 173, 174, 155, 171, 181,
 	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
 	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
 	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
 	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
+	114, 135, 102, 122, 121, 123, 0, 360, 0, 151,
+	167, 185, 80, 375, 147, 156, 175, 176, 177, 178,
 	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
 	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 325, 336, 331, 332, 329, 330, 328, 327, 326,
-	338, 317, 318, 319, 320, 322, 0, 333, 334, 321,
-	68, 75, 110, 0, 138, 95, 168, 129, 0, 182,
-	89, 85, 67, 0, 0, 0, 284, 0, 0, 0,
-	91, 0, 281, 0, 0, 0, 109, 324, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 315, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
-	0, 282, 303, 302, 305, 306, 307, 308, 0, 0,
-	82, 304, 309, 310, 311, 0, 0, 0, 279, 296,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 293, 294, 275, 0, 0, 0, 337,
-	0, 295, 0, 0, 0, 0, 0, 290, 291, 292,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 335, 0,
+	149, 371, 374, 369, 370, 407, 408, 446, 447, 448,
+	425, 366, 0, 372, 373, 0, 429, 435, 436, 410,
+	68, 75, 110, 452, 138, 95, 168, 440, 428, 0,
+	398, 443, 377, 390, 451, 391, 392, 421, 363, 406,
+	129, 388, 182, 89, 85, 67, 420, 0, 380, 358,
+	385, 359, 378, 400, 91, 403, 376, 430, 409, 442,
+	109, 449, 111, 414, 0, 150, 120, 0, 0, 402,
+	432, 0, 404, 426, 397, 422, 368, 413, 444, 389,
+	418, 445, 0, 0, 0, 207, 0, 0, 0, 0,
+	0, 0, 0, 0, 82, 0, 416, 439, 387, 417,
+	419, 357, 415, 0, 361, 364, 450, 434, 383, 93,
+	128, 0, 0, 0, 0, 0, 0, 0, 401, 405,
+	423, 395, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 381, 0, 412, 0, 0, 0, 0, 0, 0,
+	365, 362, 0, 0, 399, 0, 0, 0, 367, 0,
+	382, 424, 0, 356, 98, 427, 433, 0, 396, 172,
+	437, 394, 393, 441, 136, 0, 153, 100, 108, 69,
+	76, 0, 99, 126, 141, 145, 431, 379, 386, 86,
+	384, 143, 131, 165, 411, 132, 142, 112, 158, 137,
+	438, 173, 174, 155, 171, 181, 70, 154, 164, 83,
+	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
+	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
+	74, 354, 169, 125, 157, 163, 119, 116, 73, 161,
+	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
+	121, 123, 0, 360, 0, 151, 167, 185, 80, 375,
+	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
+	81, 97, 92, 133, 355, 353, 103, 148, 106, 113,
+	139, 183, 130, 144, 84, 166, 149, 371, 374, 369,
+	370, 407, 408, 446, 447, 448, 425, 366, 0, 372,
+	373, 0, 429, 435, 436, 410, 68, 75, 110, 452,
+	138, 95, 168, 440, 428, 0, 398, 443, 377, 390,
+	451, 391, 392, 421, 363, 406, 129, 388, 182, 89,
+	85, 67, 420, 0, 380, 358, 385, 359, 378, 400,
+	91, 403, 376, 430, 409, 442, 109, 449, 111, 414,
+	0, 150, 120, 0, 0, 402, 432, 0, 404, 426,
+	397, 422, 368, 413, 444, 389, 418, 445, 0, 0,
+	0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+	82, 0, 416, 439, 387, 417, 419, 357, 415, 0,
+	361, 364, 450, 434, 383, 93, 128, 0, 0, 0,
+	0, 0, 0, 0, 401, 405, 423, 395, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 381, 0, 412,
+	0, 0, 0, 0, 0, 0, 365, 362, 0, 0,
+	399, 0, 0, 0, 367, 0, 382, 424, 0, 356,
+	98, 427, 433, 0, 396, 172, 437, 394, 393, 441,
 	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
+	141, 145, 431, 379, 386, 86, 384, 143, 131, 165,
+	411, 132, 142, 112, 158, 137, 438, 173, 174, 155,
 	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
 	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
 	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
 	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
+	101, 134, 114, 135, 102, 122, 121, 123, 0, 360,
+	0, 151, 167, 185, 80, 375, 147, 156, 175, 176,
 	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
 	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 325, 336, 331, 332, 329, 330, 328,
-	327, 326, 338, 317, 318, 319, 320, 322, 0, 333,
-	334, 321, 68, 75, 110, 0, 138, 95, 168, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 284, 0,
-	0, 0, 91, 0, 281, 0, 0, 0, 109, 324,
-	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	55, 0, 0, 282, 303, 845, 305, 306, 307, 308,
-	0, 0, 82, 304, 309, 310, 311, 0, 0, 0,
-	279, 296, 0, 323, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 293, 294, 275, 0, 0,
-	0, 337, 0, 295, 0, 0, 0, 0, 0, 290,
-	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
-	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
-	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	84, 166, 149, 371, 374, 369, 370, 407, 408, 446,
+	447, 448, 425, 366, 0, 372, 373, 0, 429, 435,
+	436, 410, 68, 75, 110, 452, 138, 95, 168, 440,
+	428, 0, 398, 443, 377, 390, 451, 391, 392, 421,
+	363, 406, 129, 388, 182, 89, 85, 67, 420, 0,
+	380, 358, 385, 359, 378, 400, 91, 403, 376, 430,
+	409, 442, 109, 449, 111, 414, 0, 150, 120, 0,
+	0, 402, 432, 0, 404, 426, 397, 422, 368, 413,
+	444, 389, 418, 445, 0, 0, 0, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 82, 0, 416, 439,
+	387, 417, 419, 357, 415, 0, 361, 364, 450, 434,
+	383, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	401, 405, 423, 395, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 381, 0, 412, 0, 0, 0, 0,
+	0, 0, 365, 362, 0, 0, 399, 0, 0, 0,
+	367, 0, 382, 424, 0, 356, 98, 427, 433, 0,
+	396, 172, 437, 394, 393, 441, 136, 0, 153, 100,
+	108, 69, 76, 0, 99, 126, 141, 145, 431, 379,
+	386, 86, 384, 143, 131, 165, 411, 132, 142, 112,
+	158, 137, 438, 173, 174, 155, 171, 181, 70, 154,
+	650, 83, 146, 72, 162, 152, 118, 104, 105, 71,
+	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
+	77, 170, 74, 354, 169, 125, 157, 163, 119, 116,
+	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
+	102, 122, 121, 123, 0, 360, 0, 151, 167, 185,
+	80, 375, 147, 156, 175, 176, 177, 178, 179, 180,
+	0, 0, 81, 97, 92, 133, 355, 353, 103, 148,
+	106, 113, 139, 183, 130, 144, 84, 166, 149, 371,
+	374, 369, 370, 407, 408, 446, 447, 448, 425, 366,
+	0, 372, 373, 0, 429, 435, 436, 410, 68, 75,
+	110, 452, 138, 95, 168, 440, 428, 0, 398, 443,
+	377, 390, 451, 391, 392, 421, 363, 406, 129, 388,
+	182, 89, 85, 67, 420, 0, 380, 358, 385, 359,
+	378, 400, 91, 403, 376, 430, 409, 442, 109, 449,
+	111, 414, 0, 150, 120, 0, 0, 402, 432, 0,
+	404, 426, 397, 422, 368, 413, 444, 389, 418, 445,
+	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 416, 439, 387, 417, 419, 357,
+	415, 0, 361, 364, 450, 434, 383, 93, 128, 0,
+	0, 0, 0, 0, 0, 0, 401, 405, 423, 395,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 381,
+	0, 412, 0, 0, 0, 0, 0, 0, 365, 362,
+	0, 0, 399, 0, 0, 0, 367, 0, 382, 424,
+	0, 356, 98, 427, 433, 0, 396, 172, 437, 394,
+	393, 441, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 431, 379, 386, 86, 384, 143,
+	131, 165, 411, 132, 142, 112, 158, 137, 438, 173,
+	174, 155, 171, 181, 70, 154, 345, 83, 146, 72,
 	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
-	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 354,
 	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
 	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
-	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	0, 360, 0, 151, 167, 185, 80, 375, 147, 156,
 	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
-	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
+	92, 133, 355, 353, 348, 347, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 371, 374, 369, 370, 407,
+	408, 446, 447, 448, 425, 366, 0, 372, 373, 0,
+	429, 435, 436, 410, 68, 75, 110, 452, 138, 95,
 	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	284, 0, 0, 0, 91, 0, 281, 0, 0, 0,
-	109, 324, 111, 0, 0, 150, 120, 0, 0, 0,
-	0, 0, 315, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 282, 303, 842, 305, 306,
-	307, 308, 0, 0, 82, 304, 309, 310, 311, 0,
-	0, 0, 279, 296, 0, 323, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 293, 294, 275,
-	0, 0, 0, 337, 0, 295, 0, 0, 0, 0,
-	0, 290, 291, 292, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 335, 0, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 325, 336, 331,
-	332, 329, 330, 328, 327, 326, 338, 317, 318, 319,
-	320, 322, 0, 333, 334, 321, 68, 75, 110, 0,
-	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 284, 0, 0, 0, 91, 0, 281, 0,
-	0, 0, 109, 324, 111, 0, 0, 150, 120, 0,
-	0, 0, 0, 0, 315, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 55, 0, 0, 282, 303, 302,
-	305, 306, 307, 308, 0, 0, 82, 304, 309, 310,
-	311, 0, 0, 0, 279, 296, 0, 323, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
-	294, 0, 0, 0, 0, 337, 0, 295, 0, 0,
-	0, 0, 0, 290, 291, 292, 297, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 335, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 325,
-	336, 331, 332, 329, 330, 328, 327, 326, 338, 317,
-	318, 319, 320, 322, 0, 333, 334, 321, 68, 75,
-	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 0, 0, 0, 91, 0,
-	0, 0, 0, 0, 109, 324, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 315, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 55, 0, 0, 282,
-	303, 302, 305, 306, 307, 308, 0, 0, 82, 304,
-	309, 310, 311, 0, 0, 0, 0, 296, 0, 323,
+	0, 298, 0, 0, 0, 91, 0, 281, 0, 0,
+	0, 109, 320, 111, 0, 0, 150, 120, 0, 0,
+	0, 0, 0, 311, 312, 0, 0, 0, 0, 0,
+	0, 0, 0, 55, 0, 0, 207, 300, 299, 302,
+	303, 304, 305, 0, 0, 82, 301, 306, 307, 308,
+	0, 0, 0, 280, 292, 0, 319, 0, 0, 0,
+	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 290,
+	0, 0, 0, 0, 333, 0, 291, 0, 0, 0,
+	0, 0, 286, 287, 288, 293, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 1326, 1327, 0,
+	172, 0, 0, 331, 0, 136, 0, 153, 100, 108,
+	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
+	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
+	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
+	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
+	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
+	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
+	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
+	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
+	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
+	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
+	113, 139, 183, 130, 144, 84, 166, 149, 321, 332,
+	327, 328, 325, 326, 324, 323, 322, 334, 313, 314,
+	315, 316, 318, 0, 329, 330, 317, 68, 75, 110,
+	0, 138, 95, 168, 129, 0, 182, 89, 85, 67,
+	0, 0, 0, 0, 298, 0, 0, 0, 91, 0,
+	281, 0, 0, 0, 109, 320, 111, 0, 0, 150,
+	120, 0, 0, 0, 0, 0, 311, 312, 0, 0,
+	0, 0, 0, 0, 893, 0, 55, 0, 0, 207,
+	300, 299, 302, 303, 304, 305, 0, 0, 82, 301,
+	306, 307, 308, 894, 0, 0, 280, 292, 0, 319,
 	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 293, 294, 0, 0, 0, 0, 337, 0, 295,
-	0, 0, 0, 0, 0, 290, 291, 292, 297, 0,
+	0, 289, 290, 0, 0, 0, 0, 333, 0, 291,
+	0, 0, 0, 0, 0, 286, 287, 288, 293, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 335, 0, 136, 0,
+	0, 0, 0, 172, 0, 0, 331, 0, 136, 0,
 	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 1492, 132,
+	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
 	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
 	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
 	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
@@ -1677,48 +1417,22 @@ var yyAct = [...]int{
 	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
 	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
 	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 325, 336, 331, 332, 329, 330, 328, 327, 326,
-	338, 317, 318, 319, 320, 322, 0, 333, 334, 321,
-	68, 75, 110, 0, 138, 95, 168, 129, 0, 182,
-	89, 85, 67, 0, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 0, 0, 109, 324, 111, 0,
-	0, 150, 120, 0, 0, 0, 0, 0, 315, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
-	516, 282, 303, 302, 305, 306, 307, 308, 0, 0,
-	82, 304, 309, 310, 311, 0, 0, 0, 0, 296,
-	0, 323, 0, 0, 0, 93, 128, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 293, 294, 0, 0, 0, 0, 337,
-	0, 295, 0, 0, 0, 0, 0, 290, 291, 292,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 172, 0, 0, 335, 0,
-	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
-	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
-	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
-	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
-	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
-	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
-	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
-	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
-	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
-	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
-	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
-	84, 166, 149, 325, 336, 331, 332, 329, 330, 328,
-	327, 326, 338, 317, 318, 319, 320, 322, 0, 333,
-	334, 321, 68, 75, 110, 0, 138, 95, 168, 129,
-	0, 182, 89, 85, 67, 0, 0, 0, 0, 0,
-	0, 0, 91, 0, 0, 0, 0, 0, 109, 324,
+	149, 321, 332, 327, 328, 325, 326, 324, 323, 322,
+	334, 313, 314, 315, 316, 318, 25, 329, 330, 317,
+	68, 75, 110, 0, 138, 95, 168, 0, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 298, 0,
+	0, 0, 91, 0, 281, 0, 0, 0, 109, 320,
 	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
-	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	55, 0, 0, 282, 303, 302, 305, 306, 307, 308,
-	0, 0, 82, 304, 309, 310, 311, 0, 0, 0,
-	0, 296, 0, 323, 0, 0, 0, 93, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 293, 294, 0, 0, 0,
-	0, 337, 0, 295, 0, 0, 0, 0, 0, 290,
-	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
+	311, 312, 0, 0, 0, 0, 0, 0, 0, 0,
+	55, 0, 0, 207, 300, 299, 302, 303, 304, 305,
+	0, 0, 82, 301, 306, 307, 308, 0, 0, 0,
+	280, 292, 0, 319, 0, 0, 0, 93, 128, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 290, 0, 0, 0,
+	0, 333, 0, 291, 0, 0, 0, 0, 0, 286,
+	287, 288, 293, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
-	335, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	331, 0, 136, 0, 153, 100, 108, 69, 76, 0,
 	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
 	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
 	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
@@ -1729,22 +1443,101 @@ var yyAct = [...]int{
 	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
 	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
 	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
-	130, 144, 84, 166, 149, 325, 336, 331, 332, 329,
-	330, 328, 327, 326, 338, 317, 318, 319, 320, 322,
-	0, 333, 334, 321, 68, 75, 110, 0, 138, 95,
-	168, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
+	130, 144, 84, 166, 149, 321, 332, 327, 328, 325,
+	326, 324, 323, 322, 334, 313, 314, 315, 316, 318,
+	0, 329, 330, 317, 68, 75, 110, 23, 138, 95,
+	168, 129, 0, 182, 89, 85, 67, 0, 0, 783,
+	0, 298, 0, 0, 0, 91, 0, 281, 0, 0,
+	0, 109, 320, 111, 0, 0, 150, 120, 0, 0,
+	0, 0, 0, 311, 312, 0, 0, 0, 0, 0,
+	0, 0, 0, 55, 0, 0, 207, 300, 299, 302,
+	303, 304, 305, 0, 0, 82, 301, 306, 307, 308,
+	0, 0, 0, 280, 292, 0, 319, 0, 0, 0,
+	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 290,
+	275, 0, 0, 0, 333, 0, 291, 0, 0, 0,
+	0, 0, 286, 287, 288, 293, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	172, 0, 0, 331, 0, 136, 0, 153, 100, 108,
+	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
+	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
+	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
+	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
+	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
+	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
+	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
+	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
+	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
+	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
+	113, 139, 183, 130, 144, 84, 166, 149, 321, 332,
+	327, 328, 325, 326, 324, 323, 322, 334, 313, 314,
+	315, 316, 318, 0, 329, 330, 317, 68, 75, 110,
+	0, 138, 95, 168, 129, 0, 182, 89, 85, 67,
+	0, 0, 0, 0, 298, 0, 0, 0, 91, 0,
+	281, 0, 0, 0, 109, 320, 111, 0, 0, 150,
+	120, 0, 0, 0, 0, 0, 311, 312, 0, 0,
+	0, 0, 0, 0, 0, 0, 55, 0, 513, 207,
+	300, 299, 302, 303, 304, 305, 0, 0, 82, 301,
+	306, 307, 308, 0, 0, 0, 280, 292, 0, 319,
+	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 570, 0, 82, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	0, 289, 290, 0, 0, 0, 0, 333, 0, 291,
+	0, 0, 0, 0, 0, 286, 287, 288, 293, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 172, 0, 0, 331, 0, 136, 0,
+	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
+	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
+	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
+	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
+	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
+	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
+	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
+	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
+	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
+	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
+	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
+	149, 321, 332, 327, 328, 325, 326, 324, 323, 322,
+	334, 313, 314, 315, 316, 318, 0, 329, 330, 317,
+	68, 75, 110, 0, 138, 95, 168, 129, 0, 182,
+	89, 85, 67, 0, 0, 0, 0, 298, 0, 0,
+	0, 91, 0, 281, 0, 0, 0, 109, 320, 111,
+	0, 0, 150, 120, 0, 0, 0, 0, 0, 311,
+	312, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 207, 300, 299, 302, 303, 304, 305, 0,
+	0, 82, 301, 306, 307, 308, 0, 0, 0, 280,
+	292, 0, 319, 0, 0, 0, 93, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 290, 275, 0, 0, 0,
+	333, 0, 291, 0, 0, 0, 0, 0, 286, 287,
+	288, 293, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 172, 0, 0, 331,
+	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
+	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
+	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
+	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
+	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
+	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
+	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
+	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
+	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
+	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
+	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
+	144, 84, 166, 149, 321, 332, 327, 328, 325, 326,
+	324, 323, 322, 334, 313, 314, 315, 316, 318, 0,
+	329, 330, 317, 68, 75, 110, 0, 138, 95, 168,
+	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
+	298, 0, 0, 0, 91, 0, 281, 0, 0, 0,
+	109, 320, 111, 0, 0, 150, 120, 0, 0, 0,
+	0, 0, 311, 312, 0, 0, 0, 0, 0, 0,
+	0, 0, 55, 0, 0, 207, 300, 841, 302, 303,
+	304, 305, 0, 0, 82, 301, 306, 307, 308, 0,
+	0, 0, 280, 292, 0, 319, 0, 0, 0, 93,
 	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 557, 556, 566, 567, 559, 560, 561,
-	562, 563, 564, 565, 558, 0, 0, 0, 0, 0,
-	568, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 290, 275,
+	0, 0, 0, 333, 0, 291, 0, 0, 0, 0,
+	0, 286, 287, 288, 293, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 98, 0, 0, 0, 0, 172,
-	0, 0, 0, 0, 136, 0, 153, 100, 108, 69,
+	0, 0, 331, 0, 136, 0, 153, 100, 108, 69,
 	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
 	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
 	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
@@ -1755,20 +1548,127 @@ var yyAct = [...]int{
 	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
 	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
 	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
-	85, 67, 0, 0, 541, 0, 68, 75, 110, 91,
-	138, 95, 168, 0, 569, 109, 0, 111, 0, 0,
-	150, 120, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 543, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 538, 537, 0, 0, 0,
-	0, 0, 0, 0, 93, 128, 0, 0, 0, 0,
-	0, 0, 0, 539, 0, 0, 0, 0, 0, 0,
+	139, 183, 130, 144, 84, 166, 149, 321, 332, 327,
+	328, 325, 326, 324, 323, 322, 334, 313, 314, 315,
+	316, 318, 0, 329, 330, 317, 68, 75, 110, 0,
+	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
+	0, 0, 0, 298, 0, 0, 0, 91, 0, 281,
+	0, 0, 0, 109, 320, 111, 0, 0, 150, 120,
+	0, 0, 0, 0, 0, 311, 312, 0, 0, 0,
+	0, 0, 0, 0, 0, 55, 0, 0, 207, 300,
+	838, 302, 303, 304, 305, 0, 0, 82, 301, 306,
+	307, 308, 0, 0, 0, 280, 292, 0, 319, 0,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 290, 275, 0, 0, 0, 333, 0, 291, 0,
+	0, 0, 0, 0, 286, 287, 288, 293, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 331, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+	321, 332, 327, 328, 325, 326, 324, 323, 322, 334,
+	313, 314, 315, 316, 318, 0, 329, 330, 317, 68,
+	75, 110, 0, 138, 95, 168, 129, 0, 182, 89,
+	85, 67, 0, 0, 0, 0, 298, 0, 0, 0,
+	91, 0, 281, 0, 0, 0, 109, 320, 111, 0,
+	0, 150, 120, 0, 0, 0, 0, 0, 311, 312,
+	0, 0, 0, 0, 0, 0, 0, 0, 55, 0,
+	0, 207, 300, 299, 302, 303, 304, 305, 0, 0,
+	82, 301, 306, 307, 308, 0, 0, 0, 280, 292,
+	0, 319, 0, 0, 0, 93, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 290, 0, 0, 0, 0, 333,
+	0, 291, 0, 0, 0, 0, 0, 286, 287, 288,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 172, 0, 0, 331, 0,
+	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
+	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
+	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
+	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
+	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
+	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
+	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
+	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
+	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
+	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
+	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
+	84, 166, 149, 321, 332, 327, 328, 325, 326, 324,
+	323, 322, 334, 313, 314, 315, 316, 318, 0, 329,
+	330, 317, 68, 75, 110, 0, 138, 95, 168, 129,
+	0, 182, 89, 85, 67, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 0, 0, 0, 0, 0, 109,
+	320, 111, 0, 0, 150, 120, 0, 0, 0, 0,
+	0, 311, 312, 0, 0, 0, 0, 0, 0, 0,
+	0, 55, 0, 0, 207, 300, 299, 302, 303, 304,
+	305, 0, 0, 82, 301, 306, 307, 308, 0, 0,
+	0, 0, 292, 0, 319, 0, 0, 0, 93, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 290, 0, 0,
+	0, 0, 333, 0, 291, 0, 0, 0, 0, 0,
+	286, 287, 288, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
+	0, 331, 0, 136, 0, 153, 100, 108, 69, 76,
+	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
+	143, 131, 165, 1515, 132, 142, 112, 158, 137, 0,
+	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
+	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
+	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
+	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
+	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
+	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
+	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
+	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
+	183, 130, 144, 84, 166, 149, 321, 332, 327, 328,
+	325, 326, 324, 323, 322, 334, 313, 314, 315, 316,
+	318, 0, 329, 330, 317, 68, 75, 110, 0, 138,
+	95, 168, 129, 0, 182, 89, 85, 67, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 0, 0, 0,
+	0, 0, 109, 320, 111, 0, 0, 150, 120, 0,
+	0, 0, 0, 0, 311, 312, 0, 0, 0, 0,
+	0, 0, 0, 0, 55, 0, 513, 207, 300, 299,
+	302, 303, 304, 305, 0, 0, 82, 301, 306, 307,
+	308, 0, 0, 0, 0, 292, 0, 319, 0, 0,
+	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	290, 0, 0, 0, 0, 333, 0, 291, 0, 0,
+	0, 0, 0, 286, 287, 288, 293, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 172, 0, 0, 331, 0, 136, 0, 153, 100,
+	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
+	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
+	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
+	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
+	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
+	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
+	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
+	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
+	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
+	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
+	106, 113, 139, 183, 130, 144, 84, 166, 149, 321,
+	332, 327, 328, 325, 326, 324, 323, 322, 334, 313,
+	314, 315, 316, 318, 0, 329, 330, 317, 68, 75,
+	110, 0, 138, 95, 168, 129, 0, 182, 89, 85,
+	67, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	0, 0, 0, 0, 0, 109, 320, 111, 0, 0,
+	150, 120, 0, 0, 0, 0, 0, 311, 312, 0,
+	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
+	207, 300, 299, 302, 303, 304, 305, 0, 0, 82,
+	301, 306, 307, 308, 0, 0, 0, 0, 292, 0,
+	319, 0, 0, 0, 93, 128, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 290, 0, 0, 0, 0, 333, 0,
+	291, 0, 0, 0, 0, 0, 286, 287, 288, 293,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 136,
+	0, 0, 0, 0, 172, 0, 0, 331, 0, 136,
 	0, 153, 100, 108, 69, 76, 0, 99, 126, 141,
 	145, 0, 0, 0, 86, 0, 143, 131, 165, 0,
 	132, 142, 112, 158, 137, 0, 173, 174, 155, 171,
@@ -1780,90 +1680,238 @@ var yyAct = [...]int{
 	151, 167, 185, 80, 0, 147, 156, 175, 176, 177,
 	178, 179, 180, 0, 0, 81, 97, 92, 133, 124,
 	79, 103, 148, 106, 113, 139, 183, 130, 144, 84,
-	166, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 0, 182, 89, 85, 67, 0, 0, 0,
-	0, 68, 75, 110, 91, 138, 95, 168, 0, 0,
-	109, 0, 111, 0, 0, 150, 120, 0, 0, 0,
+	166, 149, 321, 332, 327, 328, 325, 326, 324, 323,
+	322, 334, 313, 314, 315, 316, 318, 0, 329, 330,
+	317, 68, 75, 110, 0, 138, 95, 168, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 0, 0, 0, 0, 0, 109, 0,
+	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	201, 0, 0, 0, 0, 0, 0, 0, 0, 93,
-	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
+	569, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 556, 555, 565, 566, 558, 559, 560, 561, 562,
+	563, 564, 557, 0, 0, 0, 0, 0, 567, 0,
+	0, 0, 0, 757, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 1289,
+	0, 0, 539, 0, 68, 75, 110, 91, 138, 95,
+	168, 0, 568, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	541, 0, 0, 0, 0, 0, 0, 82, 0, 0,
+	569, 0, 0, 536, 535, 0, 0, 0, 0, 0,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
+	0, 537, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 556, 555, 565, 566, 558, 559, 560, 561, 562,
+	563, 564, 557, 0, 0, 0, 0, 0, 567, 0,
+	0, 0, 0, 757, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+	1288, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 68,
+	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
+	111, 0, 568, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 203, 204, 0, 0, 200,
-	0, 0, 0, 205, 136, 0, 153, 100, 108, 69,
-	76, 0, 99, 126, 141, 145, 0, 0, 0, 86,
-	0, 143, 131, 165, 0, 132, 142, 112, 158, 137,
-	0, 173, 174, 155, 171, 181, 70, 154, 164, 83,
-	146, 72, 162, 152, 118, 104, 105, 71, 0, 140,
-	90, 96, 88, 127, 159, 160, 87, 184, 77, 170,
-	74, 78, 169, 125, 157, 163, 119, 116, 73, 161,
-	117, 115, 107, 94, 101, 134, 114, 135, 102, 122,
-	121, 123, 0, 0, 0, 151, 167, 185, 80, 0,
-	147, 156, 175, 176, 177, 178, 179, 180, 0, 0,
-	81, 97, 92, 133, 124, 79, 103, 148, 106, 113,
-	139, 183, 130, 144, 84, 166, 149, 0, 202, 0,
+	0, 569, 0, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
+	0, 0, 556, 555, 565, 566, 558, 559, 560, 561,
+	562, 563, 564, 557, 0, 0, 0, 0, 0, 567,
+	0, 0, 0, 0, 757, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 25, 0, 0, 0, 0, 68, 75, 110, 0,
-	138, 95, 168, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 0, 0, 0, 91, 0, 0, 0,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
+	0, 0, 98, 203, 204, 0, 0, 200, 0, 0,
+	0, 205, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 25, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 182,
+	89, 85, 67, 568, 68, 75, 110, 0, 138, 95,
+	168, 91, 0, 0, 0, 0, 0, 109, 0, 111,
+	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	569, 0, 207, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
+	0, 556, 555, 565, 566, 558, 559, 560, 561, 562,
+	563, 564, 557, 0, 0, 0, 0, 0, 567, 0,
+	0, 0, 0, 757, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 55, 0, 0, 207, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
+	0, 136, 0, 153, 100, 108, 69, 76, 1010, 99,
+	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
+	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
+	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
+	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
+	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
+	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
+	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
+	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
+	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
+	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
+	144, 84, 166, 149, 25, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 182, 89,
+	85, 67, 568, 68, 75, 110, 23, 138, 95, 168,
+	91, 0, 0, 0, 0, 0, 109, 0, 111, 0,
+	0, 150, 120, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 569, 0, 55, 0,
+	0, 637, 0, 0, 0, 1011, 0, 0, 0, 0,
+	82, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 128, 556, 555, 565,
+	566, 558, 559, 560, 561, 562, 563, 564, 557, 0,
+	0, 0, 0, 0, 567, 0, 0, 0, 0, 757,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 172, 0, 0, 0, 0,
+	136, 0, 153, 100, 108, 69, 76, 0, 99, 126,
+	141, 145, 0, 0, 0, 86, 0, 143, 131, 165,
+	0, 132, 142, 112, 158, 137, 0, 173, 174, 155,
+	171, 181, 70, 154, 164, 83, 146, 72, 162, 152,
+	118, 104, 105, 71, 0, 140, 90, 96, 88, 127,
+	159, 160, 87, 184, 77, 170, 74, 78, 169, 125,
+	157, 163, 119, 116, 73, 161, 117, 115, 107, 94,
+	101, 134, 114, 135, 102, 122, 121, 123, 0, 0,
+	0, 151, 167, 185, 80, 0, 147, 156, 175, 176,
+	177, 178, 179, 180, 0, 0, 81, 97, 92, 133,
+	124, 79, 103, 148, 106, 113, 139, 183, 130, 144,
+	84, 166, 149, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 568, 0,
+	0, 0, 68, 75, 110, 23, 138, 95, 168, 129,
+	0, 182, 89, 85, 67, 0, 0, 0, 878, 0,
+	0, 0, 0, 91, 0, 0, 0, 0, 0, 109,
+	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 65, 0, 64, 0, 0, 0,
+	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
-	25, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 0, 182, 89, 85, 67, 68, 75,
-	110, 23, 138, 95, 168, 91, 0, 0, 0, 0,
-	0, 109, 0, 111, 0, 0, 150, 120, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 55, 0, 0, 640, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
+	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
+	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
+	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
+	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
+	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
+	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
+	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
+	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
+	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
+	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
+	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 182, 89, 85, 67,
+	0, 0, 0, 878, 0, 68, 75, 110, 91, 138,
+	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
+	0, 64, 0, 0, 0, 0, 0, 0, 82, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	93, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 136, 0, 153, 100, 108,
-	69, 76, 0, 99, 126, 141, 145, 0, 0, 0,
-	86, 0, 143, 131, 165, 0, 132, 142, 112, 158,
-	137, 0, 173, 174, 155, 171, 181, 70, 154, 164,
-	83, 146, 72, 162, 152, 118, 104, 105, 71, 0,
-	140, 90, 96, 88, 127, 159, 160, 87, 184, 77,
-	170, 74, 78, 169, 125, 157, 163, 119, 116, 73,
-	161, 117, 115, 107, 94, 101, 134, 114, 135, 102,
-	122, 121, 123, 0, 0, 0, 151, 167, 185, 80,
-	0, 147, 156, 175, 176, 177, 178, 179, 180, 0,
-	0, 81, 97, 92, 133, 124, 79, 103, 148, 106,
-	113, 139, 183, 130, 144, 84, 166, 149, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
+	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
+	0, 0, 0, 86, 0, 143, 131, 165, 0, 876,
+	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
+	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
+	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
+	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
+	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
+	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
+	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
+	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
+	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
+	149, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 182, 89, 85, 67, 0, 0, 0, 0, 0,
+	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
+	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 68, 75, 110,
-	23, 138, 95, 168, 129, 0, 182, 89, 85, 67,
-	0, 0, 886, 0, 0, 0, 0, 91, 0, 0,
-	0, 0, 0, 109, 0, 111, 0, 0, 150, 120,
+	0, 0, 0, 0, 207, 0, 0, 768, 0, 0,
+	769, 0, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
+	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
+	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
+	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
+	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
+	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
+	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
+	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
+	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
+	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
+	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
+	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	182, 89, 85, 67, 0, 68, 75, 110, 0, 138,
+	95, 168, 91, 0, 659, 0, 0, 0, 109, 0,
+	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 658, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
-	64, 0, 0, 0, 0, 0, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
+	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
+	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 55, 0, 0, 637, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1882,8 +1930,8 @@ var yyAct = [...]int{
 	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
 	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
 	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	0, 182, 89, 85, 67, 0, 0, 886, 0, 68,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 68,
 	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
 	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1897,7 +1945,7 @@ var yyAct = [...]int{
 	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
 	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
 	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
-	131, 165, 0, 884, 142, 112, 158, 137, 0, 173,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
 	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
 	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
 	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
@@ -1907,12 +1955,12 @@ var yyAct = [...]int{
 	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
 	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
 	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 0, 182, 89, 85, 67,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
 	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
 	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	0, 771, 0, 0, 772, 0, 0, 82, 0, 0,
+	541, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1933,289 +1981,205 @@ var yyAct = [...]int{
 	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 129, 0, 182, 89, 85, 67, 68,
-	75, 110, 0, 138, 95, 168, 91, 0, 662, 0,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
+	75, 110, 0, 138, 95, 168, 628, 91, 0, 0,
+	0, 0, 0, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 661,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 68, 75,
-	110, 91, 138, 95, 168, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
-	0, 0, 640, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+	0, 337, 0, 0, 0, 0, 0, 0, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 68,
+	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
+	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 65, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
 	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
-	0, 0, 0, 68, 75, 110, 91, 138, 95, 168,
-	0, 0, 109, 0, 111, 0, 0, 150, 120, 0,
+	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
+	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 65, 0, 64,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 93, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 136, 0, 153, 100,
-	108, 69, 76, 0, 99, 126, 141, 145, 0, 0,
-	0, 86, 0, 143, 131, 165, 0, 132, 142, 112,
-	158, 137, 0, 173, 174, 155, 171, 181, 70, 154,
-	164, 83, 146, 72, 162, 152, 118, 104, 105, 71,
-	0, 140, 90, 96, 88, 127, 159, 160, 87, 184,
-	77, 170, 74, 78, 169, 125, 157, 163, 119, 116,
-	73, 161, 117, 115, 107, 94, 101, 134, 114, 135,
-	102, 122, 121, 123, 0, 0, 0, 151, 167, 185,
-	80, 0, 147, 156, 175, 176, 177, 178, 179, 180,
-	0, 0, 81, 97, 92, 133, 124, 79, 103, 148,
-	106, 113, 139, 183, 130, 144, 84, 166, 149, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 219,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
 	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	182, 89, 85, 67, 0, 0, 0, 0, 68, 75,
-	110, 91, 138, 95, 168, 0, 0, 109, 0, 111,
-	0, 0, 150, 120, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 543, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 136, 0, 153, 100, 108, 69, 76, 0, 99,
-	126, 141, 145, 0, 0, 0, 86, 0, 143, 131,
-	165, 0, 132, 142, 112, 158, 137, 0, 173, 174,
-	155, 171, 181, 70, 154, 164, 83, 146, 72, 162,
-	152, 118, 104, 105, 71, 0, 140, 90, 96, 88,
-	127, 159, 160, 87, 184, 77, 170, 74, 78, 169,
-	125, 157, 163, 119, 116, 73, 161, 117, 115, 107,
-	94, 101, 134, 114, 135, 102, 122, 121, 123, 0,
-	0, 0, 151, 167, 185, 80, 0, 147, 156, 175,
-	176, 177, 178, 179, 180, 0, 0, 81, 97, 92,
-	133, 124, 79, 103, 148, 106, 113, 139, 183, 130,
-	144, 84, 166, 149, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 68, 75, 110, 0, 138, 95, 168,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	0, 0, 631, 91, 0, 0, 0, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 68,
+	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
+	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 65, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
+	0, 0, 0, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 341, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
+	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
+	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 65, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 65, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 0, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	182, 89, 85, 67, 0, 0, 0, 0, 0, 68,
+	75, 110, 91, 138, 95, 168, 0, 0, 109, 0,
+	111, 0, 0, 150, 120, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 219, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 1060, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 136, 0, 153, 100, 108, 69, 76, 0,
+	99, 126, 141, 145, 0, 0, 0, 86, 0, 143,
+	131, 165, 0, 132, 142, 112, 158, 137, 0, 173,
+	174, 155, 171, 181, 70, 154, 164, 83, 146, 72,
+	162, 152, 118, 104, 105, 71, 0, 140, 90, 96,
+	88, 127, 159, 160, 87, 184, 77, 170, 74, 78,
+	169, 125, 157, 163, 119, 116, 73, 161, 117, 115,
+	107, 94, 101, 134, 114, 135, 102, 122, 121, 123,
+	0, 0, 0, 151, 167, 185, 80, 0, 147, 156,
+	175, 176, 177, 178, 179, 180, 0, 0, 81, 97,
+	92, 133, 124, 79, 103, 148, 106, 113, 139, 183,
+	130, 144, 84, 166, 149, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 182, 89, 85, 67, 0,
+	0, 0, 0, 0, 68, 75, 110, 91, 138, 95,
+	168, 0, 0, 109, 0, 111, 0, 0, 150, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 65, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 1017, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 182, 89, 85,
-	67, 0, 0, 0, 0, 68, 75, 110, 91, 138,
-	95, 168, 0, 0, 109, 0, 111, 0, 0, 150,
-	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 172, 0, 0, 0, 0, 136, 0, 153,
+	100, 108, 69, 76, 0, 99, 126, 141, 145, 0,
+	0, 0, 86, 0, 143, 131, 165, 0, 132, 142,
+	112, 158, 137, 0, 173, 174, 155, 171, 181, 70,
+	154, 164, 83, 146, 72, 162, 152, 118, 104, 105,
+	71, 0, 140, 90, 96, 88, 127, 159, 160, 87,
+	184, 77, 170, 74, 78, 169, 125, 157, 163, 119,
+	116, 73, 161, 117, 115, 107, 94, 101, 134, 114,
+	135, 102, 122, 121, 123, 0, 0, 0, 151, 167,
+	185, 80, 0, 147, 156, 175, 176, 177, 178, 179,
+	180, 230, 0, 81, 97, 92, 133, 124, 79, 103,
+	148, 106, 113, 139, 183, 130, 144, 84, 166, 149,
+	0, 0, 0, 0, 0, 0, 243, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 68,
+	75, 110, 0, 138, 95, 168, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 136, 0,
-	153, 100, 108, 69, 76, 0, 99, 126, 141, 145,
-	0, 0, 0, 86, 0, 143, 131, 165, 0, 132,
-	142, 112, 158, 137, 0, 173, 174, 155, 171, 181,
-	70, 154, 164, 83, 146, 72, 162, 152, 118, 104,
-	105, 71, 0, 140, 90, 96, 88, 127, 159, 160,
-	87, 184, 77, 170, 74, 78, 169, 125, 157, 163,
-	119, 116, 73, 161, 117, 115, 107, 94, 101, 134,
-	114, 135, 102, 122, 121, 123, 0, 0, 0, 151,
-	167, 185, 80, 0, 147, 156, 175, 176, 177, 178,
-	179, 180, 0, 0, 81, 97, 92, 133, 124, 79,
-	103, 148, 106, 113, 139, 183, 130, 144, 84, 166,
-	149, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 0, 182, 89, 85, 67, 0, 0, 0, 0,
-	68, 75, 110, 91, 138, 95, 168, 0, 0, 109,
-	0, 111, 0, 0, 150, 120, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1023, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 128,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	232, 0, 0, 0, 0, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 136, 0, 153, 100, 108, 69, 76,
-	0, 99, 126, 141, 145, 0, 0, 0, 86, 0,
-	143, 131, 165, 0, 132, 142, 112, 158, 137, 0,
-	173, 174, 155, 171, 181, 70, 154, 164, 83, 146,
-	72, 162, 152, 118, 104, 105, 71, 0, 140, 90,
-	96, 88, 127, 159, 160, 87, 184, 77, 170, 74,
-	78, 169, 125, 157, 163, 119, 116, 73, 161, 117,
-	115, 107, 94, 101, 134, 114, 135, 102, 122, 121,
-	123, 0, 0, 0, 151, 167, 185, 80, 0, 147,
-	156, 175, 176, 177, 178, 179, 180, 0, 0, 81,
-	97, 92, 133, 124, 79, 103, 148, 106, 113, 139,
-	183, 130, 144, 84, 166, 149, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 68, 75, 110, 0, 138,
-	95, 168,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 236,
+	226, 227, 0, 237, 238, 239, 241, 0, 240, 246,
+	0, 0, 0, 228, 231, 0, 224, 245, 244,
 }
 
-var yyPact = [...]int{
-	1855, -1000, -189, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	2185, -1000, -197, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 996, 12225, 1051, -1000, -1000, -1000, -1000, -1000,
-	-1000, 407, 10223, 53, 215, 83, 13222, 213, 2095, 13712,
-	-1000, 38, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -26,
-	-57, -1000, 88, -1000, -1000, -1000, -1000, -1000, 989, 993,
-	774, -1000, 969, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 970, 11360, 1017, -1000, -1000, -1000, -1000, -1000,
+	-1000, 411, 9370, 16, 150, 29, 12345, 149, 13523, 12835,
+	-1000, 8, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -78,
+	-118, -1000, 85, -1000, -1000, -1000, -1000, -1000, 967, 978,
+	754, -1000, 939, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2227,24 +2191,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 831, 966, 888, -1000,
-	7899, 154, 154, 12977, 6326, -1000, -1000, 389, 13712, 199,
-	13712, -153, 145, 145, 145, -1000, -1000, -1000, -1000, 210,
-	13712, 346, -1000, 13712, 140, 671, 140, 140, 140, 13712,
-	-1000, 294, 13712, 644, 3851, 226, 3851, 3851, -1000, 3851,
-	3851, -1000, 3851, 91, 3851, -30, 1007, -1000, -1000, -1000,
-	-1000, 19, -1000, 3851, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 553, 957, 8685,
-	8685, 88, 12225, 776, 996, -1000, 88, -1000, -1000, -1000,
-	939, -1000, -1000, 476, 1017, -1000, 9978, 282, -1000, 8685,
-	26, 776, -1000, -1000, 776, -1000, -1000, 245, -1000, -1000,
-	9471, 9471, 9471, 9471, 9471, 9471, 9471, 9471, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 812, 957, 861, -1000,
+	7039, 111, 111, 12100, 5460, -1000, -1000, 348, 12835, 140,
+	12835, -165, 102, 102, 102, -1000, -1000, -1000, -1000, 146,
+	12835, 401, -1000, 12835, 101, 673, 101, 101, 101, 12835,
+	-1000, 218, 12835, 669, 3252, 313, 3252, 3252, -1000, 3252,
+	3252, -1000, 3252, 48, 3252, -80, 989, -1000, -1000, -1000,
+	-1000, -58, -1000, 3252, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 574, 918, 7828,
+	7828, 85, 11360, 757, 970, -1000, 85, -1000, -1000, -1000,
+	893, -1000, -1000, 452, 1003, -1000, 9125, 283, 2000, -1000,
+	7828, 757, -1000, -1000, -1000, -1000, 8617, 8617, 8617, 8617,
+	8617, 8617, 8617, 8617, -1000, -1000, -1000, -1000, 757, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 776, -1000, 7113, 776, 776, 776, 776, 776,
-	776, 776, 776, 8685, 776, 776, 776, 776, 776, 776,
-	776, 776, 776, 776, 776, 776, 776, 776, 776, 12732,
-	11980, 13712, 755, 707, -1000, -1000, 280, 769, 6051, -97,
-	-1000, -1000, -1000, 400, 11735, -1000, -1000, -1000, 918, -1000,
+	6250, 757, 757, 757, 757, 757, 757, 757, 757, 7828,
+	757, 757, 757, 757, 757, 757, 757, 757, 757, 757,
+	757, 757, 757, 757, 757, 11855, 11115, 12835, 731, 730,
+	-1000, -1000, 187, 746, 5184, -102, -1000, -1000, -1000, 362,
+	10870, -1000, -1000, -1000, 895, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2254,300 +2218,302 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 688, 13712, -1000, 2383,
-	-1000, 641, 3851, 173, 635, 429, 631, 13712, 13712, 3851,
-	78, 103, 203, 13712, 772, 171, 13712, 961, 852, 13712,
-	605, 591, -1000, 5776, -1000, 3851, -1000, -1000, -1000, 3851,
-	3851, 3851, 13712, 3851, 3851, -1000, -1000, -1000, -1000, -1000,
-	3851, 3851, -1000, 1016, 437, -1000, -1000, -1000, -1000, 8685,
-	-1000, 850, -1000, -1000, -1000, -1000, -1000, -1000, 1046, 347,
-	487, 276, 770, -1000, 481, -1000, -1000, 88, 989, 553,
-	888, 11486, 830, -1000, -1000, 13712, -1000, 8685, 8685, 444,
-	-1000, 12470, -1000, -1000, 4676, 367, 9471, 457, 363, 9471,
-	9471, 9471, 9471, 9471, 9471, 9471, 9471, 9471, 9471, 9471,
-	9471, 9471, 9471, 9471, 9471, 9471, 9471, 9471, 505, 9471,
-	2940, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 579,
-	-1000, 88, 833, 833, 29, 29, 29, 29, 29, 29,
-	29, 9733, 7375, 553, 686, 372, 7113, 7899, 7899, 8685,
-	8685, 8423, 8161, 7899, 974, 424, 372, 13957, -1000, -1000,
-	9209, -1000, -1000, -1000, -1000, -1000, 553, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 13467, 13467, 7899, 7899, 7899, 7899,
-	106, 13712, -1000, 758, 1132, -1000, -1000, -1000, 963, 10734,
-	776, 11241, 106, 723, 11980, 13712, -1000, -1000, 11980, 13712,
-	4401, 5501, 769, -97, 745, -1000, -91, -52, 6850, 241,
-	-1000, -1000, -1000, -1000, 3576, 450, 701, 455, -19, -1000,
-	-1000, -1000, 787, -1000, 787, 787, 787, 787, 14, 14,
-	14, 14, -1000, -1000, -1000, -1000, -1000, 816, 815, -1000,
-	787, 787, 787, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 668, 12835, -1000, 1962, -1000, 663, 3252,
+	126, 662, 404, 651, 12835, 12835, 3252, 30, 57, 145,
+	12835, 750, 122, 12835, 935, 828, 12835, 649, 638, -1000,
+	4908, -1000, 3252, -1000, -1000, -1000, 3252, 3252, 3252, 12835,
+	3252, 3252, -1000, -1000, -1000, -1000, -1000, 3252, 3252, -1000,
+	1002, 353, -1000, -1000, -1000, -1000, 7828, -1000, 827, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1011, 280, 467, 24, 757,
+	748, -1000, 476, -1000, -1000, 85, 967, 574, 861, 10621,
+	822, -1000, -1000, 12835, -1000, 7828, 7828, 420, -1000, 11605,
+	-1000, -1000, 7828, 6513, 3804, 8617, 483, 380, 8617, 8617,
+	8617, 8617, 8617, 8617, 8617, 8617, 8617, 8617, 8617, 8617,
+	8617, 8617, 8617, 8617, 8617, 8617, 8617, 530, 8617, 2355,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 312, -1000, 624,
+	25, 25, 25, 25, 25, 25, 25, 8880, -1000, 85,
+	574, 637, 394, 6250, 7039, 7039, 7828, 7828, 7565, 7302,
+	7039, 942, 391, 394, 12590, -1000, -1000, 8354, -1000, -1000,
+	-1000, -1000, -1000, 574, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 12590, 12590, 7039, 7039, 7039, 7039, 74, 12835, -1000,
+	704, 876, -1000, -1000, -1000, 938, 9868, 757, 10376, 74,
+	699, 11115, 12835, -1000, -1000, 11115, 12835, 3528, 4632, 746,
+	-102, 735, -1000, -142, -140, 5986, 227, -1000, -1000, -1000,
+	-1000, 2976, 664, 676, 457, -60, -1000, -1000, -1000, 765,
+	-1000, 765, 765, 765, 765, -30, -30, -30, -30, -1000,
+	-1000, -1000, -1000, -1000, 801, 796, -1000, 765, 765, 765,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 814, 814, 814, 808, 808, 822, -1000, 13712, 3851,
-	960, 3851, -1000, 1872, -1000, 13467, 13467, 13712, 13712, 233,
-	13712, 13712, 767, -1000, 13712, 3851, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 794, 794,
+	794, 791, 791, 810, -1000, 12835, 3252, 933, 3252, -1000,
+	232, -1000, 12590, 12590, 12835, 12835, 208, 12835, 12835, 741,
+	-1000, 12835, 3252, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12835, 366, 12835,
+	12835, 394, 12835, -1000, 877, 7828, 7828, 4080, 7828, -1000,
+	-1000, -1000, 574, 918, -1000, 942, 969, -1000, 889, 888,
+	7039, -1000, -1000, 312, 386, -1000, -1000, 533, -1000, -1000,
+	-1000, 394, 574, 7039, 742, -1000, -1000, 757, 1206, -1000,
+	-1000, -1000, -1000, 483, 8617, 8617, 8617, 9610, 1206, 1206,
+	1206, 1206, 1206, 9856, 987, 725, 25, 182, 182, 38,
+	38, 38, 38, 38, 1544, 1544, -1000, -1000, -1000, 279,
+	-1000, -1000, -1000, 2355, 13325, 790, 787, 574, -1000, -1000,
+	7828, -1000, 574, 630, 630, 443, 509, 410, 993, 630,
+	298, 991, 630, 630, 7039, 505, -1000, 7828, 574, -1000,
+	331, 740, 738, 630, 574, 737, 630, 630, 242, 757,
+	-1000, 13080, 11115, 840, 11115, 11115, -1000, -1000, -1000, 862,
+	12835, -1000, 632, 9868, 12590, 217, 757, -1000, 11360, 988,
+	11115, 719, -1000, 719, -1000, 185, -1000, -1000, 735, -102,
+	-56, -1000, -1000, -1000, -1000, 394, -1000, 539, 722, 2700,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 786, 609, -1000,
+	907, 238, 413, 584, 906, -1000, -1000, -1000, 898, -1000,
+	433, -65, -1000, -1000, 535, -30, -30, -1000, -1000, 227,
+	894, 227, 227, 227, 551, 551, -1000, -1000, -1000, -1000,
+	532, -1000, -1000, -1000, 508, -1000, 824, 12590, 3252, -1000,
+	-1000, -1000, -1000, 540, 540, 365, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 73, 803, -1000,
+	-1000, -1000, 28, 27, 120, -1000, 3252, -1000, 353, -1000,
+	550, 7828, -1000, -1000, -1000, 873, 394, 394, -1000, -1000,
+	-1000, 12835, -1000, -1000, -1000, -1000, 743, -1000, -1000, -1000,
+	948, 630, 7039, 977, 7039, -1000, 9610, 1206, 2542, -1000,
+	8617, 8617, -1000, -200, -203, -1000, 714, -205, -207, 502,
+	501, -1000, 394, -1000, -1000, -1000, 2355, 530, 2355, 8617,
+	8617, -1000, 8617, 8617, -1000, -177, 685, 382, -1000, 7828,
+	462, -1000, -1000, 8617, 8617, -1000, -1000, -1000, -1000, 821,
+	13080, 757, -1000, 9619, 12590, 732, -1000, 355, -1000, 175,
+	-1000, -1000, 876, 11115, 11115, -1000, 858, 853, 850, 842,
+	820, -1000, -1000, -1000, -1000, -1000, 574, 713, -1000, 251,
+	-1000, 139, 136, 133, 12590, -1000, 970, 7828, 719, -1000,
+	-1000, 239, -1000, -1000, -148, -150, -1000, -1000, -1000, 2976,
+	-1000, 2976, 12590, 88, -1000, 584, 584, -1000, -1000, -1000,
+	782, 818, 8617, -1000, -1000, -1000, 633, 227, 227, -1000,
+	281, -1000, -1000, -1000, 617, -1000, 607, 712, 603, 12835,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	13712, 445, 13712, 13712, 372, 13712, -1000, 896, 8685, 8685,
-	5226, 8685, -1000, -1000, -1000, 553, 957, -1000, 974, 994,
-	-1000, 909, 907, 7899, -1000, -1000, 367, 377, -1000, -1000,
-	535, -1000, -1000, -1000, -1000, 275, 776, -1000, 1487, -1000,
-	-1000, -1000, -1000, 457, 9471, 9471, 9471, 1720, 1487, 1487,
-	1487, 1487, 1487, 2061, 935, 1916, 29, 325, 325, 34,
-	34, 34, 34, 34, 752, 752, -1000, -1000, -1000, 75,
-	-1000, -1000, -1000, 2940, 14202, 553, -1000, -1000, -1000, 553,
-	7899, 762, -1000, -1000, 8685, -1000, 553, 677, 677, 479,
-	524, 373, 1015, 677, 341, 1009, 677, 677, 7899, 421,
-	-1000, 8685, 553, -1000, 274, -1000, 148, 759, 754, 677,
-	553, 677, 677, 115, 776, -1000, 13957, 11980, 864, 11980,
-	11980, 11980, -1000, -1000, -1000, 874, 866, 894, 13712, -1000,
-	680, 10734, 13467, 227, 776, -1000, 12225, 1006, 11980, 738,
-	-1000, 738, -1000, 273, -1000, -1000, 745, -97, -60, -1000,
-	-1000, -1000, -1000, 372, -1000, 515, 740, 3301, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 793, 577, -1000, 951, 279,
-	285, 575, 950, -1000, -1000, -1000, 922, -1000, 438, -21,
-	-1000, -1000, 510, 14, 14, -1000, -1000, 241, 917, 241,
-	241, 241, 539, 539, -1000, -1000, -1000, -1000, 508, -1000,
-	-1000, -1000, 507, -1000, 846, 13467, 3851, -1000, -1000, -1000,
-	-1000, 1304, 1304, 319, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 105, 820, -1000, -1000, -1000,
-	66, 58, 159, -1000, 3851, -1000, 437, -1000, 536, 8685,
-	-1000, -1000, -1000, 893, 372, 372, 263, -1000, -1000, -1000,
-	13712, -1000, -1000, -1000, -1000, 757, -1000, -1000, -1000, 4126,
-	7899, -1000, 1720, 1487, 1534, -1000, 9471, 9471, -1000, -192,
-	-195, -1000, 737, -197, -199, -1000, -1000, 677, 7899, 372,
-	-1000, -1000, -1000, 2940, 505, 2940, 9471, 9471, -1000, 9471,
-	9471, -1000, -165, 748, 412, -1000, 8685, 436, -1000, 5226,
-	-1000, 9471, 9471, -1000, -1000, -1000, -1000, 836, 13957, 776,
-	-1000, 10485, 13467, 749, -1000, 399, 1132, 11980, -1000, 878,
-	877, 835, 1000, -1000, -1000, 867, -1000, 865, -1000, -1000,
-	-1000, -1000, 553, 733, -1000, 344, -1000, 198, 181, 177,
-	13467, -1000, 996, 8685, 738, -1000, -1000, 315, -1000, -1000,
-	-134, -82, -1000, -1000, -1000, 3576, -1000, 3576, 13467, 124,
-	-1000, 575, 575, -1000, -1000, -1000, 791, 828, 9471, -1000,
-	-1000, -1000, 603, 241, 241, -1000, 361, -1000, -1000, -1000,
-	653, -1000, 650, 731, 640, 13712, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 13712, -1000, -1000, -1000, -1000, -1000, 13467, -172,
-	556, 13467, 13467, 13712, -1000, 445, -1000, 372, -1000, 4951,
-	-1000, 1006, 11980, -1000, -1000, 553, -1000, 9471, 1487, 1487,
-	-1000, -1000, 14202, 2940, 2940, -1000, -1000, 553, 553, 553,
-	1656, 1559, 1462, 1399, 776, -160, -1000, 372, 8685, -1000,
-	270, 218, -1000, 953, 695, 722, -1000, -1000, 7637, 553,
-	602, 260, 600, -1000, 996, 13957, 8685, 807, -1000, -1000,
-	-1000, 8685, -1000, 8685, 790, -1000, -1000, 963, 13467, 6588,
-	776, 776, 776, 600, 989, 372, -1000, -1000, -1000, -1000,
-	3301, -1000, 596, -1000, 787, -1000, -1000, -1000, 13467, -15,
-	1044, 1487, -1000, -1000, -1000, -1000, -1000, 14, 530, 14,
-	496, -1000, 495, 3851, -1000, -1000, -1000, -1000, 956, -1000,
-	4951, -1000, -1000, 779, -1000, -1000, -1000, 1004, 730, -1000,
-	1487, -1000, -1000, -1000, -1000, -1000, -1000, 9471, 9471, 9471,
-	9471, 9471, 553, 525, 372, 9471, 9471, 948, -1000, 776,
-	-1000, -1000, 162, 13467, 13467, -1000, 13467, 989, -1000, 372,
-	-1000, -1000, 372, 372, 13467, 13712, -1000, -1000, 372, 776,
-	776, 13467, 13467, 13467, 10996, -1000, 222, 13467, -1000, 587,
-	-1000, 249, -1000, 43, 241, -1000, 241, 597, 562, -1000,
-	776, 727, -1000, 395, 13467, 1001, 992, 148, 148, 148,
-	148, 56, -1000, -1000, 148, 148, 1042, -1000, 776, -1000,
-	88, 228, -1000, -1000, -1000, 583, -1000, 11980, 13957, 573,
-	573, 573, 227, 222, -1000, 543, 387, 498, -1000, 121,
-	13467, 474, 937, -1000, 933, -1000, -1000, -1000, -1000, -1000,
-	104, 4951, 3576, 568, 76, 8685, 8685, -1000, -1000, -1000,
-	-1000, 553, 57, -176, -1000, -1000, 13957, 722, 553, 13467,
-	-1000, 912, 553, -1000, -1000, -1000, -1000, -1000, -1000, 489,
-	-1000, -1000, 13712, -1000, -1000, 451, -1000, -1000, 561, -1000,
-	13467, -1000, -1000, 820, -1000, 819, 372, 713, -1000, 885,
-	-169, -185, 712, -1000, -1000, -1000, -1000, -1000, 777, -1000,
-	-1000, 104, 904, -172, 709, -1000, 480, 979, 8685, -1000,
-	882, -1000, 13467, -1000, 100, -1000, 819, -1000, 402, 8685,
-	372, -174, 552, 59, -1000, 1018, 372, -181, 827, 776,
-	-1000, -186, 809, -1000, 1014, 8947, -1000, -1000, 1020, 236,
-	236, 148, 553, -1000, -1000, -1000, 129, 497, -1000, -1000,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 12835, -1000, -1000, -1000,
+	-1000, -1000, 12590, -184, 557, 12590, 12590, 12835, -1000, 366,
+	-1000, 394, -1000, -1000, 988, 11115, -1000, 757, 948, -1000,
+	7828, 574, -1000, 8617, 1206, 1206, -1000, -1000, 13325, 2355,
+	2355, 711, 710, 574, 574, 574, 9361, 9130, 2166, 1754,
+	757, -172, -1000, 394, 7828, 1699, 474, -1000, 927, 679,
+	706, -1000, -1000, 6776, 574, 601, 174, 596, -1000, 970,
+	13080, 7828, 4356, 781, 809, -1000, -1000, -1000, 851, -1000,
+	849, -1000, 7828, 938, 12590, 5723, 757, 757, 757, 596,
+	967, 394, -1000, -1000, -1000, -1000, 2700, -1000, 594, -1000,
+	765, -1000, -1000, -1000, 12590, -54, 1007, 1206, -1000, -1000,
+	-1000, -1000, -1000, -30, 547, -30, 498, -1000, 493, 3252,
+	-1000, -1000, -1000, -1000, 929, -1000, 4080, -1000, -1000, 764,
+	-1000, -1000, -1000, 983, 708, 69, -1000, 582, -1000, 1206,
+	-1000, -1000, -1000, 484, 472, -1000, -1000, -1000, 8617, 8617,
+	8617, 8617, 8617, 574, 546, 394, 8617, 8617, 905, -1000,
+	757, -1000, -1000, 179, 12590, 12590, -1000, 12590, 967, -1000,
+	394, -1000, 165, -1000, -1000, -1000, 7828, 763, -1000, -1000,
+	-1000, 394, 12835, -1000, -1000, 394, 757, 757, 12590, 12590,
+	12590, 10131, -1000, 196, 12590, -1000, 578, -1000, 213, -1000,
+	-48, 227, -1000, 227, 618, 604, -1000, 757, 707, -1000,
+	326, 12590, 981, 976, 970, 975, 948, 583, 579, 331,
+	331, 331, 331, 71, -1000, -1000, 331, 331, 1006, -1000,
+	757, -1000, 85, 163, -1000, -1000, -1000, 4080, 394, 12590,
+	-1000, 11115, 13080, 573, 573, 573, 217, 196, -1000, 479,
+	305, 534, -1000, 41, 12590, 444, 902, -1000, 900, -1000,
+	-1000, -1000, -1000, -1000, 59, 4080, 2976, 571, 36, 7828,
+	7828, 574, 7828, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	574, 65, -189, -1000, -1000, 13080, 706, 574, 12590, -1000,
+	568, 612, 574, -1000, -1000, -1000, -1000, -1000, -1000, 464,
+	-1000, -1000, 12835, -1000, -1000, 531, -1000, -1000, 562, -1000,
+	12590, -1000, -1000, 803, -1000, 797, 394, 703, -1000, 703,
+	-1000, 872, -180, -193, 702, -1000, -1000, -1000, -1000, -1000,
+	-1000, 761, -1000, -1000, 59, 883, -184, 701, -1000, 446,
+	955, 7828, -1000, 867, -1000, 12590, -1000, 51, -1000, 797,
+	-1000, 367, 7828, 394, -185, 556, 42, -1000, 1014, 394,
+	-190, 817, 757, -1000, -194, 815, -1000, 999, 8091, -1000,
+	-1000, 990, 199, 199, 331, 574, -1000, -1000, -1000, 93,
+	477, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 
-var yyPgo = [...]int{
-	0, 1309, 99, 232, 1308, 1306, 1296, 142, 1295, 1293,
-	1292, 1289, 1288, 1287, 1286, 1280, 1278, 1277, 1273, 1272,
-	1270, 1269, 1268, 1266, 1265, 1262, 1261, 1260, 1259, 210,
-	1258, 1257, 1252, 72, 1248, 73, 1236, 1234, 46, 172,
-	70, 38, 1022, 1230, 60, 23, 44, 1227, 1226, 1222,
-	21, 1215, 22, 1214, 1213, 76, 1212, 1209, 56, 1208,
-	1207, 75, 1206, 66, 1205, 12, 47, 1204, 1202, 1201,
-	1200, 71, 1405, 1199, 1198, 15, 1196, 1195, 97, 1194,
-	57, 9, 13, 39, 20, 1193, 59, 28, 1192, 52,
-	1191, 1190, 1188, 1178, 41, 1177, 58, 1176, 19, 61,
-	1174, 1172, 3, 1171, 11, 69, 34, 26, 7, 77,
-	85, 1169, 29, 65, 55, 1168, 1167, 260, 1166, 1160,
-	48, 1157, 1152, 31, 222, 242, 1151, 1150, 1149, 1147,
-	79, 0, 888, 50, 74, 1143, 1141, 1138, 1885, 51,
-	17, 18, 25, 35, 294, 45, 1137, 1135, 62, 27,
-	1133, 1131, 1130, 1128, 1126, 1124, 1120, 1117, 1114, 67,
-	1110, 1107, 1105, 43, 30, 1104, 1103, 64, 63, 1102,
-	1097, 1095, 53, 68, 1094, 1093, 54, 32, 1092, 1090,
-	1088, 1086, 1084, 42, 14, 1082, 16, 1079, 10, 1078,
-	1077, 36, 1074, 5, 1072, 8, 1071, 4, 1070, 6,
-	49, 1, 1069, 2, 1065, 1064, 353, 437, 78, 1061,
-	80,
+var yyPgo = [...]int16{
+	0, 1297, 34, 188, 1295, 1294, 1292, 107, 1291, 1289,
+	1284, 1282, 1277, 1275, 1274, 1272, 1270, 1268, 1266, 1265,
+	1248, 1244, 1238, 1234, 1226, 1224, 1202, 1201, 1197, 338,
+	1196, 1195, 1194, 68, 1193, 71, 1190, 1187, 44, 152,
+	48, 52, 474, 1186, 69, 17, 49, 1185, 1181, 1180,
+	27, 1178, 28, 1177, 1176, 74, 1175, 1174, 51, 1173,
+	1172, 1306, 1171, 77, 1170, 14, 80, 1167, 1165, 1164,
+	1163, 1162, 654, 1161, 1160, 23, 1159, 1158, 90, 1157,
+	55, 11, 16, 18, 25, 1154, 124, 19, 1153, 58,
+	1152, 1151, 1150, 1149, 15, 1148, 22, 54, 63, 1147,
+	30, 62, 1143, 1142, 4, 1140, 6, 73, 46, 36,
+	9, 75, 72, 1139, 47, 67, 61, 1138, 1137, 265,
+	1135, 1134, 56, 1133, 1132, 39, 186, 168, 1127, 1125,
+	1124, 1123, 29, 1259, 26, 357, 70, 1122, 1121, 1120,
+	205, 33, 20, 21, 40, 154, 1354, 45, 1119, 1118,
+	60, 43, 1117, 1116, 1114, 1108, 1106, 1104, 1103, 1102,
+	1101, 32, 1100, 1086, 1085, 31, 37, 1083, 1079, 76,
+	64, 1078, 1075, 1074, 50, 65, 1069, 1066, 66, 38,
+	1062, 1061, 1058, 1056, 1050, 41, 10, 1049, 24, 1046,
+	12, 1043, 1042, 42, 1040, 5, 1038, 13, 1037, 8,
+	1036, 7, 57, 2, 1032, 3, 1028, 1027, 0, 190,
+	78, 1026, 101,
 }
 
-var yyR1 = [...]int{
-	0, 204, 205, 205, 1, 1, 1, 1, 1, 1,
+var yyR1 = [...]uint8{
+	0, 206, 207, 207, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 2, 2, 2, 2, 6, 6,
-	8, 8, 7, 9, 3, 4, 5, 5, 10, 10,
-	32, 32, 11, 12, 12, 12, 12, 208, 208, 55,
-	55, 56, 56, 105, 105, 13, 13, 13, 13, 110,
-	110, 114, 114, 114, 115, 115, 115, 115, 146, 146,
-	14, 14, 14, 14, 14, 14, 14, 199, 199, 198,
-	197, 197, 196, 196, 195, 20, 179, 181, 181, 180,
-	180, 180, 180, 173, 152, 152, 152, 152, 155, 155,
-	153, 153, 153, 153, 153, 153, 153, 153, 153, 154,
-	154, 154, 154, 154, 156, 156, 156, 156, 156, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 158, 158, 158, 158, 158, 158,
-	158, 158, 172, 172, 159, 159, 167, 167, 168, 168,
-	168, 165, 165, 166, 166, 169, 169, 169, 161, 161,
-	162, 162, 170, 170, 163, 163, 163, 164, 164, 164,
-	171, 171, 171, 171, 171, 160, 160, 174, 174, 189,
-	189, 188, 188, 188, 178, 178, 185, 185, 185, 185,
-	185, 176, 176, 177, 177, 187, 187, 186, 175, 175,
-	191, 191, 191, 191, 202, 203, 201, 201, 201, 201,
-	201, 182, 182, 182, 183, 183, 183, 184, 184, 184,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 200, 200, 200, 200, 200, 200, 200,
-	200, 200, 200, 200, 194, 192, 192, 193, 193, 16,
-	21, 21, 17, 17, 17, 17, 17, 18, 18, 22,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	8, 8, 7, 9, 3, 4, 4, 4, 5, 5,
+	10, 10, 32, 32, 11, 12, 12, 12, 12, 210,
+	210, 55, 55, 56, 56, 107, 107, 13, 13, 13,
+	13, 112, 112, 116, 116, 116, 117, 117, 117, 117,
+	148, 148, 14, 14, 14, 14, 14, 14, 14, 201,
+	201, 200, 199, 199, 198, 198, 197, 20, 181, 183,
+	183, 182, 182, 182, 182, 175, 154, 154, 154, 154,
+	157, 157, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 156, 156, 156, 156, 156, 158, 158, 158, 158,
+	158, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 160, 160, 160, 160,
+	160, 160, 160, 160, 174, 174, 161, 161, 169, 169,
+	170, 170, 170, 167, 167, 168, 168, 171, 171, 171,
+	163, 163, 164, 164, 172, 172, 165, 165, 165, 166,
+	166, 166, 173, 173, 173, 173, 173, 162, 162, 176,
+	176, 191, 191, 190, 190, 190, 180, 180, 187, 187,
+	187, 187, 187, 178, 178, 179, 179, 189, 189, 188,
+	177, 177, 193, 193, 193, 193, 204, 205, 203, 203,
+	203, 203, 203, 184, 184, 184, 185, 185, 185, 186,
+	186, 186, 15, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 196, 194, 194, 195,
+	195, 16, 21, 21, 17, 17, 17, 17, 17, 18,
+	18, 22, 23, 23, 23, 23, 23, 23, 23, 23,
 	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
 	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 121, 121, 119, 119, 122, 122, 120, 120, 120,
-	123, 123, 123, 147, 147, 147, 24, 24, 26, 26,
-	27, 28, 25, 25, 25, 25, 25, 25, 25, 19,
-	209, 29, 30, 30, 31, 31, 31, 35, 35, 35,
-	33, 33, 34, 34, 40, 40, 39, 39, 41, 41,
-	41, 41, 135, 135, 135, 134, 134, 43, 43, 44,
-	44, 45, 45, 46, 46, 46, 46, 46, 64, 64,
-	49, 49, 48, 48, 50, 51, 51, 51, 104, 104,
-	106, 106, 47, 47, 47, 47, 52, 52, 53, 53,
-	54, 54, 142, 142, 141, 141, 141, 190, 190, 190,
-	140, 140, 57, 57, 57, 59, 58, 58, 58, 58,
-	60, 60, 62, 62, 61, 61, 63, 65, 65, 65,
-	65, 66, 66, 42, 42, 42, 42, 42, 42, 42,
-	118, 118, 68, 68, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 79, 79,
-	79, 79, 79, 79, 69, 69, 69, 69, 69, 69,
-	69, 38, 38, 80, 80, 80, 86, 81, 81, 72,
+	23, 23, 23, 123, 123, 121, 121, 124, 124, 122,
+	122, 122, 125, 125, 125, 149, 149, 149, 24, 24,
+	26, 26, 27, 28, 25, 25, 25, 25, 25, 25,
+	25, 19, 211, 29, 30, 30, 31, 31, 31, 35,
+	35, 35, 33, 33, 34, 34, 40, 40, 39, 39,
+	41, 41, 41, 41, 137, 137, 137, 136, 136, 43,
+	43, 44, 44, 45, 45, 46, 46, 46, 46, 46,
+	64, 64, 49, 49, 48, 48, 50, 51, 51, 51,
+	106, 106, 108, 108, 47, 47, 47, 47, 52, 52,
+	53, 53, 54, 54, 144, 144, 143, 143, 143, 192,
+	192, 192, 142, 142, 57, 57, 57, 59, 58, 58,
+	58, 58, 60, 60, 62, 62, 61, 61, 63, 65,
+	65, 65, 65, 66, 66, 42, 42, 42, 42, 42,
+	42, 42, 120, 120, 68, 68, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	79, 79, 79, 79, 79, 79, 69, 69, 69, 69,
+	69, 69, 69, 38, 38, 80, 80, 80, 86, 81,
+	81, 72, 72, 72, 72, 72, 72, 72, 72, 72,
 	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
 	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 72, 72, 76, 76, 76, 74, 74, 74, 74,
-	74, 74, 74, 74, 74, 74, 74, 74, 74, 75,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 210, 210, 78, 77, 77,
-	77, 77, 77, 77, 36, 36, 36, 36, 36, 145,
-	145, 148, 148, 148, 148, 150, 150, 149, 149, 151,
-	151, 90, 90, 37, 37, 88, 88, 89, 91, 91,
-	87, 87, 87, 71, 71, 71, 71, 71, 71, 71,
-	71, 73, 73, 73, 92, 92, 93, 93, 94, 94,
-	95, 95, 96, 97, 97, 97, 98, 98, 98, 98,
-	99, 99, 99, 100, 100, 101, 101, 102, 102, 102,
-	102, 70, 70, 70, 70, 70, 70, 103, 103, 103,
-	103, 107, 107, 82, 82, 84, 84, 83, 85, 108,
-	108, 112, 109, 109, 113, 113, 113, 113, 111, 111,
-	111, 137, 137, 137, 116, 116, 124, 124, 125, 125,
-	117, 117, 126, 126, 126, 126, 126, 126, 126, 126,
-	126, 126, 127, 127, 127, 128, 128, 129, 129, 129,
-	136, 136, 132, 132, 133, 133, 138, 138, 139, 139,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
-	130, 130, 130, 130, 130, 130, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 206, 207, 143, 144, 144,
-	144,
+	72, 72, 72, 72, 76, 76, 76, 76, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 212, 212, 78,
+	77, 77, 77, 77, 77, 77, 36, 36, 36, 36,
+	36, 147, 147, 150, 150, 150, 150, 150, 150, 152,
+	152, 151, 151, 153, 153, 90, 90, 37, 37, 88,
+	88, 89, 91, 91, 87, 87, 87, 71, 71, 71,
+	71, 71, 71, 71, 71, 73, 73, 73, 92, 92,
+	95, 95, 94, 94, 93, 93, 96, 96, 97, 97,
+	98, 99, 99, 99, 100, 100, 100, 100, 101, 101,
+	101, 102, 102, 103, 103, 104, 104, 104, 104, 70,
+	70, 70, 70, 70, 70, 105, 105, 105, 105, 109,
+	109, 82, 82, 84, 84, 83, 85, 110, 110, 114,
+	111, 111, 115, 115, 115, 115, 113, 113, 113, 139,
+	139, 139, 118, 118, 126, 126, 127, 127, 119, 119,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	129, 129, 129, 130, 130, 131, 131, 131, 138, 138,
+	134, 134, 135, 135, 140, 140, 141, 141, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 208, 209, 145, 146, 146, 146,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 0, 4, 4, 6, 7, 0, 1,
-	1, 3, 5, 5, 11, 3, 1, 3, 7, 8,
-	1, 1, 9, 8, 7, 6, 6, 1, 1, 1,
-	3, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 2, 8, 4, 6, 5, 5, 0, 2, 1,
-	0, 2, 1, 3, 3, 4, 4, 2, 4, 1,
-	3, 3, 3, 8, 3, 1, 1, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
-	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
-	5, 0, 1, 0, 1, 0, 1, 2, 0, 2,
-	0, 3, 0, 1, 0, 3, 3, 0, 2, 2,
-	0, 2, 1, 2, 1, 0, 2, 5, 4, 1,
-	2, 2, 3, 2, 0, 1, 2, 3, 3, 2,
-	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
-	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
-	6, 7, 7, 7, 7, 4, 5, 7, 5, 5,
-	5, 12, 7, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 3, 4, 4, 4, 4, 4, 4, 3, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 2, 2, 2, 2, 3, 3, 5, 2,
-	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 3, 2,
-	0, 2, 0, 2, 1, 2, 2, 0, 1, 1,
-	0, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 2, 1,
-	3, 1, 1, 1, 3, 1, 3, 6, 3, 7,
-	0, 1, 1, 3, 3, 1, 4, 4, 1, 3,
-	1, 3, 5, 4, 4, 3, 2, 4, 0, 1,
-	0, 2, 0, 1, 0, 1, 2, 0, 1, 1,
-	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 3, 0, 5, 5,
-	5, 0, 2, 1, 3, 3, 2, 3, 1, 2,
-	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
-	3, 3, 3, 3, 4, 5, 6, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	1, 3, 5, 5, 11, 1, 3, 3, 1, 3,
+	7, 8, 1, 1, 9, 8, 7, 6, 6, 1,
+	1, 1, 3, 1, 3, 0, 4, 3, 4, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 2, 8, 4, 6, 5, 5, 0,
+	2, 1, 0, 2, 1, 3, 3, 4, 4, 2,
+	4, 1, 3, 3, 3, 8, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	1, 4, 4, 2, 2, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 0, 1, 0, 1, 2,
+	0, 2, 0, 3, 0, 1, 0, 3, 3, 0,
+	2, 2, 0, 2, 1, 2, 1, 0, 2, 5,
+	4, 1, 2, 2, 3, 2, 0, 1, 2, 3,
+	3, 2, 2, 1, 1, 0, 1, 1, 3, 2,
+	3, 1, 10, 11, 11, 12, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 3, 1, 2, 3, 1,
+	1, 1, 6, 7, 7, 7, 7, 4, 5, 7,
+	5, 5, 5, 12, 7, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 7, 1, 3, 8,
+	8, 3, 3, 5, 4, 6, 5, 4, 4, 3,
+	2, 3, 4, 3, 4, 4, 4, 4, 4, 4,
+	3, 3, 2, 3, 3, 2, 3, 4, 3, 7,
+	5, 4, 2, 4, 2, 2, 2, 2, 3, 3,
+	5, 2, 3, 1, 1, 0, 1, 1, 1, 0,
+	2, 2, 0, 2, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 2, 2, 2, 2, 2, 3,
+	3, 2, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
+	1, 2, 3, 3, 0, 1, 2, 1, 1, 0,
+	2, 1, 3, 1, 1, 1, 3, 1, 3, 6,
+	3, 7, 0, 1, 1, 3, 3, 1, 4, 4,
+	1, 3, 1, 3, 5, 4, 5, 3, 2, 4,
+	0, 1, 0, 2, 0, 1, 0, 1, 2, 0,
+	1, 1, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 3, 3, 3, 3, 4, 5, 6, 2,
+	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 3, 1,
+	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
 	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 3, 4, 5, 6, 4, 4, 6, 6,
-	6, 8, 8, 8, 8, 9, 7, 5, 4, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
-	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
-	1, 1, 1, 3, 3, 0, 1, 1, 3, 3,
-	3, 0, 1, 0, 2, 1, 2, 4, 0, 2,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 0, 3, 0, 2, 0, 3,
-	1, 3, 2, 0, 1, 1, 0, 2, 4, 4,
-	0, 2, 4, 0, 2, 1, 3, 2, 4, 3,
-	2, 2, 1, 3, 5, 4, 6, 1, 3, 3,
-	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
-	3, 3, 1, 3, 3, 3, 3, 3, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 3, 3, 5, 6, 8, 6, 4, 4,
+	6, 6, 6, 8, 8, 8, 8, 9, 7, 5,
+	4, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 8, 8, 0, 2, 3,
+	4, 4, 4, 4, 4, 4, 0, 3, 4, 7,
+	3, 1, 1, 1, 1, 3, 3, 6, 6, 0,
+	1, 1, 3, 3, 3, 0, 1, 0, 2, 1,
+	2, 4, 0, 2, 1, 3, 5, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
+	0, 2, 0, 5, 0, 3, 0, 3, 1, 3,
+	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
+	4, 0, 2, 1, 3, 2, 4, 3, 2, 2,
+	1, 3, 5, 4, 6, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 1, 2, 1, 1,
+	1, 1, 1, 1, 0, 2, 0, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 0, 1, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2569,346 +2535,350 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
-var yyChk = [...]int{
-	-1000, -204, -1, -2, -9, -10, -11, -12, -13, -14,
+var yyChk = [...]int16{
+	-1000, -206, -1, -2, -9, -10, -11, -12, -13, -14,
 	-15, -16, -17, -18, -22, -23, -24, -26, -27, -28,
-	-25, -19, -3, 276, -4, 6, 7, -32, 9, 10,
-	34, -20, 125, 126, 128, 127, 160, 129, 153, 55,
-	173, 174, 176, 177, 29, 154, 155, 158, 159, 35,
-	36, 131, -206, 8, 263, 59, -205, 280, -94, 15,
-	-8, -7, -140, -138, 64, 62, -131, 23, 273, 146,
-	173, 184, 178, 205, 197, 274, 147, 195, 198, 242,
-	225, 237, 71, 176, 251, 22, 156, 193, 189, 21,
-	187, 31, 239, 86, 210, 278, 188, 238, 131, 149,
-	144, 211, 215, 243, 182, 183, 245, 209, 145, 37,
-	275, 39, 164, 246, 213, 208, 204, 207, 181, 203,
-	43, 217, 216, 218, 241, 200, 150, 190, 87, 18,
-	249, 159, 162, 240, 212, 214, 141, 166, 277, 247,
-	186, 151, 163, 158, 250, 152, 177, 227, 244, 253,
-	42, 222, 180, 143, 174, 170, 228, 201, 165, 191,
-	192, 206, 179, 202, 175, 160, 252, 223, 279, 199,
-	196, 171, 136, 168, 169, 229, 230, 231, 232, 233,
-	234, 172, 20, 248, 194, 224, -31, 5, -29, -209,
-	-29, -29, -29, -29, -29, -179, -181, 59, 96, -129,
-	136, 77, 255, 132, 133, 140, -132, 62, -131, -117,
-	136, 232, 138, 133, 133, 135, 136, 255, 132, 133,
-	-61, -138, 133, 118, 242, 125, 226, 227, 239, 135,
-	37, 240, 166, -147, 133, -119, 225, 229, 230, 231,
-	234, 232, 172, 62, 244, 243, 235, -138, 175, -143,
-	-143, -143, -143, -143, 228, 228, -143, -2, -98, 17,
-	16, -6, 60, 26, -5, -3, -206, 6, 24, 25,
-	-35, 44, 45, -30, -41, 106, -42, -138, -67, 79,
-	-72, 33, 62, -131, 27, -71, -68, -87, -85, -86,
-	118, 119, 120, 104, 105, 112, 80, 121, -76, -74,
-	-75, -77, 64, 63, 72, 65, 66, 67, 68, 73,
-	74, 75, -132, -83, -206, 49, 50, 264, 265, 266,
-	267, 272, 268, 82, 38, 254, 262, 261, 260, 258,
-	259, 256, 257, 270, 271, 139, 255, 110, 263, -117,
-	-117, 11, -55, -56, -61, -63, -138, -109, -146, 175,
-	-113, 244, 243, -133, -111, -132, -130, 242, 198, 241,
-	130, 78, 26, 28, 220, 81, 118, 16, 82, 117,
-	264, 125, 53, 256, 257, 254, 266, 267, 255, 226,
-	33, 10, 29, 154, 25, 108, 127, 85, 157, 27,
-	155, 75, 19, 56, 11, 13, 14, 139, 138, 98,
-	135, 51, 8, 121, 30, 95, 46, 32, 49, 96,
-	17, 258, 259, 35, 272, 161, 110, 54, 40, 79,
-	73, 76, 57, 77, 15, 52, 97, 128, 263, 50,
-	132, 6, 269, 34, 153, 47, 133, 84, 270, 271,
-	137, 167, 74, 5, 140, 36, 9, 55, 58, 260,
-	261, 262, 38, 83, 12, 276, -180, 96, -173, 62,
-	-61, 135, -61, 263, -125, 139, -125, -125, 133, -61,
-	125, 127, 130, 57, -21, -61, -124, 139, 62, -124,
-	-124, -124, -61, 122, -61, 62, -144, -206, -133, 255,
-	62, 166, 133, 167, 136, -144, -144, -144, -144, -144,
-	170, 171, -144, -122, -121, 237, 238, 228, 236, 12,
-	228, 169, -144, -143, -143, -207, 61, -99, 19, 35,
-	-42, -138, -95, -96, -42, -2, -7, -206, -94, -2,
-	-29, 40, -33, 25, 70, 11, -135, 78, 77, 95,
-	-134, 26, -132, 64, 122, -42, -69, 98, 79, 96,
-	112, 114, 113, 115, 97, 81, 101, 100, 111, 104,
-	105, 106, 107, 108, 109, 110, 102, 103, 117, 281,
-	69, 88, 89, 90, 91, 92, 93, 94, -118, -206,
-	-86, -206, 123, 124, -72, -72, -72, -72, -72, -72,
-	-72, -72, -206, -2, -81, -42, -206, -206, -206, -206,
-	-206, -206, -206, -206, -206, -90, -42, -206, -210, -78,
-	-206, -210, -78, -210, -78, -210, -206, -210, -78, -210,
-	-78, -210, -210, -78, -206, -206, -206, -206, -206, -206,
-	-62, 30, -61, -44, -45, -46, -47, -64, -86, -206,
-	62, -61, -61, -55, -208, 60, 11, 58, -208, 60,
-	122, 60, -109, 175, -110, -114, 245, 247, 88, -137,
-	-132, 64, 33, 34, 61, 60, -61, -152, -155, -157,
-	-156, -158, -153, -154, 195, 196, 118, 199, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 34, 156,
-	191, 192, 193, 194, 211, 212, 213, 214, 215, 216,
-	217, 218, 178, 197, 274, 179, 180, 181, 182, 183,
-	184, 186, 187, 188, 189, 190, 62, -144, 136, 62,
-	79, 62, -61, -61, -144, 168, 168, 133, 133, -61,
-	60, 137, -55, 27, 57, -61, 62, 62, -139, -138,
-	-130, -144, -144, -144, -144, -61, -144, -144, -144, -144,
-	11, -120, 11, 98, -42, 57, 9, 98, 60, 18,
-	122, 60, -97, 28, 29, -2, -98, -207, -35, -73,
-	-132, 65, 68, -34, 47, -61, -42, -42, -79, 73,
-	79, 74, 75, -134, 106, -139, -133, -130, -72, -80,
-	-83, -86, 69, 98, 96, 97, 81, -72, -72, -72,
+	-25, -19, -3, 277, -4, 6, 7, -32, 9, 10,
+	35, -20, 126, 127, 129, 128, 161, 130, 154, 56,
+	174, 175, 177, 178, 30, 155, 156, 159, 160, 36,
+	37, 132, -208, 8, 264, 60, -207, 281, -96, 15,
+	-8, -7, -142, -140, 65, 63, -133, 23, 274, 147,
+	174, 185, 179, 206, 198, 275, 148, 196, 199, 243,
+	226, 238, 72, 177, 252, 22, 157, 194, 190, 21,
+	188, 32, 240, 87, 211, 279, 189, 239, 132, 150,
+	145, 212, 216, 244, 183, 184, 246, 210, 146, 38,
+	276, 40, 165, 247, 214, 209, 205, 208, 182, 204,
+	44, 218, 217, 219, 242, 201, 151, 191, 88, 18,
+	250, 160, 163, 241, 213, 215, 142, 167, 278, 248,
+	187, 152, 164, 159, 251, 153, 178, 228, 245, 254,
+	43, 223, 181, 144, 175, 171, 229, 202, 166, 192,
+	193, 207, 180, 203, 176, 161, 253, 224, 280, 200,
+	197, 172, 137, 169, 170, 230, 231, 232, 233, 234,
+	235, 173, 20, 249, 195, 225, -31, 5, -29, -211,
+	-29, -29, -29, -29, -29, -181, -183, 60, 97, -131,
+	137, 78, 256, 133, 134, 141, -134, 63, -133, -119,
+	137, 233, 139, 134, 134, 136, 137, 256, 133, 134,
+	-61, -140, 134, 119, 243, 126, 227, 228, 240, 136,
+	38, 241, 167, -149, 134, -121, 226, 230, 231, 232,
+	235, 233, 173, 63, 245, 244, 236, -140, 176, -145,
+	-145, -145, -145, -145, 229, 229, -145, -2, -100, 17,
+	16, -6, 61, 27, -5, -3, -208, 6, 25, 26,
+	-35, 45, 46, -30, -41, 107, -42, -134, -72, -67,
+	80, 34, -71, -68, -85, -86, 119, 120, 121, 105,
+	106, 113, 81, 122, -76, -74, -75, -77, 28, 65,
+	64, 73, 66, 67, 68, 69, 74, 75, 76, -83,
+	-208, 50, 51, 265, 266, 267, 268, 273, 269, 83,
+	39, 255, 263, 262, 261, 259, 260, 257, 258, 271,
+	272, 140, 256, 111, 264, -119, -119, 11, -55, -56,
+	-61, -63, -140, -111, -148, 176, -115, 245, 244, -135,
+	-113, -134, -132, 243, 199, 242, 131, 79, 27, 29,
+	221, 82, 119, 16, 83, 118, 265, 126, 54, 257,
+	258, 255, 267, 268, 256, 227, 34, 10, 30, 155,
+	26, 109, 128, 86, 158, 28, 156, 76, 19, 57,
+	11, 13, 14, 140, 139, 99, 136, 52, 8, 122,
+	31, 96, 47, 33, 50, 97, 17, 259, 260, 36,
+	273, 162, 111, 55, 41, 80, 74, 77, 58, 78,
+	24, 15, 53, 98, 129, 264, 51, 133, 6, 270,
+	35, 154, 48, 134, 85, 271, 272, 138, 168, 75,
+	5, 141, 37, 9, 56, 59, 261, 262, 263, 39,
+	84, 12, 277, -182, 97, -175, 63, -61, 136, -61,
+	264, -127, 140, -127, -127, 134, -61, 126, 128, 131,
+	58, -21, -61, -126, 140, 63, -126, -126, -126, -61,
+	123, -61, 63, -146, -208, -135, 256, 63, 167, 134,
+	168, 137, -146, -146, -146, -146, -146, 171, 172, -146,
+	-124, -123, 238, 239, 229, 237, 12, 229, 170, -146,
+	-145, -145, -209, 62, -101, 19, 36, -42, -72, -134,
+	-97, -98, -42, -2, -7, -208, -96, -2, -29, 41,
+	-33, 26, 71, 11, -137, 79, 78, 96, -136, 27,
+	-134, 65, 124, -208, 123, -69, 99, 80, 97, 113,
+	115, 114, 116, 98, 82, 102, 101, 112, 105, 106,
+	107, 108, 109, 110, 111, 103, 104, 118, 282, 70,
+	89, 90, 91, 92, 93, 94, 95, -42, -120, -208,
+	-72, -72, -72, -72, -72, -72, -72, -72, -86, -208,
+	-2, -81, -42, -208, -208, -208, -208, -208, -208, -208,
+	-208, -208, -90, -42, -208, -212, -78, -208, -212, -78,
+	-212, -78, -212, -208, -212, -78, -212, -78, -212, -212,
+	-78, -208, -208, -208, -208, -208, -208, -62, 31, -61,
+	-44, -45, -46, -47, -64, -86, -208, 63, -61, -61,
+	-55, -210, 61, 11, 59, -210, 61, 123, 61, -111,
+	176, -112, -116, 246, 248, 89, -139, -134, 65, 34,
+	35, 62, 61, -61, -154, -157, -159, -158, -160, -155,
+	-156, 196, 197, 119, 200, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 35, 157, 192, 193, 194,
+	195, 212, 213, 214, 215, 216, 217, 218, 219, 179,
+	198, 275, 180, 181, 182, 183, 184, 185, 187, 188,
+	189, 190, 191, 63, -146, 137, 63, 80, 63, -61,
+	-61, -146, 169, 169, 134, 134, -61, 61, 138, -55,
+	28, 58, -61, 63, 63, -141, -140, -132, -146, -146,
+	-146, -146, -61, -146, -146, -146, -146, 11, -122, 11,
+	99, -42, 58, 9, 99, 61, 18, 123, 61, -99,
+	29, 30, -2, -100, -209, -35, -73, -134, 66, 69,
+	-34, 48, -61, -42, -42, -79, 74, 80, 75, 76,
+	-136, -42, -40, 26, -39, -41, 107, -135, -72, -80,
+	-83, -86, 70, 99, 97, 98, 82, -72, -72, -72,
 	-72, -72, -72, -72, -72, -72, -72, -72, -72, -72,
-	-72, -72, -72, -72, -72, -72, -145, 62, 64, -72,
-	-148, 62, -131, 281, 283, 62, -71, -71, -132, -40,
-	25, -39, -41, -207, 60, -207, -2, -39, -39, -42,
-	-42, -87, 64, -39, -87, 64, -39, -39, -33, -88,
-	-89, 83, -87, -132, -138, -207, -72, -132, -132, -39,
-	-40, -39, -39, -105, 162, -61, 34, 60, -190, -59,
-	-58, -60, 48, 7, 47, 49, 50, 54, -142, 26,
-	-44, -206, -206, -141, 162, -140, 26, -105, 58, -44,
-	-61, -44, -63, -138, 106, -113, -110, 60, 246, 248,
-	249, 57, 76, -42, -164, 117, -182, -183, -184, -133,
-	64, 65, -173, -174, -175, -185, 148, -191, 141, 143,
-	140, -176, 149, 135, 32, 61, -169, 73, 79, -165,
-	223, -159, 59, -159, -159, -159, -159, -163, 198, -163,
-	-163, -163, 59, 59, -159, -159, -159, -167, 59, -167,
-	-167, -168, 59, -168, -136, 58, -61, -144, 27, -144,
-	-126, 130, 127, 128, -194, 126, 220, 198, 71, 33,
-	15, 264, 162, 279, 62, 163, -132, -132, -61, -61,
-	130, 127, -61, -61, -61, -144, -61, -123, 96, 12,
-	-138, -138, -61, 42, -42, -42, -139, -96, -207, -99,
-	-116, 19, 11, 38, 38, -39, 73, 74, 75, 122,
-	-206, -80, -72, -72, -72, -38, 157, 78, 282, -148,
-	-150, -149, -151, 62, -131, -207, -207, -39, 60, -42,
-	-207, -207, -207, 60, 58, 26, 11, 11, -207, 11,
-	11, -207, -207, -39, -91, -89, 85, -42, -207, 122,
-	-207, 60, 60, -207, -207, -207, -207, -70, 34, 38,
-	-2, -206, -206, -108, -112, -87, -45, -57, 46, 51,
-	53, -46, -45, -46, 46, 52, 46, 52, 46, -58,
-	-138, -207, -49, -48, -50, -132, -65, 55, 138, 56,
-	-206, -140, -66, 12, -44, -66, -66, 122, -114, -115,
-	250, 247, 253, 62, 64, 60, -184, 88, 59, 62,
-	32, -176, -176, -177, 62, -177, 32, -161, 33, 73,
-	-166, 224, 65, -163, -163, -164, 34, -164, -164, -164,
-	-172, 64, -172, 65, 65, 57, -132, -144, -143, -200,
-	142, 148, 149, 144, 62, 135, 32, 141, 143, 162,
-	140, -200, -127, -128, 137, 26, 135, 32, 162, -199,
-	58, 168, 168, 137, -144, -120, 64, -42, 43, 122,
-	-61, -43, 11, 106, -133, -40, -38, 78, -72, -72,
-	282, 284, 60, 285, 285, -207, -41, -148, -145, -148,
-	-72, -72, -72, -72, 273, -94, 86, -42, 84, -133,
-	-72, -72, -107, 57, -108, -82, -84, -83, -206, -2,
-	-103, -132, -106, -132, -66, 60, 88, -46, 46, 46,
-	-54, 57, -52, 57, 58, 46, 46, -207, 60, 99,
-	135, 135, 135, -106, -94, -42, -66, 247, 251, 252,
-	-183, -184, -187, -186, -132, -191, -177, -177, 59, -162,
-	57, -72, 61, -164, -164, 62, 118, 61, 60, 61,
-	60, 61, 60, -61, -143, -143, -61, -143, -132, -197,
-	276, -198, 62, -132, -132, -61, -123, -66, -44, -207,
-	-72, -149, -148, -148, -207, -207, -207, 19, 19, 19,
-	19, -206, -37, 269, -42, 60, 60, 31, -107, 60,
-	-207, -207, -207, 60, 122, -207, 60, -94, -112, -42,
-	-53, -52, -42, -42, 59, -142, -50, -51, -42, 133,
-	134, -206, -206, -206, -207, -98, 61, 60, -159, -104,
-	-132, -170, 220, 9, -163, 64, -163, 65, 65, -144,
-	30, -196, -195, -133, 59, -92, 13, -72, -72, -72,
-	-72, -72, -207, 64, -72, -72, 32, -84, 38, -2,
-	-206, -132, -132, -132, -98, -104, -138, -206, -206, -104,
-	-104, -104, -141, -189, -188, 58, 145, 71, -186, 61,
-	60, -171, 141, 32, 140, -75, -164, -164, 61, 61,
-	-206, 60, 88, -104, -93, 14, 16, -207, -207, -207,
-	-207, -36, 98, 276, -207, -207, 9, -82, -2, 122,
-	61, -45, -87, -207, -207, -207, -65, -188, 62, -178,
-	88, 64, 151, -132, -160, 71, 32, 32, -192, -193,
-	162, -195, -184, 61, -100, 167, -42, -81, -207, 274,
-	54, 277, -108, -207, -132, -207, -207, 65, -61, 64,
-	-207, 60, -132, -199, -101, -102, 57, 23, 22, 43,
-	275, 278, 59, -193, 38, -197, 60, 20, 86, 21,
-	-42, 43, -104, 164, -102, 87, -42, 276, 61, 165,
-	7, 277, -202, -203, 57, -206, 278, -203, 57, 10,
-	9, -72, 161, -201, 152, 147, 150, 34, -201, -207,
-	-207, 146, 33, 73,
+	-72, -72, -72, -72, -72, -72, -147, 63, 65, -72,
+	-150, 63, -133, 282, 284, 190, 191, 63, -134, -209,
+	61, -209, -2, -39, -39, -42, -42, -134, 65, -39,
+	-134, 65, -39, -39, -33, -88, -89, 84, -134, -209,
+	-72, -134, -134, -39, -40, -39, -39, -39, -107, 163,
+	-61, 35, 61, -192, -59, -60, 49, 7, 48, 55,
+	-144, 27, -44, -208, -208, -143, 163, -142, 27, -107,
+	59, -44, -61, -44, -63, -140, 107, -115, -112, 61,
+	247, 249, 250, 58, 77, -42, -166, 118, -184, -185,
+	-186, -135, 65, 66, -175, -176, -177, -187, 149, -193,
+	142, 144, 141, -178, 150, 136, 33, 62, -171, 74,
+	80, -167, 224, -161, 60, -161, -161, -161, -161, -165,
+	199, -165, -165, -165, 60, 60, -161, -161, -161, -169,
+	60, -169, -169, -170, 60, -170, -138, 59, -61, -146,
+	28, -146, -128, 131, 128, 129, -196, 127, 221, 199,
+	72, 34, 15, 265, 163, 280, 63, 164, -134, -134,
+	-61, -61, 131, 128, -61, -61, -61, -146, -61, -125,
+	97, 12, -140, -140, -61, 43, -42, -42, -98, -209,
+	-101, -118, 19, 11, 39, 39, -39, 74, 75, 76,
+	-209, -39, 61, 15, -208, -80, -72, -72, -72, -38,
+	158, 79, 283, -150, -152, -151, -153, 63, -133, 60,
+	60, -209, -42, -209, -209, -209, 61, 59, 27, 11,
+	11, -209, 11, 11, -209, -209, -39, -91, -89, 86,
+	-42, -209, -209, 61, 61, -209, -209, -209, -209, -70,
+	35, 39, -2, -208, -208, -110, -114, -87, -134, -140,
+	63, -133, -45, -57, -58, 47, 52, 54, 50, 51,
+	-46, -46, 47, -58, -140, -209, -49, -48, -50, -134,
+	-65, 56, 139, 57, -208, -142, -66, 12, -44, -66,
+	-66, 123, -116, -117, 251, 248, 254, 63, 65, 61,
+	-186, 89, 60, 63, 33, -178, -178, -179, 63, -179,
+	33, -163, 34, 74, -168, 225, 66, -165, -165, -166,
+	35, -166, -166, -166, -174, 65, -174, 66, 66, 58,
+	-134, -146, -145, -202, 143, 149, 150, 145, 63, 136,
+	33, 142, 144, 163, 141, -202, -129, -130, 138, 27,
+	136, 33, 163, -201, 59, 169, 169, 138, -146, -122,
+	65, -42, 44, -61, -43, 11, -94, 24, -209, -41,
+	16, -40, -38, 79, -72, -72, 283, 285, 61, 286,
+	286, 66, 66, -150, -147, -150, -72, -72, -72, -72,
+	274, -96, 87, -42, 85, -72, -72, -109, 58, -110,
+	-82, -84, -83, -208, -2, -105, -134, -108, -134, -66,
+	61, 89, 123, -46, -45, 47, 47, 47, 53, 47,
+	53, -54, 58, -209, 61, 100, 136, 136, 136, -108,
+	-96, -42, -66, 248, 252, 253, -185, -186, -189, -188,
+	-134, -193, -179, -179, 60, -164, 58, -72, 62, -166,
+	-166, 63, 119, 62, 61, 62, 61, 62, 61, -61,
+	-145, -145, -61, -145, -134, -199, 277, -200, 63, -134,
+	-134, -61, -125, -66, -44, -208, -94, -97, -209, -72,
+	-151, -150, -150, 61, 61, -209, -209, -209, 19, 19,
+	19, 19, -208, -37, 270, -42, 61, 61, 32, -109,
+	61, -209, -209, -209, 61, 123, -209, 61, -96, -114,
+	-42, -135, -141, -132, -53, -52, 58, 59, -52, 47,
+	47, -42, -144, -50, -51, -42, 134, 135, -208, -208,
+	-208, -209, -100, 62, 61, -161, -106, -134, -172, 221,
+	9, -165, 65, -165, 66, 66, -146, 31, -198, -197,
+	-135, 60, -92, 13, -93, 163, -209, 66, 66, -72,
+	-72, -72, -72, -72, -209, 65, -72, -72, 33, -84,
+	39, -2, -208, -134, -134, -134, -100, 123, -42, 60,
+	-140, -208, -208, -106, -106, -106, -143, -191, -190, 59,
+	146, 72, -188, 62, 61, -173, 142, 33, 141, -75,
+	-166, -166, 62, 62, -208, 61, 89, -106, -95, 14,
+	16, -96, 16, -94, 62, 62, -209, -209, -209, -209,
+	-36, 99, 277, -209, -209, 9, -82, -2, 123, -135,
+	-106, -45, -87, -209, -209, -209, -65, -190, 63, -180,
+	89, 65, 152, -134, -162, 72, 33, 33, -194, -195,
+	163, -197, -186, 62, -102, 168, -42, -81, -209, -81,
+	-209, 275, 55, 278, -110, -209, -134, 62, -209, -209,
+	66, -61, 65, -209, 61, -134, -201, -103, -104, 58,
+	23, 22, 44, 276, 279, 60, -195, 39, -199, 61,
+	20, 87, 21, -42, 44, -106, 165, -104, 88, -42,
+	277, 62, 166, 7, 278, -204, -205, 58, -208, 279,
+	-205, 58, 10, 9, -72, 162, -203, 153, 148, 151,
+	35, -203, -209, -209, 147, 34, 74,
 }
 
-var yyDef = [...]int{
+var yyDef = [...]int16{
 	23, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 578, 0, 0, 320, 320, 320, 320, 320,
-	320, 0, 657, 640, 0, 0, 0, 0, -2, 307,
-	308, 0, 310, 311, 887, 887, 887, 887, 887, 0,
-	0, 887, 0, 40, 41, 885, 1, 3, 586, 0,
-	28, 30, 0, 390, 391, 666, 667, 766, 767, 768,
-	769, 770, 771, 772, 773, 774, 775, 776, 777, 778,
-	779, 780, 781, 782, 783, 784, 785, 786, 787, 788,
-	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
-	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
-	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
-	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
-	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
-	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
-	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
-	859, 860, 861, 862, 863, 864, 865, 866, 867, 868,
-	869, 870, 871, 872, 873, 874, 875, 876, 877, 878,
-	879, 880, 881, 882, 883, 884, 0, 324, 327, 322,
-	0, 640, 640, 0, 0, 70, 71, 0, 0, 0,
-	871, 0, 638, 638, 638, 658, 659, 662, 663, 0,
-	0, 0, 641, 0, 636, 0, 636, 636, 636, 0,
-	258, 404, 0, 0, 888, 0, 888, 888, 270, 888,
-	888, 273, 888, 0, 888, 0, 280, 282, 283, 284,
-	285, 0, 289, 888, 304, 305, 294, 306, 309, 312,
-	313, 314, 315, 316, 887, 887, 319, 0, 590, 0,
-	0, 0, 29, 0, 578, 36, 0, 320, 325, 326,
-	330, 328, 329, 321, 0, 338, 342, 0, 413, 0,
-	418, 420, -2, -2, 0, 459, 460, 461, 462, 463,
-	0, 0, 0, 0, 0, 0, 0, 0, 487, 488,
-	489, 490, 563, 564, 565, 566, 567, 568, 569, 570,
-	422, 423, 560, 618, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 551, 0, 525, 525, 525, 525, 525,
-	525, 525, 525, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 49, 51, 404, 55, 0, 863,
-	622, -2, -2, 0, 0, 664, 665, -2, 777, -2,
-	670, 671, 672, 673, 674, 675, 676, 677, 678, 679,
-	680, 681, 682, 683, 684, 685, 686, 687, 688, 689,
-	690, 691, 692, 693, 694, 695, 696, 697, 698, 699,
-	700, 701, 702, 703, 704, 705, 706, 707, 708, 709,
-	710, 711, 712, 713, 714, 715, 716, 717, 718, 719,
-	720, 721, 722, 723, 724, 725, 726, 727, 728, 729,
-	730, 731, 732, 733, 734, 735, 736, 737, 738, 739,
-	740, 741, 742, 743, 744, 745, 746, 747, 748, 749,
-	750, 751, 752, 753, 754, 755, 756, 757, 758, 759,
-	760, 761, 762, 763, 764, 765, 0, 0, 89, 0,
-	87, 0, 888, 0, 0, 0, 0, 0, 0, 888,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 257, 0, 259, 888, 261, 889, 890, 888,
-	888, 888, 0, 888, 888, 268, 269, 271, 272, 274,
-	888, 888, 276, 0, 297, 295, 296, 291, 292, 0,
-	286, 287, 290, 317, 318, 35, 886, 24, 0, 0,
-	587, 0, 579, 580, 583, 25, 31, 0, 586, 0,
-	327, 0, 332, 331, 323, 0, 339, 0, 0, 0,
-	343, 0, 345, 346, 0, 416, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 444, 445, 446, 447, 448, 449, 450, 419, 0,
-	437, 0, 0, 0, 479, 480, 481, 482, 483, 484,
-	485, 0, 334, 0, 0, 457, 0, 0, 0, 0,
-	0, 0, 0, 0, 330, 0, 552, 0, 509, 517,
-	0, 510, 518, 511, 519, 512, 0, 513, 520, 514,
-	521, 515, 516, 522, 0, 0, 0, 334, 0, 0,
-	53, 0, 403, 0, -2, 351, 352, 353, -2, 0,
-	666, 384, -2, 0, 0, 0, 47, 48, 0, 0,
-	0, 0, 56, 863, 58, 59, 0, 0, 0, 167,
-	631, 632, 633, 629, 211, 0, 0, 155, 151, 95,
-	96, 97, 144, 99, 144, 144, 144, 144, 164, 164,
-	164, 164, 127, 128, 129, 130, 131, 0, 0, 114,
-	144, 144, 144, 118, 134, 135, 136, 137, 138, 139,
-	140, 141, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 146, 146, 146, 148, 148, 660, 73, 0, 888,
-	0, 888, 85, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 252, 637, 0, 888, 255, 256, 405, 668,
-	669, 260, 262, 263, 264, 265, 266, 267, 275, 279,
-	0, 300, 0, 0, 281, 0, 591, 0, 0, 0,
-	0, 0, 582, 584, 585, 0, 590, 37, 330, 0,
-	571, 0, 0, 0, 333, 33, 414, 415, 417, 438,
-	0, 440, 442, 344, 340, 0, 561, -2, 424, 425,
-	453, 454, 455, 0, 0, 0, 0, 451, 429, 430,
-	431, 432, 433, 0, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 478, 539, 540, 0,
-	492, 541, 542, 0, 545, 0, 476, 477, 486, 0,
-	0, 335, 336, 456, 0, 617, 0, 0, 0, 0,
-	0, 461, 563, 0, 461, 563, 0, 0, 0, 558,
-	555, 0, 0, 560, 0, 526, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 388, 389, 395, 0, 0, 0, 0, 383,
-	0, 0, 360, 407, 831, 385, 0, 411, 0, 411,
-	50, 411, 52, 0, 406, 623, 57, 0, 0, 62,
-	63, 624, 625, 626, 627, 0, 86, 212, 214, 217,
-	218, 219, 90, 91, 92, 0, 0, 199, 0, 0,
-	193, 193, 0, 191, 192, 88, 158, 156, 0, 153,
-	152, 98, 0, 164, 164, 121, 122, 167, 0, 167,
-	167, 167, 0, 0, 115, 116, 117, 109, 0, 110,
-	111, 112, 0, 113, 0, 0, 888, 75, 639, 76,
-	887, 0, 0, 652, 226, 642, 643, 644, 645, 646,
-	647, 648, 649, 650, 651, 0, 77, 228, 230, 229,
-	0, 0, 0, 250, 888, 254, 297, 278, 0, 0,
-	298, 299, 288, 0, 588, 589, 0, 581, 32, 26,
-	0, 634, 635, 572, 573, 347, 439, 441, 443, 0,
-	334, 426, 451, 434, 0, 427, 0, 0, 491, 0,
-	0, 546, 547, 0, 0, 421, 493, 0, 0, 458,
-	-2, 496, 497, 0, 0, 0, 0, 0, 532, 0,
-	0, 533, 0, 578, 0, 556, 0, 0, 508, 0,
-	527, 0, 0, 528, 529, 530, 531, 611, 0, 0,
-	602, 0, 0, 411, 619, 0, -2, 0, 392, 0,
-	0, 380, 387, 375, 396, 0, 398, 0, 400, 401,
-	354, 356, 0, 361, 362, 0, 358, 0, 0, 0,
-	0, 386, 578, 0, 411, 45, 46, 0, 60, 61,
-	0, 0, 67, 168, 169, 0, 215, 0, 0, 0,
-	186, 193, 193, 189, 194, 190, 0, 160, 0, 157,
-	94, 154, 0, 167, 167, 123, 0, 124, 125, 126,
-	0, 142, 0, 0, 0, 0, 661, 74, 220, 887,
-	233, 234, 235, 236, 237, 238, 239, 240, 241, 242,
-	243, 887, 0, 887, 653, 654, 655, 656, 0, 80,
-	0, 0, 0, 0, 253, 300, 301, 302, 592, 0,
-	27, 411, 0, 341, 562, 0, 428, 0, 452, 435,
-	543, 544, 0, 0, 0, 494, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 553, 507, 559, 0, 561,
-	0, 0, 38, 0, 611, 601, 613, 615, 0, 0,
-	0, 607, 0, 370, 578, 0, 0, 378, 393, 394,
-	373, 0, 374, 0, 0, 397, 399, 382, 0, 0,
-	0, 0, 0, 0, 586, 412, 44, 64, 65, 66,
-	213, 216, 0, 195, 144, 198, 187, 188, 0, 162,
-	0, 159, 145, 119, 120, 165, 166, 164, 0, 164,
-	0, 149, 0, 888, 221, 222, 223, 224, 0, 227,
-	0, 78, 79, 0, 232, 251, 277, 574, 348, 495,
-	436, 548, 549, 550, 498, 500, 499, 0, 0, 0,
-	0, 0, 0, 0, 557, 0, 0, 0, 39, 0,
-	616, -2, 0, 0, 0, 54, 0, 586, 620, 621,
-	372, 379, 381, 376, 0, 0, 363, 364, 365, 0,
-	0, 0, 0, 0, 384, 43, 178, 0, 197, 0,
-	368, 170, 163, 0, 167, 143, 167, 0, 0, 72,
-	0, 81, 82, 0, 0, 576, 0, 0, 0, 0,
-	0, 534, 506, 554, 0, 0, 0, 614, 0, 605,
-	0, 609, 608, 371, 42, 0, 357, 0, 0, 0,
-	0, 0, 407, 177, 179, 0, 184, 0, 196, 0,
-	0, 175, 0, 172, 174, 161, 132, 133, 147, 150,
-	0, 0, 0, 0, 593, 0, 0, 501, 503, 502,
-	504, 0, 0, 0, 523, 524, 0, 604, 0, 0,
-	377, 387, 0, 408, 409, 410, 359, 180, 181, 0,
-	185, 183, 0, 369, 93, 0, 171, 173, 0, 245,
-	0, 83, 84, 77, 34, 0, 577, 575, 505, 0,
-	0, 0, 612, -2, 610, 366, 367, 182, 0, 176,
-	244, 0, 0, 80, 594, 595, 0, 0, 0, 535,
-	0, 538, 0, 246, 0, 231, 0, 597, 0, 0,
-	600, 536, 0, 0, 596, 0, 599, 0, 200, 0,
-	598, 0, 201, 202, 0, 0, 537, 203, 0, 0,
-	0, 0, 0, 204, 206, 207, 0, 0, 205, 247,
-	248, 208, 209, 210,
+	21, 22, -2, 0, 0, 322, 322, 322, 322, 322,
+	322, 0, 665, 648, 0, 0, 0, 0, -2, 309,
+	310, 0, 312, 313, 896, 896, 896, 896, 896, 0,
+	0, 896, 0, 42, 43, 894, 1, 3, 594, 0,
+	28, 30, 0, 392, 393, 674, 675, 775, 776, 777,
+	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
+	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
+	888, 889, 890, 891, 892, 893, 0, 326, 329, 324,
+	0, 648, 648, 0, 0, 72, 73, 0, 0, 0,
+	880, 0, 646, 646, 646, 666, 667, 670, 671, 0,
+	0, 0, 649, 0, 644, 0, 644, 644, 644, 0,
+	260, 406, 0, 0, 897, 0, 897, 897, 272, 897,
+	897, 275, 897, 0, 897, 0, 282, 284, 285, 286,
+	287, 0, 291, 897, 306, 307, 296, 308, 311, 314,
+	315, 316, 317, 318, 896, 896, 321, 0, 598, 0,
+	0, 0, 29, 0, -2, 38, 0, 322, 327, 328,
+	332, 330, 331, 323, 0, 340, 344, 463, 420, 415,
+	0, 422, 461, 462, 464, 465, 0, 0, 0, 0,
+	0, 0, 0, 0, 487, 488, 489, 490, 0, 567,
+	568, 569, 570, 571, 572, 573, 574, 424, 425, 626,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 555,
+	0, 527, 527, 527, 527, 527, 527, 527, 527, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	51, 53, 406, 57, 0, 872, 630, -2, -2, 0,
+	0, 672, 673, -2, 786, -2, 678, 679, 680, 681,
+	682, 683, 684, 685, 686, 687, 688, 689, 690, 691,
+	692, 693, 694, 695, 696, 697, 698, 699, 700, 701,
+	702, 703, 704, 705, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 734, 735, 736, 737, 738, 739, 740, 741,
+	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
+	772, 773, 774, 0, 0, 91, 0, 89, 0, 897,
+	0, 0, 0, 0, 0, 0, 897, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 259,
+	0, 261, 897, 263, 898, 899, 897, 897, 897, 0,
+	897, 897, 270, 271, 273, 274, 276, 897, 897, 278,
+	0, 299, 297, 298, 293, 294, 0, 288, 289, 292,
+	319, 320, 36, 895, 24, 0, 0, 595, 420, 463,
+	587, 588, 591, 25, 31, 0, 594, 0, 329, 0,
+	334, 333, 325, 0, 341, 0, 0, 0, 345, 0,
+	347, 348, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	446, 447, 448, 449, 450, 451, 452, 418, 421, 0,
+	479, 480, 481, 482, 483, 484, 485, 0, 439, 0,
+	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
+	0, 332, 0, 556, 0, 511, 519, 0, 512, 520,
+	513, 521, 514, 0, 515, 522, 516, 523, 517, 518,
+	524, 0, 0, 0, 336, 0, 0, 55, 0, 405,
+	0, -2, 353, 354, 355, -2, 0, 674, 386, -2,
+	0, 0, 0, 49, 50, 0, 0, 0, 0, 58,
+	872, 60, 61, 0, 0, 0, 169, 639, 640, 641,
+	637, 213, 0, 0, 157, 153, 97, 98, 99, 146,
+	101, 146, 146, 146, 146, 166, 166, 166, 166, 129,
+	130, 131, 132, 133, 0, 0, 116, 146, 146, 146,
+	120, 136, 137, 138, 139, 140, 141, 142, 143, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 148, 148,
+	148, 150, 150, 668, 75, 0, 897, 0, 897, 87,
+	0, 227, 0, 0, 0, 0, 0, 0, 0, 254,
+	645, 0, 897, 257, 258, 407, 676, 677, 262, 264,
+	265, 266, 267, 268, 269, 277, 281, 0, 302, 0,
+	0, 283, 0, 599, 0, 0, 0, 0, 0, 590,
+	592, 593, 0, 598, 39, 332, 0, 575, 0, 0,
+	0, 335, 33, 416, 417, 419, 440, 0, 442, 444,
+	346, 342, 0, 0, 337, 338, 343, 492, 426, 427,
+	455, 456, 457, 0, 0, 0, 0, 453, 431, 432,
+	433, 434, 435, 0, 466, 467, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 541, 542, 0,
+	493, 543, 544, 0, 549, 796, 835, 0, 486, 458,
+	0, 625, 0, 0, 0, 0, 0, 463, 567, 0,
+	463, 567, 0, 0, 0, 562, 559, 0, 0, 528,
+	0, 0, 0, 0, 0, 337, 0, 0, 0, 0,
+	404, 0, 0, 0, 0, 0, 390, 391, 397, 0,
+	0, 385, 0, 0, 362, 409, 840, 387, 0, 413,
+	0, 413, 52, 413, 54, 0, 408, 631, 59, 0,
+	0, 64, 65, 632, 633, 634, 635, 0, 88, 214,
+	216, 219, 220, 221, 92, 93, 94, 0, 0, 201,
+	0, 0, 195, 195, 0, 193, 194, 90, 160, 158,
+	0, 155, 154, 100, 0, 166, 166, 123, 124, 169,
+	0, 169, 169, 169, 0, 0, 117, 118, 119, 111,
+	0, 112, 113, 114, 0, 115, 0, 0, 897, 77,
+	647, 78, 896, 0, 0, 660, 228, 650, 651, 652,
+	653, 654, 655, 656, 657, 658, 659, 0, 79, 230,
+	232, 231, 0, 0, 0, 252, 897, 256, 299, 280,
+	0, 0, 300, 301, 290, 0, 596, 597, 589, 32,
+	26, 0, 642, 643, 576, 577, 349, 441, 443, 445,
+	582, 0, 0, 0, 336, 428, 453, 436, 0, 429,
+	0, 0, 491, 0, 0, 550, 551, 0, 0, 0,
+	0, 423, 460, -2, 498, 499, 0, 0, 0, 0,
+	0, 534, 0, 0, 535, 0, 586, 0, 560, 0,
+	0, 510, 529, 0, 0, 530, 531, 532, 533, 619,
+	0, 0, 610, 0, 0, 413, 627, 0, 564, 0,
+	-2, -2, -2, 0, 0, 394, 0, 0, 0, 0,
+	382, 377, 402, 403, 356, 358, 0, 363, 364, 0,
+	360, 0, 0, 0, 0, 388, 586, 0, 413, 47,
+	48, 0, 62, 63, 0, 0, 69, 170, 171, 0,
+	217, 0, 0, 0, 188, 195, 195, 191, 196, 192,
+	0, 162, 0, 159, 96, 156, 0, 169, 169, 125,
+	0, 126, 127, 128, 0, 144, 0, 0, 0, 0,
+	669, 76, 222, 896, 235, 236, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 896, 0, 896, 661, 662,
+	663, 664, 0, 82, 0, 0, 0, 0, 255, 302,
+	303, 304, 600, 27, 413, 0, 494, 0, 582, 339,
+	0, 0, 430, 0, 454, 437, 545, 546, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 557, 509, 563, 0, 0, 0, 40, 0, 619,
+	609, 621, 623, 0, 0, 0, 615, 0, 372, 586,
+	0, 0, 0, 380, 389, 395, 396, 398, 0, 400,
+	0, 375, 0, 384, 0, 0, 0, 0, 0, 0,
+	594, 414, 46, 66, 67, 68, 215, 218, 0, 197,
+	146, 200, 189, 190, 0, 164, 0, 161, 147, 121,
+	122, 167, 168, 166, 0, 166, 0, 151, 0, 897,
+	223, 224, 225, 226, 0, 229, 0, 80, 81, 0,
+	234, 253, 279, 578, 350, 584, 495, 0, 497, 438,
+	552, 553, 554, 0, 0, 500, 502, 501, 0, 0,
+	0, 0, 0, 0, 0, 561, 0, 0, 0, 41,
+	0, 624, -2, 0, 0, 0, 56, 0, 594, 628,
+	629, 565, 0, -2, 374, 381, 0, 0, 376, 399,
+	401, 383, 0, 365, 366, 367, 0, 0, 0, 0,
+	0, 386, 45, 180, 0, 199, 0, 370, 172, 165,
+	0, 169, 145, 169, 0, 0, 74, 0, 83, 84,
+	0, 0, 580, 0, 586, 0, 582, 0, 0, 0,
+	0, 0, 0, 536, 508, 558, 0, 0, 0, 622,
+	0, 613, 0, 617, 616, 373, 44, 0, 378, 0,
+	359, 0, 0, 0, 0, 0, 409, 179, 181, 0,
+	186, 0, 198, 0, 0, 177, 0, 174, 176, 163,
+	134, 135, 149, 152, 0, 0, 0, 0, 601, 0,
+	0, 0, 0, 496, 547, 548, 503, 505, 504, 506,
+	0, 0, 0, 525, 526, 0, 612, 0, 0, 566,
+	0, 389, 0, 410, 411, 412, 361, 182, 183, 0,
+	187, 185, 0, 371, 95, 0, 173, 175, 0, 247,
+	0, 85, 86, 79, 34, 0, 581, 579, 583, 585,
+	507, 0, 0, 0, 620, -2, 618, 379, 368, 369,
+	184, 0, 178, 246, 0, 0, 82, 602, 603, 0,
+	0, 0, 537, 0, 540, 0, 248, 0, 233, 0,
+	605, 0, 0, 608, 538, 0, 0, 604, 0, 607,
+	0, 202, 0, 606, 0, 203, 204, 0, 0, 539,
+	205, 0, 0, 0, 0, 0, 206, 208, 209, 0,
+	0, 207, 249, 250, 210, 211, 212,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 80, 3, 3, 3, 109, 101, 3,
-	59, 61, 106, 104, 60, 105, 122, 107, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 285, 280,
-	89, 88, 90, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 81, 3, 3, 3, 110, 102, 3,
+	60, 62, 107, 105, 61, 106, 123, 108, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 286, 281,
+	90, 89, 91, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 281, 3, 282, 111, 3, 3, 3, 3, 3,
+	3, 282, 3, 283, 112, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 283, 100, 284, 112,
+	3, 3, 3, 284, 101, 285, 113,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 62, 63, 64,
+	52, 53, 54, 55, 56, 57, 58, 59, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 81, 82, 83, 84, 85,
-	86, 87, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 102, 103, 108, 110, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 82, 83, 84, 85,
+	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -2926,9 +2896,9 @@ var yyTok2 = [...]int{
 	269, 270, 271, 272, 273, 274,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]uint16{
 	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
-	0,
+	57605, 280, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3009,9 +2979,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -3021,13 +2991,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -3058,30 +3028,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -3136,7 +3106,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -3147,8 +3117,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -3161,7 +3131,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -3170,18 +3140,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -3203,10 +3173,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -3242,7 +3212,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -3253,16 +3223,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -3270,35 +3240,35 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:344
+//line sql.y:369
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:349
+//line sql.y:374
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:350
+//line sql.y:375
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:354
+//line sql.y:379
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:376
+//line sql.y:401
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:382
+//line sql.y:407
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3308,85 +3278,97 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:390
+//line sql.y:415
 		{
 			yyVAL.selStmt = &With{CommonTableExpressions: yyDollar[2].commonTableExpressions, Select: yyDollar[4].selStmt}
 		}
 	case 26:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:394
+//line sql.y:419
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 27:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:398
+//line sql.y:423
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
 	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:403
+//line sql.y:428
 		{
 			yyVAL.bytes = nil
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:407
+//line sql.y:432
 		{
 			yyVAL.bytes = []byte(",")
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:414
+//line sql.y:439
 		{
 			yyVAL.commonTableExpressions = []*CommonTableExpression{yyDollar[1].commonTableExpression}
 		}
 	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:418
+//line sql.y:443
 		{
 			yyVAL.commonTableExpressions = append(yyDollar[1].commonTableExpressions, yyDollar[3].commonTableExpression)
 		}
 	case 32:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:424
+//line sql.y:449
 		{
 			yyVAL.commonTableExpression = &CommonTableExpression{Name: yyDollar[1].tableIdent, Select: yyDollar[4].selStmt}
 		}
 	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:431
+//line sql.y:456
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
 	case 34:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:438
+//line sql.y:463
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Trigger: yyDollar[11].triggers}
 		}
 	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:469
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:444
+//line sql.y:473
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 36:
+	case 37:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:477
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:450
+//line sql.y:483
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:454
+//line sql.y:487
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 38:
+	case 40:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:461
+//line sql.y:494
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3398,9 +3380,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:473
+//line sql.y:506
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3410,334 +3392,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 40:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:518
 		{
 			yyVAL.str = InsertStr
 		}
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:489
+//line sql.y:522
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:495
+//line sql.y:528
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:501
+//line sql.y:534
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:505
+//line sql.y:538
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:509
+//line sql.y:542
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:513
+//line sql.y:546
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:518
+//line sql.y:551
 		{
 		}
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:519
+//line sql.y:552
 		{
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:523
+//line sql.y:556
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:527
+//line sql.y:560
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:533
+//line sql.y:566
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:537
+//line sql.y:570
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:542
+//line sql.y:575
 		{
 			yyVAL.partitions = nil
 		}
-	case 54:
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:546
+//line sql.y:579
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 55:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:552
+//line sql.y:585
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 56:
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:556
+//line sql.y:589
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 59:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:560
+//line sql.y:593
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 58:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:564
+//line sql.y:597
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 59:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:570
+//line sql.y:603
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 60:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:574
+//line sql.y:607
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 61:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:580
+//line sql.y:613
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 62:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:584
+//line sql.y:617
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 63:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:588
+//line sql.y:621
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 64:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:594
+//line sql.y:627
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 65:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:598
+//line sql.y:631
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 66:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:602
+//line sql.y:635
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 67:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:606
+//line sql.y:639
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 68:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:612
+//line sql.y:645
 		{
 			yyVAL.str = SessionStr
 		}
-	case 69:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:616
+//line sql.y:649
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 70:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:622
+//line sql.y:655
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 71:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:627
+//line sql.y:660
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 72:
+	case 74:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:633
+//line sql.y:666
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 73:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:638
+//line sql.y:671
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 74:
+	case 76:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:642
+//line sql.y:675
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 75:
+	case 77:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:646
+//line sql.y:679
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 76:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:650
+//line sql.y:683
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 77:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:655
+//line sql.y:688
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 78:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:659
+//line sql.y:692
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 79:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:665
+//line sql.y:698
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 80:
+	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:670
+//line sql.y:703
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 81:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:675
+//line sql.y:708
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 82:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:681
+//line sql.y:714
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 83:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:686
+//line sql.y:719
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 84:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:692
+//line sql.y:725
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 85:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:698
+//line sql.y:731
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 86:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:705
+//line sql.y:738
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 87:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:712
+//line sql.y:745
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 88:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:716
+//line sql.y:749
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 89:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:722
+//line sql.y:755
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 90:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:727
+//line sql.y:760
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:731
+//line sql.y:764
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:735
+//line sql.y:768
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:741
+//line sql.y:774
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3747,814 +3729,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:752
+//line sql.y:785
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:763
+//line sql.y:796
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 99:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:768
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 100:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:774
-		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-		}
 	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:778
+//line sql.y:801
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:782
+//line sql.y:807
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:786
+//line sql.y:811
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:790
+//line sql.y:815
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:819
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:823
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+//line sql.y:827
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:806
+//line sql.y:831
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:835
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:839
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:812
+//line sql.y:845
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 110:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:818
+//line sql.y:851
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 111:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:824
+//line sql.y:857
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 112:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:830
+//line sql.y:863
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 113:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:836
+//line sql.y:869
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 114:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:844
+//line sql.y:877
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 115:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:848
+//line sql.y:881
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:852
+//line sql.y:885
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:856
+//line sql.y:889
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:860
+//line sql.y:893
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 119:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+//line sql.y:899
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 120:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:870
+//line sql.y:903
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 121:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:907
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 122:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:878
+//line sql.y:911
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:882
+//line sql.y:915
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:919
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:890
+//line sql.y:923
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:894
+//line sql.y:927
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 127:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:931
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:935
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:939
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:943
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:914
+//line sql.y:947
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:918
+//line sql.y:951
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:923
+//line sql.y:956
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 134:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:933
+//line sql.y:966
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:937
+//line sql.y:970
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:941
+//line sql.y:974
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:945
+//line sql.y:978
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:949
+//line sql.y:982
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:953
+//line sql.y:986
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:957
+//line sql.y:990
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:963
+//line sql.y:996
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:968
+//line sql.y:1001
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 144:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:973
+//line sql.y:1006
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 145:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:977
+//line sql.y:1010
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:982
+//line sql.y:1015
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:986
+//line sql.y:1019
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 148:
+	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:994
+//line sql.y:1027
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 149:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:998
+//line sql.y:1031
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 150:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1004
+//line sql.y:1037
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1012
+//line sql.y:1045
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1016
+//line sql.y:1049
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1021
+//line sql.y:1054
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 154:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1025
+//line sql.y:1058
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 155:
+	case 157:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1031
+//line sql.y:1064
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 156:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1035
+//line sql.y:1068
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 157:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1039
+//line sql.y:1072
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 158:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1077
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1081
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 160:
+	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1053
+//line sql.y:1086
 		{
 			yyVAL.optVal = nil
 		}
-	case 161:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1057
+//line sql.y:1090
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 162:
+	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1062
+//line sql.y:1095
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 163:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1066
+//line sql.y:1099
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 164:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1071
+//line sql.y:1104
 		{
 			yyVAL.str = ""
 		}
-	case 165:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1075
+//line sql.y:1108
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 166:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1079
+//line sql.y:1112
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 167:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1084
+//line sql.y:1117
 		{
 			yyVAL.str = ""
 		}
-	case 168:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1088
+//line sql.y:1121
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 169:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1092
+//line sql.y:1125
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 170:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1097
+//line sql.y:1130
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 171:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1101
+//line sql.y:1134
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 172:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1105
+//line sql.y:1138
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 173:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1109
+//line sql.y:1142
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 174:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1113
+//line sql.y:1146
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 175:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1118
+//line sql.y:1151
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1122
+//line sql.y:1155
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1128
+//line sql.y:1161
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1132
+//line sql.y:1165
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1138
+//line sql.y:1171
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1142
+//line sql.y:1175
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1148
+//line sql.y:1181
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1152
+//line sql.y:1185
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 183:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1157
+//line sql.y:1190
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 184:
+	case 186:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1163
+//line sql.y:1196
 		{
 			yyVAL.str = ""
 		}
-	case 185:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1200
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 186:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1173
+//line sql.y:1206
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 187:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1177
+//line sql.y:1210
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 188:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1181
+//line sql.y:1214
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 189:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1185
+//line sql.y:1218
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 190:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1189
+//line sql.y:1222
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 191:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1228
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 192:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1199
+//line sql.y:1232
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 193:
+	case 195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1204
+//line sql.y:1237
 		{
 			yyVAL.str = ""
 		}
-	case 194:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1208
+//line sql.y:1241
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 195:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1247
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 196:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1218
+//line sql.y:1251
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 197:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1224
+//line sql.y:1257
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 198:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1230
+//line sql.y:1263
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 199:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1234
+//line sql.y:1267
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 200:
+	case 202:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1241
+//line sql.y:1274
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 201:
+	case 203:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1245
+//line sql.y:1278
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 202:
+	case 204:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1249
+//line sql.y:1282
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 203:
+	case 205:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1253
+//line sql.y:1286
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 204:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1259
+//line sql.y:1292
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 205:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1265
+//line sql.y:1298
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 206:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1304
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 207:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1308
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 208:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1279
+//line sql.y:1312
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 209:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1283
+//line sql.y:1316
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 210:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1287
+//line sql.y:1320
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 211:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1292
+//line sql.y:1325
 		{
 			yyVAL.str = ""
 		}
-	case 212:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1296
+//line sql.y:1329
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 213:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1300
+//line sql.y:1333
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 214:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1308
+//line sql.y:1341
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 215:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1312
+//line sql.y:1345
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 216:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1316
+//line sql.y:1349
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 217:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1355
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 218:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1326
+//line sql.y:1359
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 219:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1330
+//line sql.y:1363
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 220:
+	case 222:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1336
+//line sql.y:1369
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 221:
+	case 223:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1340
+//line sql.y:1373
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 222:
+	case 224:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1344
+//line sql.y:1377
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 223:
+	case 225:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1348
+//line sql.y:1381
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 224:
+	case 226:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1353
+//line sql.y:1386
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 225:
+	case 227:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1358
+//line sql.y:1391
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 226:
+	case 228:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1362
+//line sql.y:1395
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 227:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1366
+//line sql.y:1399
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[5].colIdent,
@@ -4562,29 +4544,29 @@ yydefault:
 				Params: yyDollar[7].vindexParams,
 			}}
 		}
-	case 228:
+	case 230:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1374
+//line sql.y:1407
 		{
 			yyVAL.statement = &DDL{Action: DropVindexStr, VindexSpec: &VindexSpec{
 				Name: yyDollar[5].colIdent,
 			}}
 		}
-	case 229:
+	case 231:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1413
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 230:
+	case 232:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1384
+//line sql.y:1417
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 233:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1388
+//line sql.y:1421
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4597,9 +4579,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 232:
+	case 234:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1401
+//line sql.y:1434
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4609,59 +4591,59 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 246:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1426
+//line sql.y:1459
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1432
+//line sql.y:1465
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1436
+//line sql.y:1469
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 249:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1442
+//line sql.y:1475
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 250:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1446
+//line sql.y:1479
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1452
+//line sql.y:1485
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1458
+//line sql.y:1491
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 253:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1462
+//line sql.y:1495
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1470
+//line sql.y:1503
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4669,16 +4651,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 253:
+	case 255:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1478
+//line sql.y:1511
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 256:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1483
+//line sql.y:1516
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4686,148 +4668,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 257:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1491
+//line sql.y:1524
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1495
+//line sql.y:1528
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1501
+//line sql.y:1534
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1505
+//line sql.y:1538
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1510
+//line sql.y:1543
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1516
+//line sql.y:1549
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1521
+//line sql.y:1554
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 262:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1525
+//line sql.y:1558
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1530
+//line sql.y:1563
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1534
+//line sql.y:1567
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 267:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1538
+//line sql.y:1571
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 266:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1542
+//line sql.y:1575
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1546
+//line sql.y:1579
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1583
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1554
+//line sql.y:1587
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1558
+//line sql.y:1591
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1562
+//line sql.y:1595
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1566
+//line sql.y:1599
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1570
+//line sql.y:1603
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1574
+//line sql.y:1607
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1578
+//line sql.y:1611
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1582
+//line sql.y:1615
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 279:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1586
+//line sql.y:1619
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 280:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1591
+//line sql.y:1624
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -4837,722 +4819,727 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1601
+//line sql.y:1634
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1605
+//line sql.y:1638
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1609
+//line sql.y:1642
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1648
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 283:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1652
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 284:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1623
+//line sql.y:1656
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1627
+//line sql.y:1660
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1631
+//line sql.y:1664
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1635
+//line sql.y:1668
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 290:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1639
+//line sql.y:1672
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 289:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1643
+//line sql.y:1676
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1653
+//line sql.y:1686
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 291:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1692
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1663
+//line sql.y:1696
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 295:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1669
+//line sql.y:1702
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1673
+//line sql.y:1706
 		{
 			yyVAL.str = "full "
 		}
-	case 295:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1679
+//line sql.y:1712
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 296:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1683
+//line sql.y:1716
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 297:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1689
+//line sql.y:1722
 		{
 			yyVAL.str = ""
 		}
-	case 298:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1693
+//line sql.y:1726
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 299:
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1697
+//line sql.y:1730
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 300:
+	case 302:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1703
+//line sql.y:1736
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1707
+//line sql.y:1740
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1711
+//line sql.y:1744
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 303:
+	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1717
+//line sql.y:1750
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1721
+//line sql.y:1754
 		{
 			yyVAL.str = SessionStr
 		}
-	case 305:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1725
+//line sql.y:1758
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 306:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1731
+//line sql.y:1764
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 307:
+	case 309:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1735
+//line sql.y:1768
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 308:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1741
+//line sql.y:1774
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1745
+//line sql.y:1778
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 310:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1751
+//line sql.y:1784
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 311:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1757
+//line sql.y:1790
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 312:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1763
+//line sql.y:1796
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1800
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1804
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 315:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1775
+//line sql.y:1808
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1779
+//line sql.y:1812
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1783
+//line sql.y:1816
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1787
+//line sql.y:1820
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 319:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1793
+//line sql.y:1826
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 320:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1797
+//line sql.y:1830
 		{
 			setAllowComments(yylex, true)
 		}
-	case 321:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1834
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 322:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1807
+//line sql.y:1840
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 323:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1811
+//line sql.y:1844
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 324:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:1850
 		{
 			yyVAL.str = UnionStr
 		}
-	case 325:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1821
+//line sql.y:1854
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 326:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1825
+//line sql.y:1858
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 327:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1830
+//line sql.y:1863
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1834
+//line sql.y:1867
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 329:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1838
+//line sql.y:1871
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 330:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1843
+//line sql.y:1876
 		{
 			yyVAL.str = ""
 		}
-	case 331:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1847
+//line sql.y:1880
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1852
+//line sql.y:1885
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1856
+//line sql.y:1889
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1861
+//line sql.y:1894
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1865
+//line sql.y:1898
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1871
+//line sql.y:1904
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1875
+//line sql.y:1908
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1881
+//line sql.y:1914
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 339:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1885
+//line sql.y:1918
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1889
+//line sql.y:1923
 		{
-			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+			yyVAL.selectExpr = &AliasedExpr{Expr: &LambdaExpr{Parameter: yyDollar[1].colIdent, Body: yyDollar[3].expr}}
 		}
-	case 341:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1893
+	case 343:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1927
 		{
-			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+			tableName, fields, ok := dottedTableName(yyDollar[1].expr)
+			if !ok {
+				yylex.Error("expecting a table or structure name before .*")
+				return 1
+			}
+			yyVAL.selectExpr = &StarExpr{TableName: tableName, Fields: fields}
 		}
-	case 342:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1898
+//line sql.y:1937
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 343:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1902
+//line sql.y:1941
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 344:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1906
+//line sql.y:1945
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 346:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:1952
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 347:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1918
+//line sql.y:1957
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 348:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1922
+//line sql.y:1961
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 349:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:1967
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 350:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1932
+//line sql.y:1971
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 353:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1942
+//line sql.y:1981
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 354:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:1985
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 355:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1950
+//line sql.y:1989
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 356:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1956
+//line sql.y:1995
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 357:
+	case 359:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1960
+//line sql.y:1999
 		{
 			yyVAL.tableExpr = &TableValuedFunction{Name: NewColIdent(string(yyDollar[1].bytes)), Args: yyDollar[3].tableValuedFunctionArguments, As: yyDollar[6].tableIdent}
 		}
-	case 358:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2005
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 359:
+	case 361:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1970
+//line sql.y:2009
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 360:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1975
+//line sql.y:2014
 		{
 			yyVAL.tableValuedFunctionArguments = nil
 		}
-	case 361:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1979
+//line sql.y:2018
 		{
 			yyVAL.tableValuedFunctionArguments = yyDollar[1].tableValuedFunctionArguments
 		}
-	case 362:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1985
+//line sql.y:2024
 		{
 			yyVAL.tableValuedFunctionArguments = TableValuedFunctionArguments{yyDollar[1].tableValuedFunctionArgument}
 		}
-	case 363:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1989
+//line sql.y:2028
 		{
 			yyVAL.tableValuedFunctionArguments = append(yyVAL.tableValuedFunctionArguments, yyDollar[3].tableValuedFunctionArgument)
 		}
-	case 364:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1995
+//line sql.y:2034
 		{
 			yyVAL.tableValuedFunctionArgument = &TableValuedFunctionArgument{Name: yyDollar[1].colIdent, Value: yyDollar[3].tableValuedFunctionArgumentValue}
 		}
-	case 365:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2001
+//line sql.y:2040
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &ExprTableValuedFunctionArgumentValue{Expr: yyDollar[1].expr}
 		}
-	case 366:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2005
+//line sql.y:2044
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &TableDescriptorTableValuedFunctionArgumentValue{Table: yyDollar[3].tableExpr}
 		}
-	case 367:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2009
+//line sql.y:2048
 		{
 			yyVAL.tableValuedFunctionArgumentValue = &FieldDescriptorTableValuedFunctionArgumentValue{Field: yyDollar[3].colName}
 		}
-	case 368:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2054
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 369:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2019
+//line sql.y:2058
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 370:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2064
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 371:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2029
+//line sql.y:2068
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 372:
+	case 374:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2042
+//line sql.y:2081
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 373:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2046
+//line sql.y:2085
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 374:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2050
+	case 376:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2089
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Strategy: yyDollar[2].str, Join: yyDollar[3].str, RightExpr: yyDollar[4].tableExpr, Condition: yyDollar[5].joinCondition}
 		}
-	case 375:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2093
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 376:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2060
+//line sql.y:2099
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 377:
+	case 379:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2062
+//line sql.y:2101
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 378:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2066
+//line sql.y:2105
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 379:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:2107
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 380:
+	case 382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2072
+//line sql.y:2111
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 381:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2113
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 382:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2077
+//line sql.y:2116
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 383:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2079
+//line sql.y:2118
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 384:
+	case 386:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2082
+//line sql.y:2121
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 385:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2125
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 386:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2090
+//line sql.y:2129
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 387:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2095
+//line sql.y:2134
 		{
 			yyVAL.str = UndefinedJoinStrategy
 		}
-	case 388:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2097
+//line sql.y:2136
 		{
 			yyVAL.str = LookupJoinStrategy
 		}
-	case 389:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2138
 		{
 			yyVAL.str = StreamJoinStrategy
 		}
-	case 391:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2104
+//line sql.y:2143
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 392:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:2149
 		{
 			yyVAL.str = JoinStr
 		}
-	case 393:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2114
+//line sql.y:2153
 		{
 			yyVAL.str = JoinStr
 		}
-	case 394:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2118
+//line sql.y:2157
 		{
 			yyVAL.str = JoinStr
 		}
-	case 395:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2124
+//line sql.y:2163
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 396:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2130
+//line sql.y:2169
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 397:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2134
+//line sql.y:2173
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 398:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2138
+//line sql.y:2177
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 399:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:2181
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 400:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2148
+//line sql.y:2187
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 401:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2152
+//line sql.y:2191
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5560,489 +5547,477 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 402:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2162
+//line sql.y:2201
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 403:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2166
+//line sql.y:2205
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 404:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2172
+//line sql.y:2211
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 405:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2176
+//line sql.y:2215
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 406:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2182
+//line sql.y:2221
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 407:
+	case 409:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2187
+//line sql.y:2226
 		{
 			yyVAL.indexHints = nil
 		}
-	case 408:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2191
+//line sql.y:2230
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 409:
+	case 411:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2195
+//line sql.y:2234
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 410:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2199
+//line sql.y:2238
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 411:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2204
+//line sql.y:2243
 		{
 			yyVAL.expr = nil
 		}
-	case 412:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2208
+//line sql.y:2247
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 413:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2214
+//line sql.y:2253
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 414:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2218
+//line sql.y:2257
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2222
+//line sql.y:2261
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 416:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2226
+//line sql.y:2265
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 417:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2230
+//line sql.y:2269
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 418:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2234
+//line sql.y:2273
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 419:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2238
+//line sql.y:2277
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 420:
+	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2244
+//line sql.y:2283
 		{
 			yyVAL.str = ""
 		}
-	case 421:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2248
+//line sql.y:2287
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 422:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:2293
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 423:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2258
+//line sql.y:2297
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 424:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2264
+//line sql.y:2303
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 425:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2307
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 426:
+	case 428:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2272
+//line sql.y:2311
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 427:
+	case 429:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2276
+//line sql.y:2315
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 428:
+	case 430:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2280
+//line sql.y:2319
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 429:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2284
+//line sql.y:2323
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 430:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2288
+//line sql.y:2327
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 431:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2292
+//line sql.y:2331
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpStr, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2296
+//line sql.y:2335
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeRegexpCaseInsensitiveStr, Right: yyDollar[3].expr}
 		}
-	case 433:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2300
+//line sql.y:2339
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 434:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2304
+//line sql.y:2343
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 435:
+	case 437:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2308
+//line sql.y:2347
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 436:
+	case 438:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2312
+//line sql.y:2351
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 437:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2316
+//line sql.y:2355
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 438:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2322
+//line sql.y:2361
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 439:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2326
+//line sql.y:2365
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 440:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2330
+//line sql.y:2369
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 441:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2334
+//line sql.y:2373
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 442:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2338
+//line sql.y:2377
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 443:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2342
+//line sql.y:2381
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 444:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2348
+//line sql.y:2387
 		{
 			yyVAL.str = EqualStr
 		}
-	case 445:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2352
+//line sql.y:2391
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 446:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2356
+//line sql.y:2395
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 447:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2360
+//line sql.y:2399
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 448:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2364
+//line sql.y:2403
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 449:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2368
+//line sql.y:2407
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 450:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2372
+//line sql.y:2411
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 451:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2377
+//line sql.y:2416
 		{
 			yyVAL.expr = nil
 		}
-	case 452:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2381
+//line sql.y:2420
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 453:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2387
+//line sql.y:2426
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 454:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2391
+//line sql.y:2430
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 455:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2395
+//line sql.y:2434
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 456:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2401
+//line sql.y:2440
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 457:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2407
+//line sql.y:2446
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 458:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2411
+//line sql.y:2450
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 459:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2417
+//line sql.y:2456
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 460:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2421
+//line sql.y:2460
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 461:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2425
+//line sql.y:2464
 		{
-			yyVAL.expr = yyDollar[1].colName
+			yyVAL.expr = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 462:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2429
+//line sql.y:2468
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 463:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2433
+//line sql.y:2472
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 464:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2437
-		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
-		}
-	case 465:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2441
-		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
-		}
 	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2445
+//line sql.y:2476
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
 	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2449
+//line sql.y:2480
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
 	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2453
+//line sql.y:2484
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
 	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2488
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
 	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2492
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
 	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2465
+//line sql.y:2496
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
 	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2469
+//line sql.y:2500
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
 	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2473
+//line sql.y:2504
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
 	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2477
+//line sql.y:2508
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
 	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2481
+//line sql.y:2512
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
 	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2485
+//line sql.y:2516
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
 	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2489
+//line sql.y:2520
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
 	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2493
+//line sql.y:2524
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
 	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2528
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
 	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2501
+//line sql.y:2532
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
 	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2536
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
 	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2509
+//line sql.y:2540
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6052,7 +6027,7 @@ yydefault:
 		}
 	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2517
+//line sql.y:2548
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6068,19 +6043,19 @@ yydefault:
 		}
 	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2531
+//line sql.y:2562
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
 	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2535
+//line sql.y:2566
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
 	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2539
+//line sql.y:2570
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6090,475 +6065,504 @@ yydefault:
 		}
 	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2551
+//line sql.y:2582
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ArrayElement, Right: yyDollar[3].expr}
 		}
 	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2555
+//line sql.y:2586
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
+			yyVAL.expr = &FieldAccessExpr{Expr: yyDollar[1].expr, Field: yyDollar[3].colIdent}
 		}
 	case 493:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2565
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2590
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
 		}
 	case 494:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2569
+//line sql.y:2600
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
 		}
 	case 495:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2573
+//line sql.y:2604
 		{
-			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].over}
 		}
 	case 496:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2608
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, OrderBy: yyDollar[6].orderBy, Over: yyDollar[8].over}
+		}
+	case 497:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2612
+		{
+			tableName, _, ok := dottedTableName(yyDollar[1].expr)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				yylex.Error("expecting a qualifier before the function name")
+				return 1
+			}
+			yyVAL.expr = &FuncExpr{Qualifier: tableName.Name, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2583
+//line sql.y:2627
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 497:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2587
+//line sql.y:2631
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 498:
+	case 500:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2591
+//line sql.y:2635
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 499:
+	case 501:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2595
+//line sql.y:2639
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 500:
+	case 502:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2599
+//line sql.y:2643
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 501:
+	case 503:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2603
+//line sql.y:2647
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &SubstrExpr{Name: &ColName{Name: yyDollar[3].colIdent}, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 502:
+	case 504:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2607
+//line sql.y:2651
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &SubstrExpr{Name: &ColName{Name: yyDollar[3].colIdent}, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 503:
+	case 505:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2611
+//line sql.y:2655
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 504:
+	case 506:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2615
+//line sql.y:2659
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 505:
+	case 507:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2619
+//line sql.y:2663
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 506:
+	case 508:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2623
+//line sql.y:2667
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 507:
+	case 509:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2627
+//line sql.y:2671
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 508:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2631
+//line sql.y:2675
 		{
-			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+			yyVAL.expr = &ValuesFuncExpr{Name: &ColName{Name: yyDollar[3].colIdent}}
 		}
-	case 509:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2685
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 510:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2645
+//line sql.y:2689
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 511:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2649
+//line sql.y:2693
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 512:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2654
+//line sql.y:2698
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 513:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2659
+//line sql.y:2703
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 514:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:2708
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 515:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2670
+//line sql.y:2714
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 516:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2675
+//line sql.y:2719
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 517:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2724
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 518:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2684
+//line sql.y:2728
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 519:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2688
+//line sql.y:2732
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 520:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2693
+//line sql.y:2737
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 521:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2698
+//line sql.y:2742
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 522:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2703
+//line sql.y:2747
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 523:
+	case 525:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2707
+//line sql.y:2751
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 524:
+	case 526:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2711
+//line sql.y:2755
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 527:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2721
+//line sql.y:2765
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 528:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2731
+//line sql.y:2775
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 529:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2735
+//line sql.y:2779
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 530:
+	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2739
+//line sql.y:2783
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 531:
+	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2743
+//line sql.y:2787
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2747
+//line sql.y:2791
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2751
+//line sql.y:2795
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 534:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2757
+//line sql.y:2801
 		{
 			yyVAL.str = ""
 		}
-	case 535:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2761
+//line sql.y:2805
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 536:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2765
+//line sql.y:2809
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 537:
+	case 539:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2769
+//line sql.y:2813
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 538:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2773
+//line sql.y:2817
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 539:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2779
+//line sql.y:2823
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 540:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2783
+//line sql.y:2827
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 541:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2789
+//line sql.y:2833
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 542:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:2837
 		{
 			yyVAL.convertType = &ConvertTypeSimple{Name: string(yyDollar[1].bytes)}
 		}
-	case 543:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2797
+//line sql.y:2841
 		{
 			yyVAL.convertType = &ConvertTypeList{Element: yyDollar[2].convertType}
 		}
-	case 544:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2801
+//line sql.y:2845
 		{
 			yyVAL.convertType = &ConvertTypeObject{Fields: yyDollar[2].convertTypeObjectFields}
 		}
-	case 545:
+	case 547:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2849
+		{
+			yyVAL.convertType = &ConvertTypeDecimal{Precision: NewIntVal(yyDollar[3].bytes), Scale: NewIntVal(yyDollar[5].bytes)}
+		}
+	case 548:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2853
+		{
+			yyVAL.convertType = &ConvertTypeDecimal{Precision: NewIntVal(yyDollar[3].bytes), Scale: NewIntVal(yyDollar[5].bytes)}
+		}
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2806
+//line sql.y:2858
 		{
 			yyVAL.convertTypeObjectFields = nil
 		}
-	case 546:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2810
+//line sql.y:2862
 		{
 			yyVAL.convertTypeObjectFields = yyDollar[1].convertTypeObjectFields
 		}
-	case 547:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2816
+//line sql.y:2868
 		{
 			yyVAL.convertTypeObjectFields = []*ConvertTypeObjectField{yyDollar[1].convertTypeObjectField}
 		}
-	case 548:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2820
+//line sql.y:2872
 		{
 			yyVAL.convertTypeObjectFields = append([]*ConvertTypeObjectField{yyDollar[1].convertTypeObjectField}, yyDollar[3].convertTypeObjectFields...)
 		}
-	case 549:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2826
+//line sql.y:2878
 		{
 			yyVAL.convertTypeObjectField = &ConvertTypeObjectField{Name: string(yyDollar[1].bytes), Type: yyDollar[3].convertType}
 		}
-	case 550:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2830
+//line sql.y:2882
 		{
 			yyVAL.convertTypeObjectField = &ConvertTypeObjectField{Name: string(yyDollar[1].bytes), Type: yyDollar[3].convertType}
 		}
-	case 551:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2835
+//line sql.y:2887
 		{
 			yyVAL.expr = nil
 		}
-	case 552:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:2891
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 553:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2844
+//line sql.y:2896
 		{
 			yyVAL.str = string("")
 		}
-	case 554:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2848
+//line sql.y:2900
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 555:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2854
+//line sql.y:2906
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 556:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2858
+//line sql.y:2910
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 557:
+	case 561:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2864
+//line sql.y:2916
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 558:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2869
+//line sql.y:2921
 		{
 			yyVAL.expr = nil
 		}
-	case 559:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2873
+//line sql.y:2925
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 560:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2879
+//line sql.y:2931
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 561:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2883
+//line sql.y:2935
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 562:
+	case 566:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2887
+//line sql.y:2939
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 563:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2893
+//line sql.y:2945
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 564:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2897
+//line sql.y:2949
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 565:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2901
+//line sql.y:2953
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 566:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2905
+//line sql.y:2957
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 567:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2909
+//line sql.y:2961
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 568:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2913
+//line sql.y:2965
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 569:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2917
+//line sql.y:2969
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 570:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2921
+//line sql.y:2973
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 571:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2927
+//line sql.y:2979
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6567,287 +6571,311 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 572:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2936
+//line sql.y:2988
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 573:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2940
+//line sql.y:2992
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 574:
+	case 578:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2945
+//line sql.y:2997
 		{
 			yyVAL.exprs = nil
 		}
-	case 575:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2949
+//line sql.y:3001
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 576:
+	case 580:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2954
+//line sql.y:3006
 		{
 			yyVAL.expr = nil
 		}
-	case 577:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2958
+//line sql.y:3010
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 578:
+	case 582:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3015
+		{
+			yyVAL.over = nil
+		}
+	case 583:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3019
+		{
+			yyVAL.over = &Over{PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy}
+		}
+	case 584:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3024
+		{
+			yyVAL.exprs = nil
+		}
+	case 585:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3028
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2963
+//line sql.y:3033
 		{
 			yyVAL.orderBy = nil
 		}
-	case 579:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2967
+//line sql.y:3037
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 580:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2973
+//line sql.y:3043
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 581:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2977
+//line sql.y:3047
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 582:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2983
+//line sql.y:3053
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 583:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2988
+//line sql.y:3058
 		{
 			yyVAL.str = AscScr
 		}
-	case 584:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2992
+//line sql.y:3062
 		{
 			yyVAL.str = AscScr
 		}
-	case 585:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2996
+//line sql.y:3066
 		{
 			yyVAL.str = DescScr
 		}
-	case 586:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3001
+//line sql.y:3071
 		{
 			yyVAL.limit = nil
 		}
-	case 587:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3005
+//line sql.y:3075
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 588:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3009
+//line sql.y:3079
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 589:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3013
+//line sql.y:3083
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 590:
+	case 598:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3018
+//line sql.y:3088
 		{
 			yyVAL.str = ""
 		}
-	case 591:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3022
+//line sql.y:3092
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 592:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3026
+//line sql.y:3096
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 593:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3031
+//line sql.y:3101
 		{
 			yyVAL.triggers = nil
 		}
-	case 594:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3035
+//line sql.y:3105
 		{
 			yyVAL.triggers = yyDollar[2].triggers
 		}
-	case 595:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3041
+//line sql.y:3111
 		{
 			yyVAL.triggers = []Trigger{yyDollar[1].trigger}
 		}
-	case 596:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3045
+//line sql.y:3115
 		{
 			yyVAL.triggers = append(yyDollar[1].triggers, yyDollar[3].trigger)
 		}
-	case 597:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3051
+//line sql.y:3121
 		{
 			yyVAL.trigger = &WatermarkTrigger{}
 		}
-	case 598:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3055
+//line sql.y:3125
 		{
 			yyVAL.trigger = &EndOfStreamTrigger{}
 		}
-	case 599:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3059
+//line sql.y:3129
 		{
 			yyVAL.trigger = &DelayTrigger{Delay: yyDollar[3].expr}
 		}
-	case 600:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3063
+//line sql.y:3133
 		{
 			yyVAL.trigger = &CountingTrigger{Count: yyDollar[2].expr}
 		}
-	case 601:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3076
+//line sql.y:3146
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 602:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3080
+//line sql.y:3150
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 603:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3084
+//line sql.y:3154
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 604:
+	case 612:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3089
+//line sql.y:3159
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 605:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3093
+//line sql.y:3163
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 606:
+	case 614:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3097
+//line sql.y:3167
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 607:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3104
+//line sql.y:3174
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 608:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3108
+//line sql.y:3178
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 609:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3112
+//line sql.y:3182
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 610:
+	case 618:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3116
+//line sql.y:3186
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 611:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3121
+//line sql.y:3191
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 612:
+	case 620:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3125
+//line sql.y:3195
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 613:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3131
+//line sql.y:3201
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 614:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3135
+//line sql.y:3205
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 615:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3141
+//line sql.y:3211
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 616:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3145
+//line sql.y:3215
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 617:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3151
+//line sql.y:3221
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 618:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3157
+//line sql.y:3227
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6855,312 +6883,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 619:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3167
+//line sql.y:3237
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 620:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3171
+//line sql.y:3241
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 621:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3177
+//line sql.y:3247
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 622:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3183
+//line sql.y:3253
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 623:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3187
+//line sql.y:3257
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 624:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3193
+//line sql.y:3263
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 625:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3197
+//line sql.y:3267
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 626:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3201
+//line sql.y:3271
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 627:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3205
+//line sql.y:3275
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 629:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3212
+//line sql.y:3282
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 631:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3219
+//line sql.y:3289
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 632:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3293
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 633:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3297
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 636:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3236
+//line sql.y:3306
 		{
 			yyVAL.byt = 0
 		}
-	case 637:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3238
+//line sql.y:3308
 		{
 			yyVAL.byt = 1
 		}
-	case 638:
+	case 646:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3241
+//line sql.y:3311
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3243
+//line sql.y:3313
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3246
+//line sql.y:3316
 		{
 			yyVAL.str = ""
 		}
-	case 641:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3248
+//line sql.y:3318
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 642:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3252
+//line sql.y:3322
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3254
+//line sql.y:3324
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 644:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3256
+//line sql.y:3326
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3258
+//line sql.y:3328
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3260
+//line sql.y:3330
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3262
+//line sql.y:3332
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 648:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3264
+//line sql.y:3334
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3266
+//line sql.y:3336
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3268
+//line sql.y:3338
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3270
+//line sql.y:3340
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3273
+//line sql.y:3343
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3275
+//line sql.y:3345
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3277
+//line sql.y:3347
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3281
+//line sql.y:3351
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 656:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3283
+//line sql.y:3353
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 657:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3286
+//line sql.y:3356
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 658:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3288
+//line sql.y:3358
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 659:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3290
+//line sql.y:3360
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 660:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3293
+//line sql.y:3363
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 661:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3295
+//line sql.y:3365
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 662:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3299
+//line sql.y:3369
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 663:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3303
+//line sql.y:3373
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 665:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3310
+//line sql.y:3380
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 666:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3316
+//line sql.y:3386
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 667:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3320
+//line sql.y:3390
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 669:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3327
+//line sql.y:3397
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 885:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3568
+//line sql.y:3639
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 886:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3577
+//line sql.y:3648
 		{
 			decNesting(yylex)
 		}
-	case 887:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3582
+//line sql.y:3653
 		{
 			skipToEnd(yylex)
 		}
-	case 888:
+	case 897:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3587
+//line sql.y:3658
 		{
 			skipToEnd(yylex)
 		}
-	case 889:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3591
+//line sql.y:3662
 		{
 			skipToEnd(yylex)
 		}
-	case 890:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3595
+//line sql.y:3666
 		{
 			skipToEnd(yylex)
 		}
@@ -47,6 +47,28 @@ func decNesting(yylex interface{}) {
   yylex.(*Tokenizer).nesting--
 }
 
+// dottedTableName converts a column name with any number of field accesses, like db.tbl or tbl.struct.field, to a table name
+// followed by the remaining fields.
+// Identifiers in expressions are always parsed as column names, as tables and structure fields are told apart during typechecking.
+func dottedTableName(expr Expr) (TableName, []ColIdent, bool) {
+  switch expr := expr.(type) {
+  case *ColName:
+    if expr.Qualifier.IsEmpty() {
+      return TableName{Name: NewTableIdent(expr.Name.String())}, nil, true
+    }
+  case *FieldAccessExpr:
+    tableName, fields, ok := dottedTableName(expr.Expr)
+    if !ok {
+      break
+    }
+    if tableName.Qualifier.IsEmpty() {
+      return TableName{Qualifier: tableName.Name, Name: NewTableIdent(expr.Field.String())}, nil, true
+    }
+    return tableName, append(fields, expr.Field), true
+  }
+  return TableName{}, nil, false
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -130,12 +152,14 @@ func skipToEnd(yylex interface{}) {
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
+  over          *Over
   optLike       *OptLike
 }
 
 %token LEX_ERROR
 %left <bytes> UNION
 %token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR WATERMARK DELAY COUNTING AFTER
+%token <bytes> OVER
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
@@ -264,7 +288,8 @@ func skipToEnd(yylex interface{}) {
 %type <whens> when_expression_list
 %type <when> when_expression
 %type <expr> expression_opt else_expression_opt
-%type <exprs> group_by_opt
+%type <exprs> group_by_opt partition_by_opt
+%type <over> over_opt
 %type <expr> having_opt
 %type <orderBy> order_by_opt order_list
 %type <order> order
@@ -440,10 +465,18 @@ base_select:
   }
 
 union_lhs:
-	openb select_statement closeb
+  base_select
+  {
+    $$ = $1
+  }
+| openb select_statement closeb
   {
     $$ = &ParenSelect{Select: $2}
   }
+| union_lhs union_op union_rhs
+  {
+    $$ = &Union{Type: $2, Left: $1, Right: $3}
+  }
 
 union_rhs:
   base_select
@@ -1885,13 +1918,19 @@ select_expression:
   {
     $$ = &AliasedExpr{Expr: $1, As: $2}
   }
-| table_id '.' '*'
+// Lambdas are only allowed as function arguments.
+| sql_id JSON_EXTRACT_OP expression
   {
-    $$ = &StarExpr{TableName: TableName{Name: $1}}
+    $$ = &AliasedExpr{Expr: &LambdaExpr{Parameter: $1, Body: $3}}
   }
-| table_id '.' reserved_table_id '.' '*'
+| value_expression '.' '*'
   {
-    $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}}
+    tableName, fields, ok := dottedTableName($1)
+    if !ok {
+      yylex.Error("expecting a table or structure name before .*")
+      return 1
+    }
+    $$ = &StarExpr{TableName: tableName, Fields: fields}
   }
 
 as_ci_opt:
@@ -2046,9 +2085,9 @@ join_table:
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
   }
-| table_reference outer_join table_reference join_condition
+| table_reference strategy_opt outer_join table_reference join_condition
   {
-    $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    $$ = &JoinTableExpr{LeftExpr: $1, Strategy: $2, Join: $3, RightExpr: $4, Condition: $5}
   }
 | table_reference natural_join table_factor
   {
@@ -2421,9 +2460,9 @@ value_expression:
   {
     $$ = $1
   }
-| column_name
+| sql_id
   {
-    $$ = $1
+    $$ = &ColName{Name: $1}
   }
 | tuple_expression
   {
@@ -2481,14 +2520,6 @@ value_expression:
   {
     $$ = &BinaryExpr{Left: $1, Operator: ShiftRightStr, Right: $3}
   }
-| column_name JSON_EXTRACT_OP value
-  {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
-  }
-| column_name JSON_UNQUOTE_EXTRACT_OP value
-  {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
-  }
 | value_expression COLLATE charset
   {
     $$ = &CollateExpr{Expr: $1, Charset: $3}
@@ -2551,6 +2582,10 @@ value_expression:
   {
     $$ = &BinaryExpr{Left: $1, Operator: ArrayElement, Right: $3}
   }
+| value_expression '.' reserved_sql_id
+  {
+    $$ = &FieldAccessExpr{Expr: $1, Field: $3}
+  }
 | value_expression LIST_ARG convert_type
   {
     $$ = &ConvertExpr{Expr: $1, Type: $3}
@@ -2561,17 +2596,26 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb select_expression_list ORDER BY order_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, OrderBy: $6, Over: $8}
   }
-| table_id '.' reserved_sql_id openb select_expression_list_opt closeb
+| value_expression '.' reserved_sql_id openb select_expression_list_opt closeb
   {
-    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
+    tableName, _, ok := dottedTableName($1)
+    if !ok || !tableName.Qualifier.IsEmpty() {
+      yylex.Error("expecting a qualifier before the function name")
+      return 1
+    }
+    $$ = &FuncExpr{Qualifier: tableName.Name, Name: $3, Exprs: $5}
   }
 
 /*
@@ -2599,13 +2643,13 @@ function_call_keyword:
   {
     $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
   }
-| SUBSTR openb column_name FROM value_expression FOR value_expression closeb
+| SUBSTR openb sql_id FROM value_expression FOR value_expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &SubstrExpr{Name: &ColName{Name: $3}, From: $5, To: $7}
   }
-| SUBSTRING openb column_name FROM value_expression FOR value_expression closeb
+| SUBSTRING openb sql_id FROM value_expression FOR value_expression closeb
   {
-    $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    $$ = &SubstrExpr{Name: &ColName{Name: $3}, From: $5, To: $7}
   }
 | SUBSTR openb STRING FROM value_expression FOR value_expression closeb
   {
@@ -2627,9 +2671,9 @@ function_call_keyword:
   {
     $$ = &CaseExpr{Expr: $2, Whens: $3, Else: $4}
   }
-| VALUES openb column_name closeb
+| VALUES openb sql_id closeb
   {
-    $$ = &ValuesFuncExpr{Name: $3}
+    $$ = &ValuesFuncExpr{Name: &ColName{Name: $3}}
   }
 
 /*
@@ -2801,6 +2845,14 @@ convert_type:
   {
     $$ = &ConvertTypeObject{Fields: $2}
   }
+| DECIMAL '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = &ConvertTypeDecimal{Precision: NewIntVal($3), Scale: NewIntVal($5)}
+  }
+| NUMERIC '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = &ConvertTypeDecimal{Precision: NewIntVal($3), Scale: NewIntVal($5)}
+  }
 
 convert_type_object_fields_opt:
   {
@@ -2959,6 +3011,24 @@ having_opt:
     $$ = $2
   }
 
+over_opt:
+  {
+    $$ = nil
+  }
+| OVER openb partition_by_opt order_by_opt closeb
+  {
+    $$ = &Over{PartitionBy: $3, OrderBy: $4}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -3402,6 +3472,7 @@ reserved_keyword:
 | OFF
 | ON
 | OR
+| OVER
 | ORDER
 | OUTER
 | REGEXP
@@ -297,6 +297,7 @@ var keywords = map[string]int{
 	"order":               ORDER,
 	"out":                 UNUSED,
 	"outer":               OUTER,
+	"over":                OVER,
 	"outfile":             UNUSED,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
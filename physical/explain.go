@@ -52,8 +52,31 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 		}, withTypeInfo))
 		out.AddChild("source", ExplainNode(node.GroupBy.Source, withTypeInfo))
 
+	case NodeTypeLimit:
+		out = graph.NewNode("limit")
+		out.AddField("limit", fmt.Sprint(node.Limit.Limit))
+		out.AddField("offset", fmt.Sprint(node.Limit.Offset))
+
+		for i := range node.Limit.Key {
+			if node.Limit.DirectionMultipliers[i] == 1 {
+				out.AddChild("asc", ExplainExpr(node.Limit.Key[i], withTypeInfo))
+			} else {
+				out.AddChild("desc", ExplainExpr(node.Limit.Key[i], withTypeInfo))
+			}
+		}
+
+		out.AddChild("source", ExplainNode(node.Limit.Source, withTypeInfo))
+
 	case NodeTypeStreamJoin:
-		out = graph.NewNode("join")
+		if node.StreamJoin.IsLeftJoin {
+			out = graph.NewNode("left join")
+		} else if node.StreamJoin.IsSemiJoin {
+			out = graph.NewNode("semi join")
+		} else if node.StreamJoin.IsAntiJoin {
+			out = graph.NewNode("anti join")
+		} else {
+			out = graph.NewNode("join")
+		}
 		out.AddChild("right", ExplainNode(node.StreamJoin.Right, withTypeInfo))
 		out.AddChild("left", ExplainNode(node.StreamJoin.Left, withTypeInfo))
 		out.AddChild("right_key", ExplainExpr(Expression{
@@ -70,7 +93,11 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 		}, withTypeInfo))
 
 	case NodeTypeLookupJoin:
-		out = graph.NewNode("lookup join")
+		if node.LookupJoin.IsLeftJoin {
+			out = graph.NewNode("left lookup join")
+		} else {
+			out = graph.NewNode("lookup join")
+		}
 		out.AddChild("source", ExplainNode(node.LookupJoin.Source, withTypeInfo))
 		out.AddChild("joined", ExplainNode(node.LookupJoin.Joined, withTypeInfo))
 
@@ -120,11 +147,43 @@ func ExplainNode(node Node, withTypeInfo bool) *graph.Node {
 				panic(fmt.Sprintf("unrecognized table valued function argument type: %v", value.TableValuedFunctionArgumentType))
 			}
 		}
+	case NodeTypeUnionAll:
+		out = graph.NewNode("union all")
+		for i := range node.UnionAll.Inputs {
+			out.AddChild(fmt.Sprintf("input_%d", i), ExplainNode(node.UnionAll.Inputs[i], withTypeInfo))
+		}
+
 	case NodeTypeUnnest:
 		out = graph.NewNode("unnest")
 		out.AddField("field", node.Unnest.Field)
 		out.AddChild("source", ExplainNode(node.Unnest.Source, withTypeInfo))
 
+	case NodeTypeWindow:
+		out = graph.NewNode("window")
+		out.AddField("function", node.Window.Function.Name)
+		if node.Window.Function.Name == "lag" || node.Window.Function.Name == "lead" {
+			out.AddField("offset", fmt.Sprint(node.Window.Function.Offset))
+		}
+		for i := range node.Window.Function.Arguments {
+			out.AddChild(fmt.Sprintf("argument_%d", i), ExplainExpr(node.Window.Function.Arguments[i], withTypeInfo))
+		}
+
+		out.AddChild("partition_by", ExplainExpr(Expression{
+			ExpressionType: ExpressionTypeTuple,
+			Tuple: &Tuple{
+				Arguments: node.Window.PartitionBy,
+			},
+		}, withTypeInfo))
+		for i := range node.Window.OrderBy {
+			if node.Window.DirectionMultipliers[i] == 1 {
+				out.AddChild("asc", ExplainExpr(node.Window.OrderBy[i], withTypeInfo))
+			} else {
+				out.AddChild("desc", ExplainExpr(node.Window.OrderBy[i], withTypeInfo))
+			}
+		}
+
+		out.AddChild("source", ExplainNode(node.Window.Source, withTypeInfo))
+
 	default:
 		panic("unexhaustive node type match")
 	}
@@ -179,6 +238,32 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 		out = graph.NewNode("subquery")
 		out.AddChild("source", ExplainNode(expr.QueryExpression.Source, withTypeInfo))
 
+	case ExpressionTypeObject:
+		out = graph.NewNode("object")
+		structType, _ := StructAlternative(expr.Type)
+		for i := range expr.Object.Arguments {
+			out.AddChild(structType.Struct.Fields[i].Name, ExplainExpr(expr.Object.Arguments[i], withTypeInfo))
+		}
+
+	case ExpressionTypeFieldAccess:
+		out = graph.NewNode("field access")
+		out.AddField("field", expr.FieldAccess.Field)
+		out.AddChild("value", ExplainExpr(expr.FieldAccess.Expression, withTypeInfo))
+
+	case ExpressionTypeListLambda:
+		if expr.ListLambda.Filter {
+			out = graph.NewNode("filter")
+		} else {
+			out = graph.NewNode("transform")
+		}
+		out.AddField("parameter", expr.ListLambda.Parameter)
+		out.AddChild("list", ExplainExpr(expr.ListLambda.List, withTypeInfo))
+		out.AddChild("body", ExplainExpr(expr.ListLambda.Body, withTypeInfo))
+
+	case ExpressionTypeExists:
+		out = graph.NewNode("exists")
+		out.AddChild("source", ExplainNode(expr.Exists.Source, withTypeInfo))
+
 	case ExpressionTypeCoalesce:
 		out = graph.NewNode("coalesce")
 		for i := range expr.Coalesce.Arguments {
@@ -201,6 +286,17 @@ func ExplainExpr(expr Expression, withTypeInfo bool) *graph.Node {
 		out.AddField("type", expr.Cast.TargetType.String())
 		out.AddChild("value", ExplainExpr(expr.Cast.Expression, withTypeInfo))
 
+	case ExpressionTypeCase:
+		out = graph.NewNode("case")
+		if expr.Case.Value != nil {
+			out.AddChild("value", ExplainExpr(*expr.Case.Value, withTypeInfo))
+		}
+		for i := range expr.Case.Conditions {
+			out.AddChild(fmt.Sprintf("when_%d", i), ExplainExpr(expr.Case.Conditions[i], withTypeInfo))
+			out.AddChild(fmt.Sprintf("then_%d", i), ExplainExpr(expr.Case.Results[i], withTypeInfo))
+		}
+		out.AddChild("else", ExplainExpr(expr.Case.Else, withTypeInfo))
+
 	default:
 		panic("unexhaustive expression type match")
 	}
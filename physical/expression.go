@@ -20,10 +20,15 @@ type Expression struct {
 	And             *And
 	Or              *Or
 	QueryExpression *QueryExpression
+	Exists          *Exists
 	Coalesce        *Coalesce
 	Tuple           *Tuple
 	TypeAssertion   *TypeAssertion
 	Cast            *Cast
+	Case            *Case
+	Object          *Object
+	FieldAccess     *FieldAccess
+	ListLambda      *ListLambda
 }
 
 type ExpressionType int
@@ -39,6 +44,11 @@ const (
 	ExpressionTypeTuple
 	ExpressionTypeTypeAssertion
 	ExpressionTypeCast
+	ExpressionTypeCase
+	ExpressionTypeExists
+	ExpressionTypeObject
+	ExpressionTypeFieldAccess
+	ExpressionTypeListLambda
 )
 
 func (t ExpressionType) String() string {
@@ -63,6 +73,16 @@ func (t ExpressionType) String() string {
 		return "type_assertion"
 	case ExpressionTypeCast:
 		return "cast"
+	case ExpressionTypeCase:
+		return "case"
+	case ExpressionTypeExists:
+		return "exists"
+	case ExpressionTypeObject:
+		return "object"
+	case ExpressionTypeFieldAccess:
+		return "field_access"
+	case ExpressionTypeListLambda:
+		return "list_lambda"
 	}
 	return "unknown"
 }
@@ -94,6 +114,10 @@ type QueryExpression struct {
 	Source Node
 }
 
+type Exists struct {
+	Source Node
+}
+
 type Coalesce struct {
 	Arguments []Expression
 }
@@ -112,6 +136,33 @@ type Cast struct {
 	TargetType octosql.Type
 }
 
+type Case struct {
+	// Value is nil for a searched case expression.
+	Value      *Expression
+	Conditions []Expression
+	Results    []Expression
+	Else       Expression
+}
+
+// Object creates a struct, the field names are part of its type.
+type Object struct {
+	Arguments []Expression
+}
+
+type FieldAccess struct {
+	Expression Expression
+	Field      string
+}
+
+// ListLambda evaluates the lambda body for each element of the list, with the element bound to the parameter.
+// If Filter is set, then the elements for which the body is true are returned, otherwise the body results are.
+type ListLambda struct {
+	List      Expression
+	Parameter string
+	Body      Expression
+	Filter    bool
+}
+
 func (expr *Expression) Materialize(ctx context.Context, env Environment) (execution.Expression, error) {
 	switch expr.ExpressionType {
 	case ExpressionTypeVariable:
@@ -148,7 +199,15 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 				}
 			}
 		}
-		return execution.NewFunctionCall(expr.FunctionCall.FunctionDescriptor.Function, expressions, nullCheckIndices), nil
+		function := expr.FunctionCall.FunctionDescriptor.Function
+		if expr.FunctionCall.FunctionDescriptor.FunctionFn != nil {
+			argumentTypes := make([]octosql.Type, len(expr.FunctionCall.Arguments))
+			for i := range expr.FunctionCall.Arguments {
+				argumentTypes[i] = expr.FunctionCall.Arguments[i].Type
+			}
+			function = expr.FunctionCall.FunctionDescriptor.FunctionFn(argumentTypes, expr.Type)
+		}
+		return execution.NewFunctionCall(function, expressions, nullCheckIndices), nil
 	case ExpressionTypeAnd:
 		expressions := make([]execution.Expression, len(expr.And.Arguments))
 		for i := range expr.And.Arguments {
@@ -184,6 +243,12 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		} else {
 			return execution.NewSingleColumnQueryExpression(source), nil
 		}
+	case ExpressionTypeExists:
+		source, err := expr.Exists.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize EXISTS source: %w", err)
+		}
+		return execution.NewExistsQueryExpression(source), nil
 	case ExpressionTypeCoalesce:
 		expressions := make([]execution.Expression, len(expr.Coalesce.Arguments))
 		for i := range expr.Coalesce.Arguments {
@@ -218,6 +283,87 @@ func (expr *Expression) Materialize(ctx context.Context, env Environment) (execu
 		}
 
 		return execution.NewCast(expr.Cast.TargetType, expression), nil
+	case ExpressionTypeCase:
+		var value execution.Expression
+		if expr.Case.Value != nil {
+			var err error
+			value, err = expr.Case.Value.Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE value: %w", err)
+			}
+		}
+		conditions := make([]execution.Expression, len(expr.Case.Conditions))
+		results := make([]execution.Expression, len(expr.Case.Results))
+		for i := range expr.Case.Conditions {
+			condition, err := expr.Case.Conditions[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE condition with index %d: %w", i, err)
+			}
+			conditions[i] = condition
+			result, err := expr.Case.Results[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize CASE result with index %d: %w", i, err)
+			}
+			results[i] = result
+		}
+		elseResult, err := expr.Case.Else.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize CASE else result: %w", err)
+		}
+
+		return execution.NewCase(value, conditions, results, elseResult), nil
+	case ExpressionTypeObject:
+		expressions := make([]execution.Expression, len(expr.Object.Arguments))
+		for i := range expr.Object.Arguments {
+			expression, err := expr.Object.Arguments[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize object field with index %d: %w", i, err)
+			}
+			expressions[i] = expression
+		}
+		return execution.NewObject(expressions), nil
+	case ExpressionTypeFieldAccess:
+		expression, err := expr.FieldAccess.Expression.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize field access source expression: %w", err)
+		}
+		structType, ok := StructAlternative(expr.FieldAccess.Expression.Type)
+		if !ok {
+			return nil, fmt.Errorf("field access source expression must be a structure, is %s", expr.FieldAccess.Expression.Type)
+		}
+		index := -1
+		for i, field := range structType.Struct.Fields {
+			if field.Name == expr.FieldAccess.Field {
+				index = i
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("structure %s has no field %s", structType, expr.FieldAccess.Field)
+		}
+		return execution.NewFieldAccess(expression, index), nil
+	case ExpressionTypeListLambda:
+		list, err := expr.ListLambda.List.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize lambda list expression: %w", err)
+		}
+		listType, ok := ListAlternative(expr.ListLambda.List.Type)
+		if !ok {
+			return nil, fmt.Errorf("lambda list expression must be a list, is %s", expr.ListLambda.List.Type)
+		}
+		elementType := octosql.Null
+		if listType.List.Element != nil {
+			elementType = *listType.List.Element
+		}
+		bodyEnv := env
+		bodyEnv.VariableContext = env.VariableContext.WithLambdaParameter(SchemaField{
+			Name: expr.ListLambda.Parameter,
+			Type: elementType,
+		})
+		body, err := expr.ListLambda.Body.Materialize(ctx, bodyEnv)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize lambda body: %w", err)
+		}
+		return execution.NewListLambda(list, body, expr.ListLambda.Filter), nil
 	}
 
 	panic("unexhaustive expression type match")
@@ -278,13 +424,118 @@ func (expr Expression) variablesUsed(acc map[string]struct{}) {
 			arg.variablesUsed(acc)
 		}
 		return
+	case ExpressionTypeQueryExpression:
+		subqueryVariablesUsed(expr.QueryExpression.Source, acc)
+		return
+	case ExpressionTypeExists:
+		subqueryVariablesUsed(expr.Exists.Source, acc)
+		return
+	case ExpressionTypeCoalesce:
+		for _, arg := range expr.Coalesce.Arguments {
+			arg.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeTuple:
+		for _, arg := range expr.Tuple.Arguments {
+			arg.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeObject:
+		for _, arg := range expr.Object.Arguments {
+			arg.variablesUsed(acc)
+		}
+		return
+	case ExpressionTypeFieldAccess:
+		expr.FieldAccess.Expression.variablesUsed(acc)
+		return
+	case ExpressionTypeListLambda:
+		expr.ListLambda.List.variablesUsed(acc)
+		bodyAcc := make(map[string]struct{})
+		expr.ListLambda.Body.variablesUsed(bodyAcc)
+		delete(bodyAcc, expr.ListLambda.Parameter)
+		for name := range bodyAcc {
+			acc[name] = struct{}{}
+		}
+		return
 	case ExpressionTypeTypeAssertion:
 		expr.TypeAssertion.Expression.variablesUsed(acc)
 		return
 	case ExpressionTypeCast:
 		expr.Cast.Expression.variablesUsed(acc)
 		return
+	case ExpressionTypeCase:
+		if expr.Case.Value != nil {
+			expr.Case.Value.variablesUsed(acc)
+		}
+		for i := range expr.Case.Conditions {
+			expr.Case.Conditions[i].variablesUsed(acc)
+			expr.Case.Results[i].variablesUsed(acc)
+		}
+		expr.Case.Else.variablesUsed(acc)
+		return
 	}
 
 	panic("unexhaustive expression type match")
 }
+
+// StructAlternative returns the structure type which values of the given type may have.
+// Other alternatives of a union, like NULL, are allowed, as long as there is only one structure.
+func StructAlternative(t octosql.Type) (octosql.Type, bool) {
+	switch t.TypeID {
+	case octosql.TypeIDStruct:
+		return t, true
+	case octosql.TypeIDUnion:
+		var out *octosql.Type
+		for i := range t.Union.Alternatives {
+			if t.Union.Alternatives[i].TypeID == octosql.TypeIDStruct {
+				if out != nil {
+					return octosql.Type{}, false
+				}
+				out = &t.Union.Alternatives[i]
+			}
+		}
+		if out == nil {
+			return octosql.Type{}, false
+		}
+		return *out, true
+	}
+	return octosql.Type{}, false
+}
+
+// ListAlternative returns the list type which values of the given type may have.
+// Other alternatives of a union, like NULL, are allowed, as long as there is only one list.
+func ListAlternative(t octosql.Type) (octosql.Type, bool) {
+	switch t.TypeID {
+	case octosql.TypeIDList:
+		return t, true
+	case octosql.TypeIDUnion:
+		var out *octosql.Type
+		for i := range t.Union.Alternatives {
+			if t.Union.Alternatives[i].TypeID == octosql.TypeIDList {
+				if out != nil {
+					return octosql.Type{}, false
+				}
+				out = &t.Union.Alternatives[i]
+			}
+		}
+		if out == nil {
+			return octosql.Type{}, false
+		}
+		return *out, true
+	}
+	return octosql.Type{}, false
+}
+
+// subqueryVariablesUsed adds all variables used in the subquery.
+// Variable names are unique, so only the ones referencing outer records will match any outer fields.
+func subqueryVariablesUsed(source Node, acc map[string]struct{}) {
+	t := Transformers{
+		ExpressionTransformer: func(expr Expression) Expression {
+			if expr.ExpressionType == ExpressionTypeVariable {
+				acc[expr.Variable.Name] = struct{}{}
+			}
+			return expr
+		},
+	}
+	t.TransformNode(source)
+}
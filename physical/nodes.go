@@ -19,13 +19,16 @@ type Node struct {
 	Distinct            *Distinct
 	Filter              *Filter
 	GroupBy             *GroupBy
+	Limit               *Limit
 	LookupJoin          *LookupJoin
 	StreamJoin          *StreamJoin
 	Map                 *Map
 	OrderBy             *OrderBy
 	Requalifier         *Requalifier
 	TableValuedFunction *TableValuedFunction
+	UnionAll            *UnionAll
 	Unnest              *Unnest
+	Window              *Window
 }
 
 type Schema struct {
@@ -53,13 +56,16 @@ const (
 	NodeTypeDistinct
 	NodeTypeFilter
 	NodeTypeGroupBy
+	NodeTypeLimit
 	NodeTypeLookupJoin
 	NodeTypeStreamJoin
 	NodeTypeMap
 	NodeTypeOrderBy
 	NodeTypeRequalifier
 	NodeTypeTableValuedFunction
+	NodeTypeUnionAll
 	NodeTypeUnnest
+	NodeTypeWindow
 )
 
 func (t NodeType) String() string {
@@ -72,6 +78,8 @@ func (t NodeType) String() string {
 		return "filter"
 	case NodeTypeGroupBy:
 		return "group_by"
+	case NodeTypeLimit:
+		return "limit"
 	case NodeTypeLookupJoin:
 		return "lookup_join"
 	case NodeTypeStreamJoin:
@@ -84,8 +92,12 @@ func (t NodeType) String() string {
 		return "requalifier"
 	case NodeTypeTableValuedFunction:
 		return "table_valued_function"
+	case NodeTypeUnionAll:
+		return "union_all"
 	case NodeTypeUnnest:
 		return "unnest"
+	case NodeTypeWindow:
+		return "window"
 	}
 	return "unknown"
 }
@@ -163,13 +175,29 @@ type Aggregate struct {
 	AggregateDescriptor AggregateDescriptor
 }
 
+type Limit struct {
+	Source Node
+	// Key may be empty, in which case records are taken in the order they arrive.
+	Key                  []Expression
+	DirectionMultipliers []int
+	Limit, Offset        int
+}
+
 type StreamJoin struct {
 	Left, Right       Node
 	LeftKey, RightKey []Expression
+	// IsLeftJoin means that left records without a match get padded with nulls.
+	IsLeftJoin bool
+	// IsSemiJoin means that only left records with a match get sent, without the right fields.
+	IsSemiJoin bool
+	// IsAntiJoin means that only left records without a match get sent, without the right fields.
+	IsAntiJoin bool
 }
 
 type LookupJoin struct {
 	Source, Joined Node
+	// IsLeftJoin means that source records without a match get padded with nulls.
+	IsLeftJoin bool
 }
 
 type Map struct {
@@ -235,7 +263,12 @@ type TableValuedFunctionArgumentDescriptor struct {
 }
 
 type TableValuedFunctionDescriptor struct {
-	Materialize func(context.Context, Environment, map[string]TableValuedFunctionArgument) (execution.Node, error)
+	Materialize         func(context.Context, Environment, map[string]TableValuedFunctionArgument) (execution.Node, error)
+	ProducesRetractions bool
+}
+
+type UnionAll struct {
+	Inputs []Node
 }
 
 type Unnest struct {
@@ -243,6 +276,81 @@ type Unnest struct {
 	Field  string
 }
 
+type Window struct {
+	Source               Node
+	PartitionBy          []Expression
+	OrderBy              []Expression
+	DirectionMultipliers []int
+	Function             WindowFunction
+}
+
+type WindowFunction struct {
+	Name      string
+	Arguments []Expression
+	// Offset is only used by lag and lead.
+	Offset int
+	// Aggregate is non-null if this is an aggregate computed over the window frame.
+	Aggregate *Aggregate
+}
+
+// MayContainRetractions checks if the records produced by the node may contain retractions.
+func (node *Node) MayContainRetractions() bool {
+	switch node.NodeType {
+	case NodeTypeDatasource:
+		withRetractions, ok := node.Datasource.DatasourceImplementation.(DatasourceWithRetractions)
+		return ok && withRetractions.ProducesRetractions()
+	case NodeTypeDistinct:
+		return node.Distinct.Source.MayContainRetractions()
+	case NodeTypeFilter:
+		return node.Filter.Source.MayContainRetractions()
+	case NodeTypeGroupBy:
+		// Only the end of stream trigger sends each key exactly once, other triggers retract previously sent values.
+		return node.GroupBy.Trigger.TriggerType != TriggerTypeEndOfStream
+	case NodeTypeLimit:
+		// The ordered limit window is updated on each record, retracting records which leave it.
+		// Without ordering, only retracted records are retracted.
+		return len(node.Limit.Key) > 0 || node.Limit.Source.MayContainRetractions()
+	case NodeTypeLookupJoin:
+		return node.LookupJoin.Source.MayContainRetractions() || node.LookupJoin.Joined.MayContainRetractions()
+	case NodeTypeStreamJoin:
+		// Left and anti joins retract records once a match arrives.
+		if node.StreamJoin.IsLeftJoin || node.StreamJoin.IsAntiJoin {
+			return true
+		}
+		return node.StreamJoin.Left.MayContainRetractions() || node.StreamJoin.Right.MayContainRetractions()
+	case NodeTypeMap:
+		return node.Map.Source.MayContainRetractions()
+	case NodeTypeOrderBy:
+		// Order by only produces records after the whole source has been read.
+		return false
+	case NodeTypeRequalifier:
+		return node.Requalifier.Source.MayContainRetractions()
+	case NodeTypeTableValuedFunction:
+		if node.TableValuedFunction.FunctionDescriptor.ProducesRetractions {
+			return true
+		}
+		for _, arg := range node.TableValuedFunction.Arguments {
+			if arg.TableValuedFunctionArgumentType == TableValuedFunctionArgumentTypeTable && arg.Table.Table.MayContainRetractions() {
+				return true
+			}
+		}
+		return false
+	case NodeTypeUnionAll:
+		for i := range node.UnionAll.Inputs {
+			if node.UnionAll.Inputs[i].MayContainRetractions() {
+				return true
+			}
+		}
+		return false
+	case NodeTypeUnnest:
+		return node.Unnest.Source.MayContainRetractions()
+	case NodeTypeWindow:
+		// Window function values are updated on each record, retracting the previous ones.
+		return true
+	}
+	panic(fmt.Sprintf("unexhaustive node type match: %d", node.NodeType))
+}
+
 func (node *Node) Materialize(ctx context.Context, env Environment) (execution.Node, error) {
 	switch node.NodeType {
 	case NodeTypeDatasource:
@@ -293,6 +401,10 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		}
 		aggregates := make([]func() nodes.Aggregate, len(node.GroupBy.Aggregates))
 		for i := range node.GroupBy.Aggregates {
+			// This is also checked during typechecking, but the optimizer may rewrite the source into one with retractions, like an anti join.
+			if node.GroupBy.Aggregates[i].AggregateDescriptor.NoRetractions && node.GroupBy.Source.MayContainRetractions() {
+				return nil, fmt.Errorf("aggregate %s doesn't support retractions, but its input may contain them", node.GroupBy.Aggregates[i].Name)
+			}
 			aggregates[i] = node.GroupBy.Aggregates[i].AggregateDescriptor.Prototype
 		}
 		expressions := make([]execution.Expression, len(node.GroupBy.AggregateExpressions))
@@ -306,6 +418,20 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		trigger := node.GroupBy.Trigger.Materialize(ctx, env)
 
 		return nodes.NewGroupBy(aggregates, expressions, key, node.GroupBy.KeyEventTimeIndex, source, trigger), nil
+	case NodeTypeLimit:
+		source, err := node.Limit.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize limit source: %w", err)
+		}
+		keyExprs := make([]execution.Expression, len(node.Limit.Key))
+		for i := range node.Limit.Key {
+			expr, err := node.Limit.Key[i].Materialize(ctx, env.WithRecordSchema(node.Limit.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize limit key with index %d: %w", i, err)
+			}
+			keyExprs[i] = expr
+		}
+		return nodes.NewLimit(source, keyExprs, node.Limit.DirectionMultipliers, node.Limit.Limit, node.Limit.Offset, node.Limit.Source.MayContainRetractions()), nil
 	case NodeTypeStreamJoin:
 		left, err := node.StreamJoin.Left.Materialize(ctx, env)
 		if err != nil {
@@ -333,6 +459,13 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			rightKeyExprs[i] = expr
 		}
 
+		if node.StreamJoin.IsLeftJoin {
+			return nodes.NewLeftStreamJoin(left, right, leftKeyExprs, rightKeyExprs, len(node.StreamJoin.Right.Schema.Fields)), nil
+		} else if node.StreamJoin.IsSemiJoin {
+			return nodes.NewSemiStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+		} else if node.StreamJoin.IsAntiJoin {
+			return nodes.NewAntiStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
+		}
 		return nodes.NewStreamJoin(left, right, leftKeyExprs, rightKeyExprs), nil
 	case NodeTypeLookupJoin:
 		source, err := node.LookupJoin.Source.Materialize(ctx, env)
@@ -344,6 +477,9 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 			return nil, fmt.Errorf("couldn't materialize right join source: %w", err)
 		}
 
+		if node.LookupJoin.IsLeftJoin {
+			return nodes.NewLeftLookupJoin(source, joined, len(node.LookupJoin.Joined.Schema.Fields)), nil
+		}
 		return nodes.NewLookupJoin(source, joined), nil
 	case NodeTypeMap:
 		source, err := node.Map.Source.Materialize(ctx, env)
@@ -380,6 +516,17 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 	case NodeTypeTableValuedFunction:
 		return node.TableValuedFunction.FunctionDescriptor.Materialize(ctx, env, node.TableValuedFunction.Arguments)
 
+	case NodeTypeUnionAll:
+		inputs := make([]execution.Node, len(node.UnionAll.Inputs))
+		for i := range node.UnionAll.Inputs {
+			input, err := node.UnionAll.Inputs[i].Materialize(ctx, env)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize union input with index %d: %w", i, err)
+			}
+			inputs[i] = input
+		}
+		return nodes.NewUnionAll(inputs), nil
+
 	case NodeTypeUnnest:
 		source, err := node.Unnest.Source.Materialize(ctx, env)
 		if err != nil {
@@ -399,6 +546,61 @@ func (node *Node) Materialize(ctx context.Context, env Environment) (execution.N
 		}
 
 		return nodes.NewUnnest(source, index), nil
+	case NodeTypeWindow:
+		source, err := node.Window.Source.Materialize(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't materialize window source: %w", err)
+		}
+		partitionBy := make([]execution.Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			expr, err := node.Window.PartitionBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window partition by expression with index %d: %w", i, err)
+			}
+			partitionBy[i] = expr
+		}
+		orderBy := make([]execution.Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			expr, err := node.Window.OrderBy[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window order by expression with index %d: %w", i, err)
+			}
+			orderBy[i] = expr
+		}
+		arguments := make([]execution.Expression, len(node.Window.Function.Arguments))
+		for i := range node.Window.Function.Arguments {
+			expr, err := node.Window.Function.Arguments[i].Materialize(ctx, env.WithRecordSchema(node.Window.Source.Schema))
+			if err != nil {
+				return nil, fmt.Errorf("couldn't materialize window function argument with index %d: %w", i, err)
+			}
+			arguments[i] = expr
+		}
+
+		var newFunction func() nodes.WindowFunction
+		if node.Window.Function.Aggregate != nil {
+			descriptor := node.Window.Function.Aggregate.AggregateDescriptor
+			newFunction = func() nodes.WindowFunction {
+				return nodes.NewWindowAggregate(descriptor.Prototype, descriptor.NoRetractions)
+			}
+		} else {
+			offset := node.Window.Function.Offset
+			switch node.Window.Function.Name {
+			case "row_number":
+				newFunction = func() nodes.WindowFunction { return nodes.NewRowNumber() }
+			case "rank":
+				newFunction = func() nodes.WindowFunction { return nodes.NewRank() }
+			case "dense_rank":
+				newFunction = func() nodes.WindowFunction { return nodes.NewDenseRank() }
+			case "lag":
+				newFunction = func() nodes.WindowFunction { return nodes.NewLag(offset) }
+			case "lead":
+				newFunction = func() nodes.WindowFunction { return nodes.NewLag(-offset) }
+			default:
+				panic(fmt.Sprintf("unknown window function: %s", node.Window.Function.Name))
+			}
+		}
+
+		return nodes.NewWindow(source, partitionBy, orderBy, node.Window.DirectionMultipliers, newFunction, arguments), nil
 	}
 
 	panic(fmt.Sprintf("unexhaustive node type match: %d", node.NodeType))
@@ -28,6 +28,8 @@ func (env Environment) WithRecordSchema(schema Schema) Environment {
 type VariableContext struct {
 	Parent *VariableContext
 	Fields []SchemaField
+	// Lambda marks frames containing lambda parameters, which aren't part of any record.
+	Lambda bool
 }
 
 func (varCtx *VariableContext) WithRecordSchema(schema Schema) *VariableContext {
@@ -37,6 +39,14 @@ func (varCtx *VariableContext) WithRecordSchema(schema Schema) *VariableContext
 	}
 }
 
+func (varCtx *VariableContext) WithLambdaParameter(parameter SchemaField) *VariableContext {
+	return &VariableContext{
+		Parent: varCtx,
+		Fields: []SchemaField{parameter},
+		Lambda: true,
+	}
+}
+
 type AggregateDetails struct {
 	Description string
 	Descriptors []AggregateDescriptor
@@ -47,6 +57,10 @@ type AggregateDescriptor struct {
 	OutputType   octosql.Type
 	TypeFn       func(octosql.Type) (octosql.Type, bool)
 	Prototype    func() nodes.Aggregate
+	// NoRetractions means that the aggregate can't handle retractions, so it may only be used on inputs without them.
+	NoRetractions bool
+	// RequiresEventTime means that the aggregate implements nodes.EventTimeAggregate, so its input must have an event time field.
+	RequiresEventTime bool
 }
 
 type DatasourceRepository struct {
@@ -72,6 +86,15 @@ func (dr *DatasourceRepository) GetDatasource(ctx context.Context, name string)
 	if strings.HasSuffix(name, ".csv") {
 		return dr.FileHandlers["csv"](name)
 	}
+	if strings.HasSuffix(name, ".tsv") {
+		return dr.FileHandlers["tsv"](name)
+	}
+	if strings.HasSuffix(name, ".psv") {
+		return dr.FileHandlers["psv"](name)
+	}
+	if strings.HasSuffix(name, ".parquet") {
+		return dr.FileHandlers["parquet"](name)
+	}
 	if index := strings.Index(name, "."); index != -1 {
 		dbName := name[:index]
 		dbConstructor, ok := dr.Databases[dbName]
@@ -105,6 +128,11 @@ type DatasourceImplementation interface {
 	PushDownPredicates(newPredicates, pushedDownPredicates []Expression) (rejected, pushedDown []Expression, changed bool)
 }
 
+// DatasourceWithRetractions may be implemented by datasources which can produce retractions.
+type DatasourceWithRetractions interface {
+	ProducesRetractions() bool
+}
+
 type FunctionDetails struct {
 	Description string
 	Descriptors []FunctionDescriptor
@@ -116,4 +144,6 @@ type FunctionDescriptor struct {
 	TypeFn        func([]octosql.Type) (octosql.Type, bool) `json:"-"`
 	Strict        bool
 	Function      func([]octosql.Value) (octosql.Value, error) `json:"-"`
+	// FunctionFn is used instead of Function if the implementation depends on the argument and output types.
+	FunctionFn func(argumentTypes []octosql.Type, outputType octosql.Type) func([]octosql.Value) (octosql.Value, error) `json:"-"`
 }
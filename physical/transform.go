@@ -68,6 +68,25 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Trigger:              node.GroupBy.Trigger,
 			},
 		}
+	case NodeTypeLimit:
+		keyExprs := make([]Expression, len(node.Limit.Key))
+		for i := range node.Limit.Key {
+			keyExprs[i] = t.TransformExpr(node.Limit.Key[i])
+		}
+		directionMultipliers := make([]int, len(node.Limit.DirectionMultipliers))
+		copy(directionMultipliers, node.Limit.DirectionMultipliers)
+
+		out = Node{
+			Schema:   node.Schema,
+			NodeType: node.NodeType,
+			Limit: &Limit{
+				Source:               t.TransformNode(node.Limit.Source),
+				Key:                  keyExprs,
+				DirectionMultipliers: directionMultipliers,
+				Limit:                node.Limit.Limit,
+				Offset:               node.Limit.Offset,
+			},
+		}
 	case NodeTypeStreamJoin:
 		leftKey := make([]Expression, len(node.StreamJoin.LeftKey))
 		for i := range node.StreamJoin.LeftKey {
@@ -82,10 +101,13 @@ func (t *Transformers) TransformNode(node Node) Node {
 			Schema:   node.Schema,
 			NodeType: node.NodeType,
 			StreamJoin: &StreamJoin{
-				Left:     t.TransformNode(node.StreamJoin.Left),
-				Right:    t.TransformNode(node.StreamJoin.Right),
-				LeftKey:  leftKey,
-				RightKey: rightKey,
+				Left:       t.TransformNode(node.StreamJoin.Left),
+				Right:      t.TransformNode(node.StreamJoin.Right),
+				LeftKey:    leftKey,
+				RightKey:   rightKey,
+				IsLeftJoin: node.StreamJoin.IsLeftJoin,
+				IsSemiJoin: node.StreamJoin.IsSemiJoin,
+				IsAntiJoin: node.StreamJoin.IsAntiJoin,
 			},
 		}
 	case NodeTypeLookupJoin:
@@ -93,8 +115,9 @@ func (t *Transformers) TransformNode(node Node) Node {
 			Schema:   node.Schema,
 			NodeType: node.NodeType,
 			LookupJoin: &LookupJoin{
-				Source: t.TransformNode(node.LookupJoin.Source),
-				Joined: t.TransformNode(node.LookupJoin.Joined),
+				Source:     t.TransformNode(node.LookupJoin.Source),
+				Joined:     t.TransformNode(node.LookupJoin.Joined),
+				IsLeftJoin: node.LookupJoin.IsLeftJoin,
 			},
 		}
 	case NodeTypeMap:
@@ -176,6 +199,19 @@ func (t *Transformers) TransformNode(node Node) Node {
 				FunctionDescriptor: node.TableValuedFunction.FunctionDescriptor,
 			},
 		}
+	case NodeTypeUnionAll:
+		inputs := make([]Node, len(node.UnionAll.Inputs))
+		for i := range node.UnionAll.Inputs {
+			inputs[i] = t.TransformNode(node.UnionAll.Inputs[i])
+		}
+
+		out = Node{
+			Schema:   node.Schema,
+			NodeType: node.NodeType,
+			UnionAll: &UnionAll{
+				Inputs: inputs,
+			},
+		}
 	case NodeTypeUnnest:
 		out = Node{
 			Schema:   node.Schema,
@@ -185,6 +221,38 @@ func (t *Transformers) TransformNode(node Node) Node {
 				Field:  node.Unnest.Field,
 			},
 		}
+	case NodeTypeWindow:
+		partitionBy := make([]Expression, len(node.Window.PartitionBy))
+		for i := range node.Window.PartitionBy {
+			partitionBy[i] = t.TransformExpr(node.Window.PartitionBy[i])
+		}
+		orderBy := make([]Expression, len(node.Window.OrderBy))
+		for i := range node.Window.OrderBy {
+			orderBy[i] = t.TransformExpr(node.Window.OrderBy[i])
+		}
+		directionMultipliers := make([]int, len(node.Window.DirectionMultipliers))
+		copy(directionMultipliers, node.Window.DirectionMultipliers)
+		arguments := make([]Expression, len(node.Window.Function.Arguments))
+		for i := range node.Window.Function.Arguments {
+			arguments[i] = t.TransformExpr(node.Window.Function.Arguments[i])
+		}
+
+		out = Node{
+			Schema:   node.Schema,
+			NodeType: node.NodeType,
+			Window: &Window{
+				Source:               t.TransformNode(node.Window.Source),
+				PartitionBy:          partitionBy,
+				OrderBy:              orderBy,
+				DirectionMultipliers: directionMultipliers,
+				Function: WindowFunction{
+					Name:      node.Window.Function.Name,
+					Arguments: arguments,
+					Offset:    node.Window.Function.Offset,
+					Aggregate: node.Window.Function.Aggregate,
+				},
+			},
+		}
 
 	default:
 		panic("unexhaustive node type match")
@@ -266,6 +334,47 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				Source: t.TransformNode(expr.QueryExpression.Source),
 			},
 		}
+	case ExpressionTypeObject:
+		arguments := make([]Expression, len(expr.Object.Arguments))
+		for i := range expr.Object.Arguments {
+			arguments[i] = t.TransformExpr(expr.Object.Arguments[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Object: &Object{
+				Arguments: arguments,
+			},
+		}
+	case ExpressionTypeFieldAccess:
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			FieldAccess: &FieldAccess{
+				Expression: t.TransformExpr(expr.FieldAccess.Expression),
+				Field:      expr.FieldAccess.Field,
+			},
+		}
+	case ExpressionTypeListLambda:
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			ListLambda: &ListLambda{
+				List:      t.TransformExpr(expr.ListLambda.List),
+				Parameter: expr.ListLambda.Parameter,
+				Body:      t.TransformExpr(expr.ListLambda.Body),
+				Filter:    expr.ListLambda.Filter,
+			},
+		}
+	case ExpressionTypeExists:
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Exists: &Exists{
+				Source: t.TransformNode(expr.Exists.Source),
+			},
+		}
 	case ExpressionTypeCoalesce:
 		arguments := make([]Expression, len(expr.Coalesce.Arguments))
 		for i := range expr.Coalesce.Arguments {
@@ -310,6 +419,29 @@ func (t *Transformers) TransformExpr(expr Expression) Expression {
 				TargetType: expr.Cast.TargetType,
 			},
 		}
+	case ExpressionTypeCase:
+		var value *Expression
+		if expr.Case.Value != nil {
+			transformed := t.TransformExpr(*expr.Case.Value)
+			value = &transformed
+		}
+		conditions := make([]Expression, len(expr.Case.Conditions))
+		results := make([]Expression, len(expr.Case.Results))
+		for i := range expr.Case.Conditions {
+			conditions[i] = t.TransformExpr(expr.Case.Conditions[i])
+			results[i] = t.TransformExpr(expr.Case.Results[i])
+		}
+
+		out = Expression{
+			Type:           expr.Type,
+			ExpressionType: expr.ExpressionType,
+			Case: &Case{
+				Value:      value,
+				Conditions: conditions,
+				Results:    results,
+				Else:       t.TransformExpr(expr.Case.Else),
+			},
+		}
 	default:
 		panic("unexhaustive expression type match")
 	}
@@ -2,6 +2,7 @@ package physical
 
 import (
 	"context"
+	"time"
 
 	"github.com/cube2222/octosql/execution"
 )
@@ -10,6 +11,7 @@ type Trigger struct {
 	TriggerType TriggerType
 	// Only one of the below may be non-null.
 	CountingTrigger    *CountingTrigger
+	DelayTrigger       *DelayTrigger
 	EndOfStreamTrigger *EndOfStreamTrigger
 	WatermarkTrigger   *WatermarkTrigger
 	MultiTrigger       *MultiTrigger
@@ -19,6 +21,7 @@ type TriggerType int
 
 const (
 	TriggerTypeCounting TriggerType = iota
+	TriggerTypeDelay
 	TriggerTypeEndOfStream
 	TriggerTypeWatermark
 	TriggerTypeMulti
@@ -28,6 +31,8 @@ func (t TriggerType) String() string {
 	switch t {
 	case TriggerTypeCounting:
 		return "counting"
+	case TriggerTypeDelay:
+		return "delay"
 	case TriggerTypeEndOfStream:
 		return "end_of_stream"
 	case TriggerTypeWatermark:
@@ -42,6 +47,10 @@ type CountingTrigger struct {
 	TriggerAfter uint
 }
 
+type DelayTrigger struct {
+	Delay time.Duration
+}
+
 type EndOfStreamTrigger struct {
 }
 
@@ -57,6 +66,8 @@ func (t *Trigger) Materialize(ctx context.Context, env Environment) func() execu
 	switch t.TriggerType {
 	case TriggerTypeCounting:
 		return execution.NewCountingTriggerPrototype(t.CountingTrigger.TriggerAfter)
+	case TriggerTypeDelay:
+		return execution.NewDelayTriggerPrototype(t.DelayTrigger.Delay)
 	case TriggerTypeEndOfStream:
 		return execution.NewEndOfStreamTriggerPrototype()
 	case TriggerTypeWatermark:
@@ -198,6 +198,11 @@ type PhysicalDatasource struct {
 	table string
 }
 
+// ProducesRetractions is always true, as plugins may send arbitrary records.
+func (p *PhysicalDatasource) ProducesRetractions() bool {
+	return true
+}
+
 func (p *PhysicalDatasource) PushDownPredicates(newPredicates, pushedDownPredicates []physical.Expression) (rejected, pushedDown []physical.Expression, changed bool) {
 	var newPredicatesSerializable, newPredicatesNotSerializable []physical.Expression
 	for i := range newPredicates {
@@ -3,6 +3,7 @@ package plugins
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -69,8 +70,23 @@ func NativeValueToProto(value octosql.Value) *Value {
 		out.Str = value.Str
 	case octosql.TypeIDTime:
 		out.Time = timestamppb.New(value.Time)
+		if loc := value.Time.Location(); loc != time.UTC {
+			out.TimeZone = loc.String()
+			_, offset := value.Time.Zone()
+			out.TimeZoneOffset = int32(offset)
+		}
+	case octosql.TypeIDDate:
+		out.Time = timestamppb.New(value.Time)
 	case octosql.TypeIDDuration:
 		out.Duration = durationpb.New(value.Duration)
+	case octosql.TypeIDInterval:
+		out.Interval = &Interval{
+			Months:   int64(value.Interval.Months),
+			Days:     int64(value.Interval.Days),
+			Duration: durationpb.New(value.Interval.Duration),
+		}
+	case octosql.TypeIDDecimal:
+		out.Decimal = value.Decimal.String()
 	case octosql.TypeIDList:
 		elements := make([]*Value, len(value.List))
 		for i := range value.List {
@@ -112,8 +128,25 @@ func (x *Value) ToNativeValue() octosql.Value {
 		out.Str = x.Str
 	case octosql.TypeIDTime:
 		out.Time = x.Time.AsTime()
+		if x.TimeZone != "" || x.TimeZoneOffset != 0 {
+			out.Time = out.Time.In(protoTimeZone(x.TimeZone, int(x.TimeZoneOffset)))
+		}
+	case octosql.TypeIDDate:
+		out.Time = x.Time.AsTime()
 	case octosql.TypeIDDuration:
 		out.Duration = x.Duration.AsDuration()
+	case octosql.TypeIDInterval:
+		out.Interval = octosql.CalendarInterval{
+			Months:   int(x.Interval.Months),
+			Days:     int(x.Interval.Days),
+			Duration: x.Interval.Duration.AsDuration(),
+		}
+	case octosql.TypeIDDecimal:
+		decimal, err := octosql.ParseDecimal(x.Decimal)
+		if err != nil {
+			panic(fmt.Sprintf("invalid decimal in proto: %s", err))
+		}
+		out.Decimal = decimal
 	case octosql.TypeIDList:
 		elements := make([]octosql.Value, len(x.List))
 		for i := range x.List {
@@ -138,6 +171,16 @@ func (x *Value) ToNativeValue() octosql.Value {
 	return out
 }
 
+// protoTimeZone returns the IANA time zone with the given name, or a fixed zone with the given offset if there's no such time zone.
+func protoTimeZone(name string, offset int) *time.Location {
+	if name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return time.FixedZone(name, offset)
+}
+
 func NativeSchemaToProto(schema physical.Schema) *Schema {
 	fields := make([]*SchemaField, len(schema.Fields))
 	for i := range schema.Fields {
@@ -171,7 +214,10 @@ func NativeTypeToProto(t octosql.Type) *Type {
 		TypeId: int32(t.TypeID),
 	}
 	switch t.TypeID {
-	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDAny:
+	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDInterval, octosql.TypeIDDate, octosql.TypeIDAny:
+	case octosql.TypeIDDecimal:
+		out.DecimalPrecision = int32(t.Decimal.Precision)
+		out.DecimalScale = int32(t.Decimal.Scale)
 	case octosql.TypeIDList:
 		if t.List.Element != nil {
 			out.List = NativeTypeToProto(*t.List.Element)
@@ -208,7 +254,10 @@ func (x *Type) ToNativeType() octosql.Type {
 		TypeID: octosql.TypeID(x.TypeId),
 	}
 	switch octosql.TypeID(x.TypeId) {
-	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDAny:
+	case octosql.TypeIDNull, octosql.TypeIDInt, octosql.TypeIDFloat, octosql.TypeIDBoolean, octosql.TypeIDString, octosql.TypeIDTime, octosql.TypeIDDuration, octosql.TypeIDInterval, octosql.TypeIDDate, octosql.TypeIDAny:
+	case octosql.TypeIDDecimal:
+		out.Decimal.Precision = int(x.DecimalPrecision)
+		out.Decimal.Scale = int(x.DecimalScale)
 	case octosql.TypeIDList:
 		if x.List != nil {
 			t := x.List.ToNativeType()
@@ -347,6 +396,7 @@ func RepopulatePhysicalExpressionFunctions(expr physical.Expression) (physical.E
 				}
 				expr.FunctionCall.FunctionDescriptor.TypeFn = descriptor.TypeFn
 				expr.FunctionCall.FunctionDescriptor.Function = descriptor.Function
+				expr.FunctionCall.FunctionDescriptor.FunctionFn = descriptor.FunctionFn
 				return expr
 			}
 
@@ -1,12 +1,16 @@
 package plugins
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/functions"
 	"github.com/cube2222/octosql/octosql"
 	"github.com/cube2222/octosql/physical"
 )
@@ -56,6 +60,18 @@ func TestPhysicalVariableContext(t *testing.T) {
 						Name: "test9",
 						Type: octosql.Null,
 					},
+					{
+						Name: "test10",
+						Type: octosql.Interval,
+					},
+					{
+						Name: "test11",
+						Type: octosql.NewDecimalType(10, 2),
+					},
+					{
+						Name: "test12",
+						Type: octosql.Date,
+					},
 				},
 				Parent: nil,
 			},
@@ -68,6 +84,9 @@ func TestPhysicalVariableContext(t *testing.T) {
 }
 
 func TestExecutionVariableContext(t *testing.T) {
+	decimal, err := octosql.ParseDecimal("-1234.50")
+	assert.NoError(t, err)
+
 	c := &execution.VariableContext{
 		Values: []octosql.Value{
 			octosql.NewString("test1"),
@@ -83,6 +102,10 @@ func TestExecutionVariableContext(t *testing.T) {
 				Values: []octosql.Value{
 					octosql.NewString("test2"),
 					octosql.NewNull(),
+					octosql.NewInterval(octosql.CalendarInterval{Months: 14, Days: 3, Duration: time.Hour}),
+					octosql.NewDecimal(decimal),
+					octosql.NewDate(time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)),
+					octosql.NewTime(time.Date(2021, 3, 4, 15, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))),
 				},
 				Parent: nil,
 			},
@@ -93,3 +116,69 @@ func TestExecutionVariableContext(t *testing.T) {
 
 	assert.Equal(t, c, outC)
 }
+
+func TestRepopulatePhysicalExpressionFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		function string
+		argument octosql.Value
+		// outputType is the type of the function call expression, used by functions which depend on it.
+		outputType octosql.Type
+		expected   octosql.Value
+	}{
+		{
+			name:       "function",
+			function:   "upper",
+			argument:   octosql.NewString("test"),
+			outputType: octosql.String,
+			expected:   octosql.NewString("TEST"),
+		},
+		{
+			name:       "function depending on types",
+			function:   "parse_json",
+			argument:   octosql.NewString("5"),
+			outputType: octosql.Int,
+			expected:   octosql.NewInt(5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var descriptor physical.FunctionDescriptor
+			for _, curDescriptor := range functions.FunctionMap()[tt.function].Descriptors {
+				if len(curDescriptor.ArgumentTypes) == 1 && curDescriptor.ArgumentTypes[0].Equals(octosql.String) {
+					descriptor = curDescriptor
+				}
+			}
+			expr := physical.Expression{
+				Type:           tt.outputType,
+				ExpressionType: physical.ExpressionTypeFunctionCall,
+				FunctionCall: &physical.FunctionCall{
+					Name: tt.function,
+					Arguments: []physical.Expression{
+						{
+							Type:           tt.argument.Type(),
+							ExpressionType: physical.ExpressionTypeConstant,
+							Constant:       &physical.Constant{Value: tt.argument},
+						},
+					},
+					FunctionDescriptor: descriptor,
+				},
+			}
+
+			// Functions aren't serialized, so they have to be repopulated on the other side.
+			data, err := json.Marshal(expr)
+			require.NoError(t, err)
+			var received physical.Expression
+			require.NoError(t, json.Unmarshal(data, &received))
+
+			repopulated, ok := RepopulatePhysicalExpressionFunctions(received)
+			require.True(t, ok)
+
+			materialized, err := repopulated.Materialize(context.Background(), physical.Environment{})
+			require.NoError(t, err)
+			value, err := materialized.Evaluate(execution.ExecutionContext{Context: context.Background()})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
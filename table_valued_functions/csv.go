@@ -0,0 +1,138 @@
+package table_valued_functions
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/cube2222/octosql/datasources/csv"
+	"github.com/cube2222/octosql/datasources/inference"
+	"github.com/cube2222/octosql/execution"
+	"github.com/cube2222/octosql/logical"
+	"github.com/cube2222/octosql/octosql"
+	"github.com/cube2222/octosql/physical"
+)
+
+var csvArgumentTypes = map[string]octosql.Type{
+	"path":        octosql.String,
+	"delimiter":   octosql.String,
+	"header":      octosql.Boolean,
+	"comment":     octosql.String,
+	"lazy_quotes": octosql.Boolean,
+	"sample_size": octosql.Int,
+	"mismatch":    octosql.String,
+}
+
+var CSV = logical.TableValuedFunctionDescription{
+	TypecheckArguments: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionArgumentValue) map[string]logical.TableValuedFunctionTypecheckedArgument {
+		outArgs := make(map[string]logical.TableValuedFunctionTypecheckedArgument)
+		for name := range csvArgumentTypes {
+			if _, ok := args[name]; ok {
+				outArgs[name] = logical.TableValuedFunctionTypecheckedArgument{
+					Argument: args[name].(*logical.TableValuedFunctionArgumentValueExpression).Typecheck(ctx, env, logicalEnv),
+				}
+			}
+		}
+		return outArgs
+	},
+	Descriptors: []logical.TableValuedFunctionDescriptor{
+		{
+			Arguments: func() map[string]logical.TableValuedFunctionArgumentMatcher {
+				out := make(map[string]logical.TableValuedFunctionArgumentMatcher)
+				for name, t := range csvArgumentTypes {
+					out[name] = logical.TableValuedFunctionArgumentMatcher{
+						Required:                               name == "path",
+						TableValuedFunctionArgumentMatcherType: physical.TableValuedFunctionArgumentTypeExpression,
+						Expression: &logical.TableValuedFunctionArgumentMatcherExpression{
+							Type: t,
+						},
+					}
+				}
+				return out
+			}(),
+			OutputSchema: func(ctx context.Context, env physical.Environment, logicalEnv logical.Environment, args map[string]logical.TableValuedFunctionTypecheckedArgument) (physical.Schema, map[string]string, error) {
+				physicalArgs := make(map[string]physical.TableValuedFunctionArgument)
+				for name, arg := range args {
+					physicalArgs[name] = arg.Argument
+				}
+				_, schema, err := getCSVDatasource(env, physicalArgs)
+				if err != nil {
+					return physical.Schema{}, nil, err
+				}
+
+				outFields := make([]physical.SchemaField, len(schema.Fields))
+				outMapping := make(map[string]string)
+				for i, field := range schema.Fields {
+					unique := logicalEnv.GetUnique(field.Name)
+					outMapping[field.Name] = unique
+					outFields[i] = physical.SchemaField{
+						Name: unique,
+						Type: field.Type,
+					}
+				}
+
+				return physical.NewSchema(outFields, schema.TimeField), outMapping, nil
+			},
+			Materialize: func(ctx context.Context, env physical.Environment, args map[string]physical.TableValuedFunctionArgument) (execution.Node, error) {
+				datasource, schema, err := getCSVDatasource(env, args)
+				if err != nil {
+					return nil, err
+				}
+				return datasource.Materialize(ctx, env, schema, nil)
+			},
+		},
+	},
+}
+
+func getCSVDatasource(env physical.Environment, args map[string]physical.TableValuedFunctionArgument) (physical.DatasourceImplementation, physical.Schema, error) {
+	constants := make(map[string]octosql.Value)
+	for name, arg := range args {
+		if arg.Expression.Expression.ExpressionType != physical.ExpressionTypeConstant {
+			return nil, physical.Schema{}, fmt.Errorf("csv argument %s must be a constant", name)
+		}
+		constants[name] = arg.Expression.Expression.Constant.Value
+	}
+
+	dialect := csv.DefaultDialect
+	if delimiter, ok := constants["delimiter"]; ok {
+		str := delimiter.Str
+		if str == `\t` {
+			// String literals aren't unescaped, so we handle the common tab escape sequence here.
+			str = "\t"
+		}
+		if utf8.RuneCountInString(str) != 1 {
+			return nil, physical.Schema{}, fmt.Errorf("csv delimiter must be a single character, is '%s'", delimiter.Str)
+		}
+		dialect.Delimiter, _ = utf8.DecodeRuneInString(str)
+	}
+	if header, ok := constants["header"]; ok {
+		dialect.HasHeader = header.Boolean
+	}
+	if comment, ok := constants["comment"]; ok {
+		if utf8.RuneCountInString(comment.Str) != 1 {
+			return nil, physical.Schema{}, fmt.Errorf("csv comment must be a single character, is '%s'", comment.Str)
+		}
+		dialect.Comment, _ = utf8.DecodeRuneInString(comment.Str)
+	}
+	if lazyQuotes, ok := constants["lazy_quotes"]; ok {
+		dialect.LazyQuotes = lazyQuotes.Boolean
+	}
+
+	options := inference.FromPhysicalConfig(env.PhysicalConfig)
+	if sampleSize, ok := constants["sample_size"]; ok {
+		options.SampleSize = sampleSize.Int
+	}
+	if mismatch, ok := constants["mismatch"]; ok {
+		mode, err := inference.ParseMode(mismatch.Str)
+		if err != nil {
+			return nil, physical.Schema{}, err
+		}
+		options.Mode = mode
+	}
+
+	datasource, schema, err := csv.NewCreator(dialect, options)(constants["path"].Str)
+	if err != nil {
+		return nil, physical.Schema{}, fmt.Errorf("couldn't read csv file: %w", err)
+	}
+	return datasource, schema, nil
+}
@@ -86,6 +86,8 @@ var Poll = logical.TableValuedFunctionDescription{
 					interval: interval,
 				}, nil
 			},
+			// Each poll retracts the records of the previous one.
+			ProducesRetractions: true,
 		},
 	},
 }